@@ -0,0 +1,172 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v4.23.3
+// source: v1/error_reason.proto
+
+package v1
+
+import (
+	_ "github.com/go-kratos/kratos/v2/errors"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ErrorReason 错误原因
+//
+// 微信接口返回的错误在ErrorInfo的metadata中携带原始的errcode、errmsg和rid.
+type ErrorReason int32
+
+const (
+	// 未知错误
+	ErrorReason_UNKNOWN_ERROR ErrorReason = 0
+	// 微信接口返回的其他错误
+	ErrorReason_WX_ERROR ErrorReason = 1
+	// AccessToken无效或过期: 40001, 40014, 41001, 42001
+	ErrorReason_ACCESS_TOKEN_INVALID ErrorReason = 2
+	// 参数错误: 40003, 40004, 40007, 41xxx, 44xxx, 47001等
+	ErrorReason_INVALID_ARGUMENT ErrorReason = 3
+	// 无接口权限或IP不在白名单: 40164, 48001, 50001等
+	ErrorReason_PERMISSION_DENIED ErrorReason = 4
+	// 资源不存在: 46001, 46004等
+	ErrorReason_NOT_FOUND ErrorReason = 5
+	// 调用次数或频率超过限制: 45009, 45011, 45047等
+	ErrorReason_QUOTA_EXCEEDED ErrorReason = 6
+	// 微信系统繁忙(-1)或无法连接微信服务器
+	ErrorReason_UPSTREAM_UNAVAILABLE ErrorReason = 7
+	// 超过WXProxy配置的调用频率限制, 详情中的RetryInfo为建议的重试间隔
+	ErrorReason_RATE_LIMITED ErrorReason = 8
+	// 调用方未认证: 未携带有效的API Key或客户端证书
+	ErrorReason_UNAUTHENTICATED ErrorReason = 9
+)
+
+// Enum value maps for ErrorReason.
+var (
+	ErrorReason_name = map[int32]string{
+		0: "UNKNOWN_ERROR",
+		1: "WX_ERROR",
+		2: "ACCESS_TOKEN_INVALID",
+		3: "INVALID_ARGUMENT",
+		4: "PERMISSION_DENIED",
+		5: "NOT_FOUND",
+		6: "QUOTA_EXCEEDED",
+		7: "UPSTREAM_UNAVAILABLE",
+		8: "RATE_LIMITED",
+		9: "UNAUTHENTICATED",
+	}
+	ErrorReason_value = map[string]int32{
+		"UNKNOWN_ERROR":        0,
+		"WX_ERROR":             1,
+		"ACCESS_TOKEN_INVALID": 2,
+		"INVALID_ARGUMENT":     3,
+		"PERMISSION_DENIED":    4,
+		"NOT_FOUND":            5,
+		"QUOTA_EXCEEDED":       6,
+		"UPSTREAM_UNAVAILABLE": 7,
+		"RATE_LIMITED":         8,
+		"UNAUTHENTICATED":      9,
+	}
+)
+
+func (x ErrorReason) Enum() *ErrorReason {
+	p := new(ErrorReason)
+	*p = x
+	return p
+}
+
+func (x ErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_error_reason_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorReason) Type() protoreflect.EnumType {
+	return &file_v1_error_reason_proto_enumTypes[0]
+}
+
+func (x ErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorReason.Descriptor instead.
+func (ErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_v1_error_reason_proto_rawDescGZIP(), []int{0}
+}
+
+var File_v1_error_reason_proto protoreflect.FileDescriptor
+
+const file_v1_error_reason_proto_rawDesc = "" +
+	"\n" +
+	"\x15v1/error_reason.proto\x12\x0eapi.wxproxy.v1\x1a\x13errors/errors.proto*\x8f\x02\n" +
+	"\vErrorReason\x12\x11\n" +
+	"\rUNKNOWN_ERROR\x10\x00\x12\f\n" +
+	"\bWX_ERROR\x10\x01\x12\x1e\n" +
+	"\x14ACCESS_TOKEN_INVALID\x10\x02\x1a\x04\xa8E\x91\x03\x12\x1a\n" +
+	"\x10INVALID_ARGUMENT\x10\x03\x1a\x04\xa8E\x90\x03\x12\x1b\n" +
+	"\x11PERMISSION_DENIED\x10\x04\x1a\x04\xa8E\x93\x03\x12\x13\n" +
+	"\tNOT_FOUND\x10\x05\x1a\x04\xa8E\x94\x03\x12\x18\n" +
+	"\x0eQUOTA_EXCEEDED\x10\x06\x1a\x04\xa8E\xad\x03\x12\x1e\n" +
+	"\x14UPSTREAM_UNAVAILABLE\x10\a\x1a\x04\xa8E\xf7\x03\x12\x16\n" +
+	"\fRATE_LIMITED\x10\b\x1a\x04\xa8E\xad\x03\x12\x19\n" +
+	"\x0fUNAUTHENTICATED\x10\t\x1a\x04\xa8E\x91\x03\x1a\x04\xa0E\xf4\x03B2\n" +
+	"\x06api.v1P\x01Z&github.com/seth16888/wxproxy/api/v1;v1b\x06proto3"
+
+var (
+	file_v1_error_reason_proto_rawDescOnce sync.Once
+	file_v1_error_reason_proto_rawDescData []byte
+)
+
+func file_v1_error_reason_proto_rawDescGZIP() []byte {
+	file_v1_error_reason_proto_rawDescOnce.Do(func() {
+		file_v1_error_reason_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_error_reason_proto_rawDesc), len(file_v1_error_reason_proto_rawDesc)))
+	})
+	return file_v1_error_reason_proto_rawDescData
+}
+
+var file_v1_error_reason_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_v1_error_reason_proto_goTypes = []any{
+	(ErrorReason)(0), // 0: api.wxproxy.v1.ErrorReason
+}
+var file_v1_error_reason_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_v1_error_reason_proto_init() }
+func file_v1_error_reason_proto_init() {
+	if File_v1_error_reason_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_error_reason_proto_rawDesc), len(file_v1_error_reason_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_error_reason_proto_goTypes,
+		DependencyIndexes: file_v1_error_reason_proto_depIdxs,
+		EnumInfos:         file_v1_error_reason_proto_enumTypes,
+	}.Build()
+	File_v1_error_reason_proto = out.File
+	file_v1_error_reason_proto_goTypes = nil
+	file_v1_error_reason_proto_depIdxs = nil
+}
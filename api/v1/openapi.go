@@ -0,0 +1,8 @@
+package v1
+
+import _ "embed"
+
+// OpenAPI 由proto生成的OpenAPI v3文档(YAML), 见scripts/gen_pb.cmd
+//
+//go:embed openapi.yaml
+var OpenAPI []byte
@@ -7,6 +7,8 @@
 package v1
 
 import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/google/gnostic/openapiv3"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -22,9 +24,963 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// GetAccessTokenRequest 获取托管的AccessToken
+type GetAccessTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=AppId,proto3" json:"AppId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccessTokenRequest) Reset() {
+	*x = GetAccessTokenRequest{}
+	mi := &file_v1_wxproxy_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccessTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccessTokenRequest) ProtoMessage() {}
+
+func (x *GetAccessTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wxproxy_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccessTokenRequest.ProtoReflect.Descriptor instead.
+func (*GetAccessTokenRequest) Descriptor() ([]byte, []int) {
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetAccessTokenRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+type GetAccessTokenReply struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	// ExpiresAt 过期时间, unix时间戳(秒)
+	ExpiresAt int64 `protobuf:"varint,2,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
+	// ExpiresIn 剩余有效期(秒)
+	ExpiresIn     int64 `protobuf:"varint,3,opt,name=ExpiresIn,proto3" json:"ExpiresIn,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccessTokenReply) Reset() {
+	*x = GetAccessTokenReply{}
+	mi := &file_v1_wxproxy_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccessTokenReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccessTokenReply) ProtoMessage() {}
+
+func (x *GetAccessTokenReply) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wxproxy_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccessTokenReply.ProtoReflect.Descriptor instead.
+func (*GetAccessTokenReply) Descriptor() ([]byte, []int) {
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetAccessTokenReply) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *GetAccessTokenReply) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *GetAccessTokenReply) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+// RefreshAccessTokenRequest 刷新托管的AccessToken
+type RefreshAccessTokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	AppId string                 `protobuf:"bytes,1,opt,name=AppId,proto3" json:"AppId,omitempty"`
+	// ForceRefresh 使用stable_token的force_refresh模式, 仅对配置了use_stable_token的账号生效, 每日次数有限
+	ForceRefresh  bool `protobuf:"varint,2,opt,name=ForceRefresh,proto3" json:"ForceRefresh,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshAccessTokenRequest) Reset() {
+	*x = RefreshAccessTokenRequest{}
+	mi := &file_v1_wxproxy_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshAccessTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshAccessTokenRequest) ProtoMessage() {}
+
+func (x *RefreshAccessTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wxproxy_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshAccessTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshAccessTokenRequest) Descriptor() ([]byte, []int) {
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RefreshAccessTokenRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *RefreshAccessTokenRequest) GetForceRefresh() bool {
+	if x != nil {
+		return x.ForceRefresh
+	}
+	return false
+}
+
+type RefreshAccessTokenReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ExpiresAt 新AccessToken的过期时间, unix时间戳(秒)
+	ExpiresAt int64 `protobuf:"varint,1,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
+	// ForceRefreshCount 今日已使用的强制刷新次数
+	ForceRefreshCount int64 `protobuf:"varint,2,opt,name=ForceRefreshCount,proto3" json:"ForceRefreshCount,omitempty"`
+	ForceRefreshLimit int64 `protobuf:"varint,3,opt,name=ForceRefreshLimit,proto3" json:"ForceRefreshLimit,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RefreshAccessTokenReply) Reset() {
+	*x = RefreshAccessTokenReply{}
+	mi := &file_v1_wxproxy_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshAccessTokenReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshAccessTokenReply) ProtoMessage() {}
+
+func (x *RefreshAccessTokenReply) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wxproxy_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshAccessTokenReply.ProtoReflect.Descriptor instead.
+func (*RefreshAccessTokenReply) Descriptor() ([]byte, []int) {
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RefreshAccessTokenReply) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *RefreshAccessTokenReply) GetForceRefreshCount() int64 {
+	if x != nil {
+		return x.ForceRefreshCount
+	}
+	return 0
+}
+
+func (x *RefreshAccessTokenReply) GetForceRefreshLimit() int64 {
+	if x != nil {
+		return x.ForceRefreshLimit
+	}
+	return 0
+}
+
+// DescribeErrorCodeRequest 查询微信错误码的说明
+type DescribeErrorCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Errcode       int64                  `protobuf:"varint,1,opt,name=Errcode,proto3" json:"Errcode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeErrorCodeRequest) Reset() {
+	*x = DescribeErrorCodeRequest{}
+	mi := &file_v1_wxproxy_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeErrorCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeErrorCodeRequest) ProtoMessage() {}
+
+func (x *DescribeErrorCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wxproxy_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeErrorCodeRequest.ProtoReflect.Descriptor instead.
+func (*DescribeErrorCodeRequest) Descriptor() ([]byte, []int) {
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DescribeErrorCodeRequest) GetErrcode() int64 {
+	if x != nil {
+		return x.Errcode
+	}
+	return 0
+}
+
+type DescribeErrorCodeReply struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Errcode int64                  `protobuf:"varint,1,opt,name=Errcode,proto3" json:"Errcode,omitempty"`
+	// Reason 对应的错误原因, 见ErrorReason
+	Reason string `protobuf:"bytes,2,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	// Category 分类: system, token, argument, permission, not_found, quota, business
+	Category string `protobuf:"bytes,3,opt,name=Category,proto3" json:"Category,omitempty"`
+	// Retryable 原样重试是否可能成功
+	Retryable     bool   `protobuf:"varint,4,opt,name=Retryable,proto3" json:"Retryable,omitempty"`
+	DescriptionZh string `protobuf:"bytes,5,opt,name=DescriptionZh,proto3" json:"DescriptionZh,omitempty"`
+	DescriptionEn string `protobuf:"bytes,6,opt,name=DescriptionEn,proto3" json:"DescriptionEn,omitempty"`
+	// SuggestedAction 建议的处理方式
+	SuggestedAction string `protobuf:"bytes,7,opt,name=SuggestedAction,proto3" json:"SuggestedAction,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DescribeErrorCodeReply) Reset() {
+	*x = DescribeErrorCodeReply{}
+	mi := &file_v1_wxproxy_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeErrorCodeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeErrorCodeReply) ProtoMessage() {}
+
+func (x *DescribeErrorCodeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wxproxy_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeErrorCodeReply.ProtoReflect.Descriptor instead.
+func (*DescribeErrorCodeReply) Descriptor() ([]byte, []int) {
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DescribeErrorCodeReply) GetErrcode() int64 {
+	if x != nil {
+		return x.Errcode
+	}
+	return 0
+}
+
+func (x *DescribeErrorCodeReply) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DescribeErrorCodeReply) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *DescribeErrorCodeReply) GetRetryable() bool {
+	if x != nil {
+		return x.Retryable
+	}
+	return false
+}
+
+func (x *DescribeErrorCodeReply) GetDescriptionZh() string {
+	if x != nil {
+		return x.DescriptionZh
+	}
+	return ""
+}
+
+func (x *DescribeErrorCodeReply) GetDescriptionEn() string {
+	if x != nil {
+		return x.DescriptionEn
+	}
+	return ""
+}
+
+func (x *DescribeErrorCodeReply) GetSuggestedAction() string {
+	if x != nil {
+		return x.SuggestedAction
+	}
+	return ""
+}
+
+// GetRidInfoRequest 查询rid信息
+type GetRidInfoRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	// Rid 微信接口返回的errmsg中的rid, 如"rid: 6486d6f1-5be4d1ac-1bea40f7"
+	Rid           string `protobuf:"bytes,2,opt,name=Rid,proto3" json:"Rid,omitempty"`
+	AppId         string `protobuf:"bytes,3,opt,name=AppId,proto3" json:"AppId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRidInfoRequest) Reset() {
+	*x = GetRidInfoRequest{}
+	mi := &file_v1_wxproxy_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRidInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRidInfoRequest) ProtoMessage() {}
+
+func (x *GetRidInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wxproxy_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRidInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetRidInfoRequest) Descriptor() ([]byte, []int) {
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetRidInfoRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *GetRidInfoRequest) GetRid() string {
+	if x != nil {
+		return x.Rid
+	}
+	return ""
+}
+
+func (x *GetRidInfoRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+type GetRidInfoReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// InvokeTime 发起请求的时间戳
+	InvokeTime int64 `protobuf:"varint,1,opt,name=InvokeTime,proto3" json:"InvokeTime,omitempty"`
+	// CostInMs 请求毫秒级耗时
+	CostInMs      int64  `protobuf:"varint,2,opt,name=CostInMs,proto3" json:"CostInMs,omitempty"`
+	RequestUrl    string `protobuf:"bytes,3,opt,name=RequestUrl,proto3" json:"RequestUrl,omitempty"`
+	RequestBody   string `protobuf:"bytes,4,opt,name=RequestBody,proto3" json:"RequestBody,omitempty"`
+	ResponseBody  string `protobuf:"bytes,5,opt,name=ResponseBody,proto3" json:"ResponseBody,omitempty"`
+	ClientIp      string `protobuf:"bytes,6,opt,name=ClientIp,proto3" json:"ClientIp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRidInfoReply) Reset() {
+	*x = GetRidInfoReply{}
+	mi := &file_v1_wxproxy_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRidInfoReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRidInfoReply) ProtoMessage() {}
+
+func (x *GetRidInfoReply) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wxproxy_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRidInfoReply.ProtoReflect.Descriptor instead.
+func (*GetRidInfoReply) Descriptor() ([]byte, []int) {
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetRidInfoReply) GetInvokeTime() int64 {
+	if x != nil {
+		return x.InvokeTime
+	}
+	return 0
+}
+
+func (x *GetRidInfoReply) GetCostInMs() int64 {
+	if x != nil {
+		return x.CostInMs
+	}
+	return 0
+}
+
+func (x *GetRidInfoReply) GetRequestUrl() string {
+	if x != nil {
+		return x.RequestUrl
+	}
+	return ""
+}
+
+func (x *GetRidInfoReply) GetRequestBody() string {
+	if x != nil {
+		return x.RequestBody
+	}
+	return ""
+}
+
+func (x *GetRidInfoReply) GetResponseBody() string {
+	if x != nil {
+		return x.ResponseBody
+	}
+	return ""
+}
+
+func (x *GetRidInfoReply) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+// GetApiQuotaRequest 查询接口的每日调用额度
+type GetApiQuotaRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	// CgiPath 接口路径, 如"/cgi-bin/message/custom/send"
+	CgiPath       string `protobuf:"bytes,2,opt,name=CgiPath,proto3" json:"CgiPath,omitempty"`
+	AppId         string `protobuf:"bytes,3,opt,name=AppId,proto3" json:"AppId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApiQuotaRequest) Reset() {
+	*x = GetApiQuotaRequest{}
+	mi := &file_v1_wxproxy_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApiQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApiQuotaRequest) ProtoMessage() {}
+
+func (x *GetApiQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wxproxy_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApiQuotaRequest.ProtoReflect.Descriptor instead.
+func (*GetApiQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetApiQuotaRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *GetApiQuotaRequest) GetCgiPath() string {
+	if x != nil {
+		return x.CgiPath
+	}
+	return ""
+}
+
+func (x *GetApiQuotaRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+type GetApiQuotaReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// DailyLimit 当天该账号可调用该接口的次数
+	DailyLimit int64 `protobuf:"varint,1,opt,name=DailyLimit,proto3" json:"DailyLimit,omitempty"`
+	// Used 当天已经调用的次数
+	Used int64 `protobuf:"varint,2,opt,name=Used,proto3" json:"Used,omitempty"`
+	// Remain 当天剩余调用次数
+	Remain int64 `protobuf:"varint,3,opt,name=Remain,proto3" json:"Remain,omitempty"`
+	// RateLimitCallCount 周期内可调用数量
+	RateLimitCallCount int64 `protobuf:"varint,4,opt,name=RateLimitCallCount,proto3" json:"RateLimitCallCount,omitempty"`
+	// RateLimitRefreshSecond 更新周期, 单位秒
+	RateLimitRefreshSecond int64 `protobuf:"varint,5,opt,name=RateLimitRefreshSecond,proto3" json:"RateLimitRefreshSecond,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetApiQuotaReply) Reset() {
+	*x = GetApiQuotaReply{}
+	mi := &file_v1_wxproxy_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApiQuotaReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApiQuotaReply) ProtoMessage() {}
+
+func (x *GetApiQuotaReply) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wxproxy_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApiQuotaReply.ProtoReflect.Descriptor instead.
+func (*GetApiQuotaReply) Descriptor() ([]byte, []int) {
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetApiQuotaReply) GetDailyLimit() int64 {
+	if x != nil {
+		return x.DailyLimit
+	}
+	return 0
+}
+
+func (x *GetApiQuotaReply) GetUsed() int64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *GetApiQuotaReply) GetRemain() int64 {
+	if x != nil {
+		return x.Remain
+	}
+	return 0
+}
+
+func (x *GetApiQuotaReply) GetRateLimitCallCount() int64 {
+	if x != nil {
+		return x.RateLimitCallCount
+	}
+	return 0
+}
+
+func (x *GetApiQuotaReply) GetRateLimitRefreshSecond() int64 {
+	if x != nil {
+		return x.RateLimitRefreshSecond
+	}
+	return 0
+}
+
+// ClearQuotaRequest 重置接口调用次数
+type ClearQuotaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,2,opt,name=AppId,proto3" json:"AppId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearQuotaRequest) Reset() {
+	*x = ClearQuotaRequest{}
+	mi := &file_v1_wxproxy_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearQuotaRequest) ProtoMessage() {}
+
+func (x *ClearQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wxproxy_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearQuotaRequest.ProtoReflect.Descriptor instead.
+func (*ClearQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ClearQuotaRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ClearQuotaRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+// ClearQuotaV2Request 使用AppSecret重置接口调用次数, 账号须由WXProxy托管
+type ClearQuotaV2Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=AppId,proto3" json:"AppId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearQuotaV2Request) Reset() {
+	*x = ClearQuotaV2Request{}
+	mi := &file_v1_wxproxy_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearQuotaV2Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearQuotaV2Request) ProtoMessage() {}
+
+func (x *ClearQuotaV2Request) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wxproxy_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearQuotaV2Request.ProtoReflect.Descriptor instead.
+func (*ClearQuotaV2Request) Descriptor() ([]byte, []int) {
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ClearQuotaV2Request) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+// GetQuotaUsageRequest 查询账号每日接口调用次数
+type GetQuotaUsageRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId       string                 `protobuf:"bytes,2,opt,name=AppId,proto3" json:"AppId,omitempty"`
+	// Date 日期(北京时间), 格式yyyymmdd, 为空时为当日
+	Date string `protobuf:"bytes,3,opt,name=Date,proto3" json:"Date,omitempty"`
+	// WithLimit 同时查询微信返回的当日额度, 每个接口调用一次quota/get
+	WithLimit     bool `protobuf:"varint,4,opt,name=WithLimit,proto3" json:"WithLimit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuotaUsageRequest) Reset() {
+	*x = GetQuotaUsageRequest{}
+	mi := &file_v1_wxproxy_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuotaUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuotaUsageRequest) ProtoMessage() {}
+
+func (x *GetQuotaUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wxproxy_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuotaUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetQuotaUsageRequest) Descriptor() ([]byte, []int) {
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetQuotaUsageRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *GetQuotaUsageRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *GetQuotaUsageRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetQuotaUsageRequest) GetWithLimit() bool {
+	if x != nil {
+		return x.WithLimit
+	}
+	return false
+}
+
+type QuotaUsage struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	CgiPath string                 `protobuf:"bytes,1,opt,name=CgiPath,proto3" json:"CgiPath,omitempty"`
+	// Count WXProxy记录的调用次数
+	Count         int64 `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+	DailyLimit    int64 `protobuf:"varint,3,opt,name=DailyLimit,proto3" json:"DailyLimit,omitempty"`
+	Used          int64 `protobuf:"varint,4,opt,name=Used,proto3" json:"Used,omitempty"`
+	Remain        int64 `protobuf:"varint,5,opt,name=Remain,proto3" json:"Remain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotaUsage) Reset() {
+	*x = QuotaUsage{}
+	mi := &file_v1_wxproxy_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotaUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaUsage) ProtoMessage() {}
+
+func (x *QuotaUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wxproxy_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaUsage.ProtoReflect.Descriptor instead.
+func (*QuotaUsage) Descriptor() ([]byte, []int) {
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *QuotaUsage) GetCgiPath() string {
+	if x != nil {
+		return x.CgiPath
+	}
+	return ""
+}
+
+func (x *QuotaUsage) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *QuotaUsage) GetDailyLimit() int64 {
+	if x != nil {
+		return x.DailyLimit
+	}
+	return 0
+}
+
+func (x *QuotaUsage) GetUsed() int64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *QuotaUsage) GetRemain() int64 {
+	if x != nil {
+		return x.Remain
+	}
+	return 0
+}
+
+type GetQuotaUsageReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=Date,proto3" json:"Date,omitempty"`
+	Items         []*QuotaUsage          `protobuf:"bytes,2,rep,name=Items,proto3" json:"Items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuotaUsageReply) Reset() {
+	*x = GetQuotaUsageReply{}
+	mi := &file_v1_wxproxy_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuotaUsageReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuotaUsageReply) ProtoMessage() {}
+
+func (x *GetQuotaUsageReply) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wxproxy_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuotaUsageReply.ProtoReflect.Descriptor instead.
+func (*GetQuotaUsageReply) Descriptor() ([]byte, []int) {
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetQuotaUsageReply) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetQuotaUsageReply) GetItems() []*QuotaUsage {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
 type GetBlacklistReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,3,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	NextOpenid    string                 `protobuf:"bytes,2,opt,name=NextOpenid,proto3" json:"NextOpenid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -32,7 +988,7 @@ type GetBlacklistReq struct {
 
 func (x *GetBlacklistReq) Reset() {
 	*x = GetBlacklistReq{}
-	mi := &file_v1_wxproxy_proto_msgTypes[0]
+	mi := &file_v1_wxproxy_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -44,7 +1000,7 @@ func (x *GetBlacklistReq) String() string {
 func (*GetBlacklistReq) ProtoMessage() {}
 
 func (x *GetBlacklistReq) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[0]
+	mi := &file_v1_wxproxy_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -57,7 +1013,7 @@ func (x *GetBlacklistReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlacklistReq.ProtoReflect.Descriptor instead.
 func (*GetBlacklistReq) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{0}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetBlacklistReq) GetAccessToken() string {
@@ -67,6 +1023,13 @@ func (x *GetBlacklistReq) GetAccessToken() string {
 	return ""
 }
 
+func (x *GetBlacklistReq) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *GetBlacklistReq) GetNextOpenid() string {
 	if x != nil {
 		return x.NextOpenid
@@ -86,7 +1049,7 @@ type GetBlacklistReply struct {
 
 func (x *GetBlacklistReply) Reset() {
 	*x = GetBlacklistReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[1]
+	mi := &file_v1_wxproxy_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -98,7 +1061,7 @@ func (x *GetBlacklistReply) String() string {
 func (*GetBlacklistReply) ProtoMessage() {}
 
 func (x *GetBlacklistReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[1]
+	mi := &file_v1_wxproxy_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -111,7 +1074,7 @@ func (x *GetBlacklistReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlacklistReply.ProtoReflect.Descriptor instead.
 func (*GetBlacklistReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{1}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetBlacklistReply) GetTotal() int64 {
@@ -145,6 +1108,7 @@ func (x *GetBlacklistReply) GetNextOpenid() string {
 type BlockMemberReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,3,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	OpenIds       []string               `protobuf:"bytes,2,rep,name=OpenIds,proto3" json:"OpenIds,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -152,7 +1116,7 @@ type BlockMemberReq struct {
 
 func (x *BlockMemberReq) Reset() {
 	*x = BlockMemberReq{}
-	mi := &file_v1_wxproxy_proto_msgTypes[2]
+	mi := &file_v1_wxproxy_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -164,7 +1128,7 @@ func (x *BlockMemberReq) String() string {
 func (*BlockMemberReq) ProtoMessage() {}
 
 func (x *BlockMemberReq) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[2]
+	mi := &file_v1_wxproxy_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -177,7 +1141,7 @@ func (x *BlockMemberReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockMemberReq.ProtoReflect.Descriptor instead.
 func (*BlockMemberReq) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{2}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *BlockMemberReq) GetAccessToken() string {
@@ -187,6 +1151,13 @@ func (x *BlockMemberReq) GetAccessToken() string {
 	return ""
 }
 
+func (x *BlockMemberReq) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *BlockMemberReq) GetOpenIds() []string {
 	if x != nil {
 		return x.OpenIds
@@ -197,6 +1168,7 @@ func (x *BlockMemberReq) GetOpenIds() []string {
 type SendKFMiniProgramMsgRequest struct {
 	state           protoimpl.MessageState                        `protogen:"open.v1"`
 	AccessToken     string                                        `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId           string                                        `protobuf:"bytes,5,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	Type            string                                        `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
 	Common          *KFMessageCommon                              `protobuf:"bytes,3,opt,name=Common,proto3" json:"Common,omitempty"`
 	MiniProgramPage *SendKFMiniProgramMsgRequest_KFMiniProgramMsg `protobuf:"bytes,4,opt,name=MiniProgramPage,proto3" json:"MiniProgramPage,omitempty"`
@@ -206,7 +1178,7 @@ type SendKFMiniProgramMsgRequest struct {
 
 func (x *SendKFMiniProgramMsgRequest) Reset() {
 	*x = SendKFMiniProgramMsgRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[3]
+	mi := &file_v1_wxproxy_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -218,7 +1190,7 @@ func (x *SendKFMiniProgramMsgRequest) String() string {
 func (*SendKFMiniProgramMsgRequest) ProtoMessage() {}
 
 func (x *SendKFMiniProgramMsgRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[3]
+	mi := &file_v1_wxproxy_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -231,7 +1203,7 @@ func (x *SendKFMiniProgramMsgRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendKFMiniProgramMsgRequest.ProtoReflect.Descriptor instead.
 func (*SendKFMiniProgramMsgRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{3}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *SendKFMiniProgramMsgRequest) GetAccessToken() string {
@@ -241,6 +1213,13 @@ func (x *SendKFMiniProgramMsgRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *SendKFMiniProgramMsgRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *SendKFMiniProgramMsgRequest) GetType() string {
 	if x != nil {
 		return x.Type
@@ -265,6 +1244,7 @@ func (x *SendKFMiniProgramMsgRequest) GetMiniProgramPage() *SendKFMiniProgramMsg
 type SendKFCardMsgRequest struct {
 	state         protoimpl.MessageState          `protogen:"open.v1"`
 	AccessToken   string                          `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                          `protobuf:"bytes,5,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	Type          string                          `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
 	Common        *KFMessageCommon                `protobuf:"bytes,3,opt,name=Common,proto3" json:"Common,omitempty"`
 	WxCard        *SendKFCardMsgRequest_KFCardMsg `protobuf:"bytes,4,opt,name=WxCard,proto3" json:"WxCard,omitempty"`
@@ -274,7 +1254,7 @@ type SendKFCardMsgRequest struct {
 
 func (x *SendKFCardMsgRequest) Reset() {
 	*x = SendKFCardMsgRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[4]
+	mi := &file_v1_wxproxy_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -286,7 +1266,7 @@ func (x *SendKFCardMsgRequest) String() string {
 func (*SendKFCardMsgRequest) ProtoMessage() {}
 
 func (x *SendKFCardMsgRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[4]
+	mi := &file_v1_wxproxy_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -299,7 +1279,7 @@ func (x *SendKFCardMsgRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendKFCardMsgRequest.ProtoReflect.Descriptor instead.
 func (*SendKFCardMsgRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{4}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *SendKFCardMsgRequest) GetAccessToken() string {
@@ -309,6 +1289,13 @@ func (x *SendKFCardMsgRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *SendKFCardMsgRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *SendKFCardMsgRequest) GetType() string {
 	if x != nil {
 		return x.Type
@@ -335,6 +1322,7 @@ type SendKFMenuMsgRequest struct {
 	Common        *KFMessageCommon              `protobuf:"bytes,1,opt,name=Common,proto3" json:"Common,omitempty"`
 	MsgMenu       *SendKFMenuMsgRequest_MenuMsg `protobuf:"bytes,2,opt,name=MsgMenu,proto3" json:"MsgMenu,omitempty"`
 	AccessToken   string                        `protobuf:"bytes,3,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                        `protobuf:"bytes,5,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	Type          string                        `protobuf:"bytes,4,opt,name=Type,proto3" json:"Type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -342,7 +1330,7 @@ type SendKFMenuMsgRequest struct {
 
 func (x *SendKFMenuMsgRequest) Reset() {
 	*x = SendKFMenuMsgRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[5]
+	mi := &file_v1_wxproxy_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -354,7 +1342,7 @@ func (x *SendKFMenuMsgRequest) String() string {
 func (*SendKFMenuMsgRequest) ProtoMessage() {}
 
 func (x *SendKFMenuMsgRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[5]
+	mi := &file_v1_wxproxy_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -367,7 +1355,7 @@ func (x *SendKFMenuMsgRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendKFMenuMsgRequest.ProtoReflect.Descriptor instead.
 func (*SendKFMenuMsgRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{5}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *SendKFMenuMsgRequest) GetCommon() *KFMessageCommon {
@@ -391,6 +1379,13 @@ func (x *SendKFMenuMsgRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *SendKFMenuMsgRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *SendKFMenuMsgRequest) GetType() string {
 	if x != nil {
 		return x.Type
@@ -401,6 +1396,7 @@ func (x *SendKFMenuMsgRequest) GetType() string {
 type SendKFToArticleMsgRequest struct {
 	state         protoimpl.MessageState                  `protogen:"open.v1"`
 	AccessToken   string                                  `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                                  `protobuf:"bytes,5,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	Type          string                                  `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
 	Common        *KFMessageCommon                        `protobuf:"bytes,3,opt,name=Common,proto3" json:"Common,omitempty"`
 	MpNewsArticle *SendKFToArticleMsgRequest_ToArticleMsg `protobuf:"bytes,4,opt,name=MpNewsArticle,proto3" json:"MpNewsArticle,omitempty"`
@@ -410,7 +1406,7 @@ type SendKFToArticleMsgRequest struct {
 
 func (x *SendKFToArticleMsgRequest) Reset() {
 	*x = SendKFToArticleMsgRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[6]
+	mi := &file_v1_wxproxy_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -422,7 +1418,7 @@ func (x *SendKFToArticleMsgRequest) String() string {
 func (*SendKFToArticleMsgRequest) ProtoMessage() {}
 
 func (x *SendKFToArticleMsgRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[6]
+	mi := &file_v1_wxproxy_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -435,7 +1431,7 @@ func (x *SendKFToArticleMsgRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendKFToArticleMsgRequest.ProtoReflect.Descriptor instead.
 func (*SendKFToArticleMsgRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{6}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *SendKFToArticleMsgRequest) GetAccessToken() string {
@@ -445,6 +1441,13 @@ func (x *SendKFToArticleMsgRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *SendKFToArticleMsgRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *SendKFToArticleMsgRequest) GetType() string {
 	if x != nil {
 		return x.Type
@@ -469,6 +1472,7 @@ func (x *SendKFToArticleMsgRequest) GetMpNewsArticle() *SendKFToArticleMsgReques
 type SendKFNewsPageMsgRequest struct {
 	state         protoimpl.MessageState                  `protogen:"open.v1"`
 	AccessToken   string                                  `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                                  `protobuf:"bytes,5,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	Type          string                                  `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
 	Common        *KFMessageCommon                        `protobuf:"bytes,3,opt,name=Common,proto3" json:"Common,omitempty"`
 	MpNews        *SendKFNewsPageMsgRequest_KFNewsPageMsg `protobuf:"bytes,4,opt,name=MpNews,proto3" json:"MpNews,omitempty"`
@@ -478,7 +1482,7 @@ type SendKFNewsPageMsgRequest struct {
 
 func (x *SendKFNewsPageMsgRequest) Reset() {
 	*x = SendKFNewsPageMsgRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[7]
+	mi := &file_v1_wxproxy_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -490,7 +1494,7 @@ func (x *SendKFNewsPageMsgRequest) String() string {
 func (*SendKFNewsPageMsgRequest) ProtoMessage() {}
 
 func (x *SendKFNewsPageMsgRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[7]
+	mi := &file_v1_wxproxy_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -503,7 +1507,7 @@ func (x *SendKFNewsPageMsgRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendKFNewsPageMsgRequest.ProtoReflect.Descriptor instead.
 func (*SendKFNewsPageMsgRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{7}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *SendKFNewsPageMsgRequest) GetAccessToken() string {
@@ -513,6 +1517,13 @@ func (x *SendKFNewsPageMsgRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *SendKFNewsPageMsgRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *SendKFNewsPageMsgRequest) GetType() string {
 	if x != nil {
 		return x.Type
@@ -537,6 +1548,7 @@ func (x *SendKFNewsPageMsgRequest) GetMpNews() *SendKFNewsPageMsgRequest_KFNewsP
 type SendKFNewsCardMsgRequest struct {
 	state         protoimpl.MessageState                  `protogen:"open.v1"`
 	AccessToken   string                                  `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                                  `protobuf:"bytes,5,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	Type          string                                  `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
 	Common        *KFMessageCommon                        `protobuf:"bytes,3,opt,name=Common,proto3" json:"Common,omitempty"`
 	News          *SendKFNewsCardMsgRequest_KFNewsCardMsg `protobuf:"bytes,4,opt,name=News,proto3" json:"News,omitempty"`
@@ -546,7 +1558,7 @@ type SendKFNewsCardMsgRequest struct {
 
 func (x *SendKFNewsCardMsgRequest) Reset() {
 	*x = SendKFNewsCardMsgRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[8]
+	mi := &file_v1_wxproxy_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -558,7 +1570,7 @@ func (x *SendKFNewsCardMsgRequest) String() string {
 func (*SendKFNewsCardMsgRequest) ProtoMessage() {}
 
 func (x *SendKFNewsCardMsgRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[8]
+	mi := &file_v1_wxproxy_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -571,7 +1583,7 @@ func (x *SendKFNewsCardMsgRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendKFNewsCardMsgRequest.ProtoReflect.Descriptor instead.
 func (*SendKFNewsCardMsgRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{8}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *SendKFNewsCardMsgRequest) GetAccessToken() string {
@@ -581,6 +1593,13 @@ func (x *SendKFNewsCardMsgRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *SendKFNewsCardMsgRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *SendKFNewsCardMsgRequest) GetType() string {
 	if x != nil {
 		return x.Type
@@ -605,6 +1624,7 @@ func (x *SendKFNewsCardMsgRequest) GetNews() *SendKFNewsCardMsgRequest_KFNewsCar
 type SendKFMusicMsgRequest struct {
 	state         protoimpl.MessageState            `protogen:"open.v1"`
 	AccessToken   string                            `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                            `protobuf:"bytes,5,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	Type          string                            `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
 	Common        *KFMessageCommon                  `protobuf:"bytes,3,opt,name=Common,proto3" json:"Common,omitempty"`
 	Music         *SendKFMusicMsgRequest_KFMusicMsg `protobuf:"bytes,4,opt,name=Music,proto3" json:"Music,omitempty"`
@@ -614,7 +1634,7 @@ type SendKFMusicMsgRequest struct {
 
 func (x *SendKFMusicMsgRequest) Reset() {
 	*x = SendKFMusicMsgRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[9]
+	mi := &file_v1_wxproxy_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -626,7 +1646,7 @@ func (x *SendKFMusicMsgRequest) String() string {
 func (*SendKFMusicMsgRequest) ProtoMessage() {}
 
 func (x *SendKFMusicMsgRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[9]
+	mi := &file_v1_wxproxy_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -639,7 +1659,7 @@ func (x *SendKFMusicMsgRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendKFMusicMsgRequest.ProtoReflect.Descriptor instead.
 func (*SendKFMusicMsgRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{9}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *SendKFMusicMsgRequest) GetAccessToken() string {
@@ -649,6 +1669,13 @@ func (x *SendKFMusicMsgRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *SendKFMusicMsgRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *SendKFMusicMsgRequest) GetType() string {
 	if x != nil {
 		return x.Type
@@ -673,6 +1700,7 @@ func (x *SendKFMusicMsgRequest) GetMusic() *SendKFMusicMsgRequest_KFMusicMsg {
 type SendKFVideoMsgRequest struct {
 	state         protoimpl.MessageState            `protogen:"open.v1"`
 	AccessToken   string                            `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                            `protobuf:"bytes,5,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	Type          string                            `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
 	Common        *KFMessageCommon                  `protobuf:"bytes,3,opt,name=Common,proto3" json:"Common,omitempty"`
 	Video         *SendKFVideoMsgRequest_KFVideoMsg `protobuf:"bytes,4,opt,name=Video,proto3" json:"Video,omitempty"`
@@ -682,7 +1710,7 @@ type SendKFVideoMsgRequest struct {
 
 func (x *SendKFVideoMsgRequest) Reset() {
 	*x = SendKFVideoMsgRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[10]
+	mi := &file_v1_wxproxy_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -694,7 +1722,7 @@ func (x *SendKFVideoMsgRequest) String() string {
 func (*SendKFVideoMsgRequest) ProtoMessage() {}
 
 func (x *SendKFVideoMsgRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[10]
+	mi := &file_v1_wxproxy_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -707,7 +1735,7 @@ func (x *SendKFVideoMsgRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendKFVideoMsgRequest.ProtoReflect.Descriptor instead.
 func (*SendKFVideoMsgRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{10}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *SendKFVideoMsgRequest) GetAccessToken() string {
@@ -717,6 +1745,13 @@ func (x *SendKFVideoMsgRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *SendKFVideoMsgRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *SendKFVideoMsgRequest) GetType() string {
 	if x != nil {
 		return x.Type
@@ -741,6 +1776,7 @@ func (x *SendKFVideoMsgRequest) GetVideo() *SendKFVideoMsgRequest_KFVideoMsg {
 type SendKFVoiceMsgRequest struct {
 	state         protoimpl.MessageState            `protogen:"open.v1"`
 	AccessToken   string                            `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                            `protobuf:"bytes,5,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	Type          string                            `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
 	Common        *KFMessageCommon                  `protobuf:"bytes,3,opt,name=Common,proto3" json:"Common,omitempty"`
 	Voice         *SendKFVoiceMsgRequest_KFVoiceMsg `protobuf:"bytes,4,opt,name=Voice,proto3" json:"Voice,omitempty"`
@@ -750,7 +1786,7 @@ type SendKFVoiceMsgRequest struct {
 
 func (x *SendKFVoiceMsgRequest) Reset() {
 	*x = SendKFVoiceMsgRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[11]
+	mi := &file_v1_wxproxy_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -762,7 +1798,7 @@ func (x *SendKFVoiceMsgRequest) String() string {
 func (*SendKFVoiceMsgRequest) ProtoMessage() {}
 
 func (x *SendKFVoiceMsgRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[11]
+	mi := &file_v1_wxproxy_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -775,7 +1811,7 @@ func (x *SendKFVoiceMsgRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendKFVoiceMsgRequest.ProtoReflect.Descriptor instead.
 func (*SendKFVoiceMsgRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{11}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *SendKFVoiceMsgRequest) GetAccessToken() string {
@@ -785,6 +1821,13 @@ func (x *SendKFVoiceMsgRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *SendKFVoiceMsgRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *SendKFVoiceMsgRequest) GetType() string {
 	if x != nil {
 		return x.Type
@@ -809,6 +1852,7 @@ func (x *SendKFVoiceMsgRequest) GetVoice() *SendKFVoiceMsgRequest_KFVoiceMsg {
 type SendKFImageMsgRequest struct {
 	state         protoimpl.MessageState            `protogen:"open.v1"`
 	AccessToken   string                            `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                            `protobuf:"bytes,5,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	Type          string                            `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
 	Common        *KFMessageCommon                  `protobuf:"bytes,3,opt,name=Common,proto3" json:"Common,omitempty"`
 	Image         *SendKFImageMsgRequest_KFImageMsg `protobuf:"bytes,4,opt,name=Image,proto3" json:"Image,omitempty"`
@@ -818,7 +1862,7 @@ type SendKFImageMsgRequest struct {
 
 func (x *SendKFImageMsgRequest) Reset() {
 	*x = SendKFImageMsgRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[12]
+	mi := &file_v1_wxproxy_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -830,7 +1874,7 @@ func (x *SendKFImageMsgRequest) String() string {
 func (*SendKFImageMsgRequest) ProtoMessage() {}
 
 func (x *SendKFImageMsgRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[12]
+	mi := &file_v1_wxproxy_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -843,7 +1887,7 @@ func (x *SendKFImageMsgRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendKFImageMsgRequest.ProtoReflect.Descriptor instead.
 func (*SendKFImageMsgRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{12}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *SendKFImageMsgRequest) GetAccessToken() string {
@@ -853,6 +1897,13 @@ func (x *SendKFImageMsgRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *SendKFImageMsgRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *SendKFImageMsgRequest) GetType() string {
 	if x != nil {
 		return x.Type
@@ -885,7 +1936,7 @@ type KFMessageCommon struct {
 
 func (x *KFMessageCommon) Reset() {
 	*x = KFMessageCommon{}
-	mi := &file_v1_wxproxy_proto_msgTypes[13]
+	mi := &file_v1_wxproxy_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -897,7 +1948,7 @@ func (x *KFMessageCommon) String() string {
 func (*KFMessageCommon) ProtoMessage() {}
 
 func (x *KFMessageCommon) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[13]
+	mi := &file_v1_wxproxy_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -910,7 +1961,7 @@ func (x *KFMessageCommon) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KFMessageCommon.ProtoReflect.Descriptor instead.
 func (*KFMessageCommon) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{13}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *KFMessageCommon) GetToUser() string {
@@ -937,6 +1988,7 @@ func (x *KFMessageCommon) GetCustomerService() *KFMessageCommon_KFAccount {
 type SendKFTextMsgRequest struct {
 	state         protoimpl.MessageState          `protogen:"open.v1"`
 	AccessToken   string                          `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                          `protobuf:"bytes,5,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	Type          string                          `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
 	Common        *KFMessageCommon                `protobuf:"bytes,3,opt,name=Common,proto3" json:"Common,omitempty"`
 	Text          *SendKFTextMsgRequest_KFTextMsg `protobuf:"bytes,4,opt,name=Text,proto3" json:"Text,omitempty"`
@@ -946,7 +1998,7 @@ type SendKFTextMsgRequest struct {
 
 func (x *SendKFTextMsgRequest) Reset() {
 	*x = SendKFTextMsgRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[14]
+	mi := &file_v1_wxproxy_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -958,7 +2010,7 @@ func (x *SendKFTextMsgRequest) String() string {
 func (*SendKFTextMsgRequest) ProtoMessage() {}
 
 func (x *SendKFTextMsgRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[14]
+	mi := &file_v1_wxproxy_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -971,7 +2023,7 @@ func (x *SendKFTextMsgRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendKFTextMsgRequest.ProtoReflect.Descriptor instead.
 func (*SendKFTextMsgRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{14}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *SendKFTextMsgRequest) GetAccessToken() string {
@@ -981,6 +2033,13 @@ func (x *SendKFTextMsgRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *SendKFTextMsgRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *SendKFTextMsgRequest) GetType() string {
 	if x != nil {
 		return x.Type
@@ -1005,6 +2064,7 @@ func (x *SendKFTextMsgRequest) GetText() *SendKFTextMsgRequest_KFTextMsg {
 type NewKFSessionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,4,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	OpenId        string                 `protobuf:"bytes,2,opt,name=OpenId,proto3" json:"OpenId,omitempty"`
 	KfAccount     string                 `protobuf:"bytes,3,opt,name=KfAccount,proto3" json:"KfAccount,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -1013,7 +2073,7 @@ type NewKFSessionRequest struct {
 
 func (x *NewKFSessionRequest) Reset() {
 	*x = NewKFSessionRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[15]
+	mi := &file_v1_wxproxy_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1025,7 +2085,7 @@ func (x *NewKFSessionRequest) String() string {
 func (*NewKFSessionRequest) ProtoMessage() {}
 
 func (x *NewKFSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[15]
+	mi := &file_v1_wxproxy_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1038,7 +2098,7 @@ func (x *NewKFSessionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NewKFSessionRequest.ProtoReflect.Descriptor instead.
 func (*NewKFSessionRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{15}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *NewKFSessionRequest) GetAccessToken() string {
@@ -1048,6 +2108,13 @@ func (x *NewKFSessionRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *NewKFSessionRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *NewKFSessionRequest) GetOpenId() string {
 	if x != nil {
 		return x.OpenId
@@ -1065,6 +2132,7 @@ func (x *NewKFSessionRequest) GetKfAccount() string {
 type CloseKFSessionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,4,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	OpenId        string                 `protobuf:"bytes,2,opt,name=OpenId,proto3" json:"OpenId,omitempty"`
 	KfAccount     string                 `protobuf:"bytes,3,opt,name=KfAccount,proto3" json:"KfAccount,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -1073,7 +2141,7 @@ type CloseKFSessionRequest struct {
 
 func (x *CloseKFSessionRequest) Reset() {
 	*x = CloseKFSessionRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[16]
+	mi := &file_v1_wxproxy_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1085,7 +2153,7 @@ func (x *CloseKFSessionRequest) String() string {
 func (*CloseKFSessionRequest) ProtoMessage() {}
 
 func (x *CloseKFSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[16]
+	mi := &file_v1_wxproxy_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1098,7 +2166,7 @@ func (x *CloseKFSessionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CloseKFSessionRequest.ProtoReflect.Descriptor instead.
 func (*CloseKFSessionRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{16}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *CloseKFSessionRequest) GetAccessToken() string {
@@ -1108,6 +2176,13 @@ func (x *CloseKFSessionRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *CloseKFSessionRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *CloseKFSessionRequest) GetOpenId() string {
 	if x != nil {
 		return x.OpenId
@@ -1132,7 +2207,7 @@ type GetKFSessionUnacceptedReply struct {
 
 func (x *GetKFSessionUnacceptedReply) Reset() {
 	*x = GetKFSessionUnacceptedReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[17]
+	mi := &file_v1_wxproxy_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1144,7 +2219,7 @@ func (x *GetKFSessionUnacceptedReply) String() string {
 func (*GetKFSessionUnacceptedReply) ProtoMessage() {}
 
 func (x *GetKFSessionUnacceptedReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[17]
+	mi := &file_v1_wxproxy_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1157,7 +2232,7 @@ func (x *GetKFSessionUnacceptedReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKFSessionUnacceptedReply.ProtoReflect.Descriptor instead.
 func (*GetKFSessionUnacceptedReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{17}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *GetKFSessionUnacceptedReply) GetCount() int64 {
@@ -1184,7 +2259,7 @@ type GetKFSessionStatusReply struct {
 
 func (x *GetKFSessionStatusReply) Reset() {
 	*x = GetKFSessionStatusReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[18]
+	mi := &file_v1_wxproxy_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1196,7 +2271,7 @@ func (x *GetKFSessionStatusReply) String() string {
 func (*GetKFSessionStatusReply) ProtoMessage() {}
 
 func (x *GetKFSessionStatusReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[18]
+	mi := &file_v1_wxproxy_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1209,7 +2284,7 @@ func (x *GetKFSessionStatusReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKFSessionStatusReply.ProtoReflect.Descriptor instead.
 func (*GetKFSessionStatusReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{18}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *GetKFSessionStatusReply) GetKfAccount() string {
@@ -1229,6 +2304,7 @@ func (x *GetKFSessionStatusReply) GetCreateTime() int64 {
 type GetKFSessionStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,3,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	OpenId        string                 `protobuf:"bytes,2,opt,name=OpenId,proto3" json:"OpenId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1236,7 +2312,7 @@ type GetKFSessionStatusRequest struct {
 
 func (x *GetKFSessionStatusRequest) Reset() {
 	*x = GetKFSessionStatusRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[19]
+	mi := &file_v1_wxproxy_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1248,7 +2324,7 @@ func (x *GetKFSessionStatusRequest) String() string {
 func (*GetKFSessionStatusRequest) ProtoMessage() {}
 
 func (x *GetKFSessionStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[19]
+	mi := &file_v1_wxproxy_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1261,7 +2337,7 @@ func (x *GetKFSessionStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKFSessionStatusRequest.ProtoReflect.Descriptor instead.
 func (*GetKFSessionStatusRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{19}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *GetKFSessionStatusRequest) GetAccessToken() string {
@@ -1271,6 +2347,13 @@ func (x *GetKFSessionStatusRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *GetKFSessionStatusRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *GetKFSessionStatusRequest) GetOpenId() string {
 	if x != nil {
 		return x.OpenId
@@ -1287,7 +2370,7 @@ type GetKFSessionListReply struct {
 
 func (x *GetKFSessionListReply) Reset() {
 	*x = GetKFSessionListReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[20]
+	mi := &file_v1_wxproxy_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1299,7 +2382,7 @@ func (x *GetKFSessionListReply) String() string {
 func (*GetKFSessionListReply) ProtoMessage() {}
 
 func (x *GetKFSessionListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[20]
+	mi := &file_v1_wxproxy_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1312,7 +2395,7 @@ func (x *GetKFSessionListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKFSessionListReply.ProtoReflect.Descriptor instead.
 func (*GetKFSessionListReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{20}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *GetKFSessionListReply) GetSessionList() []*KFSession {
@@ -1332,7 +2415,7 @@ type KFSession struct {
 
 func (x *KFSession) Reset() {
 	*x = KFSession{}
-	mi := &file_v1_wxproxy_proto_msgTypes[21]
+	mi := &file_v1_wxproxy_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1344,7 +2427,7 @@ func (x *KFSession) String() string {
 func (*KFSession) ProtoMessage() {}
 
 func (x *KFSession) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[21]
+	mi := &file_v1_wxproxy_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1357,7 +2440,7 @@ func (x *KFSession) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KFSession.ProtoReflect.Descriptor instead.
 func (*KFSession) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{21}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *KFSession) GetOpenId() string {
@@ -1377,6 +2460,7 @@ func (x *KFSession) GetCreateTime() int64 {
 type GetKFSessionListRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,3,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	KfAccount     string                 `protobuf:"bytes,2,opt,name=KfAccount,proto3" json:"KfAccount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1384,7 +2468,7 @@ type GetKFSessionListRequest struct {
 
 func (x *GetKFSessionListRequest) Reset() {
 	*x = GetKFSessionListRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[22]
+	mi := &file_v1_wxproxy_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1396,7 +2480,7 @@ func (x *GetKFSessionListRequest) String() string {
 func (*GetKFSessionListRequest) ProtoMessage() {}
 
 func (x *GetKFSessionListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[22]
+	mi := &file_v1_wxproxy_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1409,7 +2493,7 @@ func (x *GetKFSessionListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKFSessionListRequest.ProtoReflect.Descriptor instead.
 func (*GetKFSessionListRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{22}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *GetKFSessionListRequest) GetAccessToken() string {
@@ -1419,6 +2503,13 @@ func (x *GetKFSessionListRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *GetKFSessionListRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *GetKFSessionListRequest) GetKfAccount() string {
 	if x != nil {
 		return x.KfAccount
@@ -1429,6 +2520,7 @@ func (x *GetKFSessionListRequest) GetKfAccount() string {
 type UpdateKFTypingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,4,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	Touser        string                 `protobuf:"bytes,2,opt,name=Touser,proto3" json:"Touser,omitempty"`
 	Command       string                 `protobuf:"bytes,3,opt,name=Command,proto3" json:"Command,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -1437,7 +2529,7 @@ type UpdateKFTypingRequest struct {
 
 func (x *UpdateKFTypingRequest) Reset() {
 	*x = UpdateKFTypingRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[23]
+	mi := &file_v1_wxproxy_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1449,7 +2541,7 @@ func (x *UpdateKFTypingRequest) String() string {
 func (*UpdateKFTypingRequest) ProtoMessage() {}
 
 func (x *UpdateKFTypingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[23]
+	mi := &file_v1_wxproxy_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1462,7 +2554,7 @@ func (x *UpdateKFTypingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateKFTypingRequest.ProtoReflect.Descriptor instead.
 func (*UpdateKFTypingRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{23}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *UpdateKFTypingRequest) GetAccessToken() string {
@@ -1472,6 +2564,13 @@ func (x *UpdateKFTypingRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *UpdateKFTypingRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *UpdateKFTypingRequest) GetTouser() string {
 	if x != nil {
 		return x.Touser
@@ -1489,6 +2588,7 @@ func (x *UpdateKFTypingRequest) GetCommand() string {
 type UpdateKFAvatarRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,4,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	KfAccount     string                 `protobuf:"bytes,2,opt,name=KfAccount,proto3" json:"KfAccount,omitempty"`
 	AvatarMediaId string                 `protobuf:"bytes,3,opt,name=AvatarMediaId,proto3" json:"AvatarMediaId,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -1497,7 +2597,7 @@ type UpdateKFAvatarRequest struct {
 
 func (x *UpdateKFAvatarRequest) Reset() {
 	*x = UpdateKFAvatarRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[24]
+	mi := &file_v1_wxproxy_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1509,7 +2609,7 @@ func (x *UpdateKFAvatarRequest) String() string {
 func (*UpdateKFAvatarRequest) ProtoMessage() {}
 
 func (x *UpdateKFAvatarRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[24]
+	mi := &file_v1_wxproxy_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1522,7 +2622,7 @@ func (x *UpdateKFAvatarRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateKFAvatarRequest.ProtoReflect.Descriptor instead.
 func (*UpdateKFAvatarRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{24}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *UpdateKFAvatarRequest) GetAccessToken() string {
@@ -1532,6 +2632,13 @@ func (x *UpdateKFAvatarRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *UpdateKFAvatarRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *UpdateKFAvatarRequest) GetKfAccount() string {
 	if x != nil {
 		return x.KfAccount
@@ -1549,6 +2656,7 @@ func (x *UpdateKFAvatarRequest) GetAvatarMediaId() string {
 type InviteKFWorkerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,4,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	KfAccount     string                 `protobuf:"bytes,2,opt,name=KfAccount,proto3" json:"KfAccount,omitempty"`
 	InviteWx      string                 `protobuf:"bytes,3,opt,name=InviteWx,proto3" json:"InviteWx,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -1557,7 +2665,7 @@ type InviteKFWorkerRequest struct {
 
 func (x *InviteKFWorkerRequest) Reset() {
 	*x = InviteKFWorkerRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[25]
+	mi := &file_v1_wxproxy_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1569,7 +2677,7 @@ func (x *InviteKFWorkerRequest) String() string {
 func (*InviteKFWorkerRequest) ProtoMessage() {}
 
 func (x *InviteKFWorkerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[25]
+	mi := &file_v1_wxproxy_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1582,7 +2690,7 @@ func (x *InviteKFWorkerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InviteKFWorkerRequest.ProtoReflect.Descriptor instead.
 func (*InviteKFWorkerRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{25}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *InviteKFWorkerRequest) GetAccessToken() string {
@@ -1592,6 +2700,13 @@ func (x *InviteKFWorkerRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *InviteKFWorkerRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *InviteKFWorkerRequest) GetKfAccount() string {
 	if x != nil {
 		return x.KfAccount
@@ -1609,6 +2724,7 @@ func (x *InviteKFWorkerRequest) GetInviteWx() string {
 type DelKFAccountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,3,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	KfAccount     string                 `protobuf:"bytes,2,opt,name=KfAccount,proto3" json:"KfAccount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1616,7 +2732,7 @@ type DelKFAccountRequest struct {
 
 func (x *DelKFAccountRequest) Reset() {
 	*x = DelKFAccountRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[26]
+	mi := &file_v1_wxproxy_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1628,7 +2744,7 @@ func (x *DelKFAccountRequest) String() string {
 func (*DelKFAccountRequest) ProtoMessage() {}
 
 func (x *DelKFAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[26]
+	mi := &file_v1_wxproxy_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1641,7 +2757,7 @@ func (x *DelKFAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DelKFAccountRequest.ProtoReflect.Descriptor instead.
 func (*DelKFAccountRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{26}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *DelKFAccountRequest) GetAccessToken() string {
@@ -1651,6 +2767,13 @@ func (x *DelKFAccountRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *DelKFAccountRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *DelKFAccountRequest) GetKfAccount() string {
 	if x != nil {
 		return x.KfAccount
@@ -1661,6 +2784,7 @@ func (x *DelKFAccountRequest) GetKfAccount() string {
 type UpdateKFAccountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,5,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	KfAccount     string                 `protobuf:"bytes,2,opt,name=KfAccount,proto3" json:"KfAccount,omitempty"`
 	Nickname      string                 `protobuf:"bytes,3,opt,name=Nickname,proto3" json:"Nickname,omitempty"`
 	Password      string                 `protobuf:"bytes,4,opt,name=Password,proto3" json:"Password,omitempty"`
@@ -1670,7 +2794,7 @@ type UpdateKFAccountRequest struct {
 
 func (x *UpdateKFAccountRequest) Reset() {
 	*x = UpdateKFAccountRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[27]
+	mi := &file_v1_wxproxy_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1682,7 +2806,7 @@ func (x *UpdateKFAccountRequest) String() string {
 func (*UpdateKFAccountRequest) ProtoMessage() {}
 
 func (x *UpdateKFAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[27]
+	mi := &file_v1_wxproxy_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1695,7 +2819,7 @@ func (x *UpdateKFAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateKFAccountRequest.ProtoReflect.Descriptor instead.
 func (*UpdateKFAccountRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{27}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *UpdateKFAccountRequest) GetAccessToken() string {
@@ -1705,6 +2829,13 @@ func (x *UpdateKFAccountRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *UpdateKFAccountRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *UpdateKFAccountRequest) GetKfAccount() string {
 	if x != nil {
 		return x.KfAccount
@@ -1729,6 +2860,7 @@ func (x *UpdateKFAccountRequest) GetPassword() string {
 type AddKFAccountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,5,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	KfAccount     string                 `protobuf:"bytes,2,opt,name=KfAccount,proto3" json:"KfAccount,omitempty"`
 	Nickname      string                 `protobuf:"bytes,3,opt,name=Nickname,proto3" json:"Nickname,omitempty"`
 	Password      string                 `protobuf:"bytes,4,opt,name=Password,proto3" json:"Password,omitempty"`
@@ -1738,7 +2870,7 @@ type AddKFAccountRequest struct {
 
 func (x *AddKFAccountRequest) Reset() {
 	*x = AddKFAccountRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[28]
+	mi := &file_v1_wxproxy_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1750,7 +2882,7 @@ func (x *AddKFAccountRequest) String() string {
 func (*AddKFAccountRequest) ProtoMessage() {}
 
 func (x *AddKFAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[28]
+	mi := &file_v1_wxproxy_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1763,7 +2895,7 @@ func (x *AddKFAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddKFAccountRequest.ProtoReflect.Descriptor instead.
 func (*AddKFAccountRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{28}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *AddKFAccountRequest) GetAccessToken() string {
@@ -1773,6 +2905,13 @@ func (x *AddKFAccountRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *AddKFAccountRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *AddKFAccountRequest) GetKfAccount() string {
 	if x != nil {
 		return x.KfAccount
@@ -1805,7 +2944,7 @@ type GetKFMsgHistoryReply struct {
 
 func (x *GetKFMsgHistoryReply) Reset() {
 	*x = GetKFMsgHistoryReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[29]
+	mi := &file_v1_wxproxy_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1817,7 +2956,7 @@ func (x *GetKFMsgHistoryReply) String() string {
 func (*GetKFMsgHistoryReply) ProtoMessage() {}
 
 func (x *GetKFMsgHistoryReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[29]
+	mi := &file_v1_wxproxy_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1830,7 +2969,7 @@ func (x *GetKFMsgHistoryReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKFMsgHistoryReply.ProtoReflect.Descriptor instead.
 func (*GetKFMsgHistoryReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{29}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *GetKFMsgHistoryReply) GetMsgId() int64 {
@@ -1867,7 +3006,7 @@ type KFMsgHistory struct {
 
 func (x *KFMsgHistory) Reset() {
 	*x = KFMsgHistory{}
-	mi := &file_v1_wxproxy_proto_msgTypes[30]
+	mi := &file_v1_wxproxy_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1879,7 +3018,7 @@ func (x *KFMsgHistory) String() string {
 func (*KFMsgHistory) ProtoMessage() {}
 
 func (x *KFMsgHistory) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[30]
+	mi := &file_v1_wxproxy_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1892,7 +3031,7 @@ func (x *KFMsgHistory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KFMsgHistory.ProtoReflect.Descriptor instead.
 func (*KFMsgHistory) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{30}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *KFMsgHistory) GetWorker() string {
@@ -1933,6 +3072,7 @@ func (x *KFMsgHistory) GetOpCode() int64 {
 type GetKFMsgHistoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,6,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	StartTime     int64                  `protobuf:"varint,2,opt,name=StartTime,proto3" json:"StartTime,omitempty"`
 	EndTime       int64                  `protobuf:"varint,3,opt,name=EndTime,proto3" json:"EndTime,omitempty"`
 	MsgId         int64                  `protobuf:"varint,4,opt,name=MsgId,proto3" json:"MsgId,omitempty"`
@@ -1943,7 +3083,7 @@ type GetKFMsgHistoryRequest struct {
 
 func (x *GetKFMsgHistoryRequest) Reset() {
 	*x = GetKFMsgHistoryRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[31]
+	mi := &file_v1_wxproxy_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1955,7 +3095,7 @@ func (x *GetKFMsgHistoryRequest) String() string {
 func (*GetKFMsgHistoryRequest) ProtoMessage() {}
 
 func (x *GetKFMsgHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[31]
+	mi := &file_v1_wxproxy_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1968,7 +3108,7 @@ func (x *GetKFMsgHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKFMsgHistoryRequest.ProtoReflect.Descriptor instead.
 func (*GetKFMsgHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{31}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *GetKFMsgHistoryRequest) GetAccessToken() string {
@@ -1978,6 +3118,13 @@ func (x *GetKFMsgHistoryRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *GetKFMsgHistoryRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *GetKFMsgHistoryRequest) GetStartTime() int64 {
 	if x != nil {
 		return x.StartTime
@@ -2015,7 +3162,7 @@ type GetKFOnlineListReply struct {
 
 func (x *GetKFOnlineListReply) Reset() {
 	*x = GetKFOnlineListReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[32]
+	mi := &file_v1_wxproxy_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2027,7 +3174,7 @@ func (x *GetKFOnlineListReply) String() string {
 func (*GetKFOnlineListReply) ProtoMessage() {}
 
 func (x *GetKFOnlineListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[32]
+	mi := &file_v1_wxproxy_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2040,7 +3187,7 @@ func (x *GetKFOnlineListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKFOnlineListReply.ProtoReflect.Descriptor instead.
 func (*GetKFOnlineListReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{32}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *GetKFOnlineListReply) GetKfOnlineList() []*KFOnlineInfo {
@@ -2062,7 +3209,7 @@ type KFOnlineInfo struct {
 
 func (x *KFOnlineInfo) Reset() {
 	*x = KFOnlineInfo{}
-	mi := &file_v1_wxproxy_proto_msgTypes[33]
+	mi := &file_v1_wxproxy_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2074,7 +3221,7 @@ func (x *KFOnlineInfo) String() string {
 func (*KFOnlineInfo) ProtoMessage() {}
 
 func (x *KFOnlineInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[33]
+	mi := &file_v1_wxproxy_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2087,7 +3234,7 @@ func (x *KFOnlineInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KFOnlineInfo.ProtoReflect.Descriptor instead.
 func (*KFOnlineInfo) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{33}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *KFOnlineInfo) GetKfAccount() string {
@@ -2127,7 +3274,7 @@ type GetKFListReply struct {
 
 func (x *GetKFListReply) Reset() {
 	*x = GetKFListReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[34]
+	mi := &file_v1_wxproxy_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2139,7 +3286,7 @@ func (x *GetKFListReply) String() string {
 func (*GetKFListReply) ProtoMessage() {}
 
 func (x *GetKFListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[34]
+	mi := &file_v1_wxproxy_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2152,7 +3299,7 @@ func (x *GetKFListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKFListReply.ProtoReflect.Descriptor instead.
 func (*GetKFListReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{34}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *GetKFListReply) GetKfList() []*KeFuInfo {
@@ -2178,7 +3325,7 @@ type KeFuInfo struct {
 
 func (x *KeFuInfo) Reset() {
 	*x = KeFuInfo{}
-	mi := &file_v1_wxproxy_proto_msgTypes[35]
+	mi := &file_v1_wxproxy_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2190,7 +3337,7 @@ func (x *KeFuInfo) String() string {
 func (*KeFuInfo) ProtoMessage() {}
 
 func (x *KeFuInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[35]
+	mi := &file_v1_wxproxy_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2203,7 +3350,7 @@ func (x *KeFuInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KeFuInfo.ProtoReflect.Descriptor instead.
 func (*KeFuInfo) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{35}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *KeFuInfo) GetKfAccount() string {
@@ -2265,6 +3412,7 @@ func (x *KeFuInfo) GetInviteExpireTime() int64 {
 type SendSubscribeMessageRequest struct {
 	state         protoimpl.MessageState                           `protogen:"open.v1"`
 	AccessToken   string                                           `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                                           `protobuf:"bytes,7,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	Touser        string                                           `protobuf:"bytes,2,opt,name=Touser,proto3" json:"Touser,omitempty"`
 	TemplateId    string                                           `protobuf:"bytes,3,opt,name=TemplateId,proto3" json:"TemplateId,omitempty"`
 	Page          string                                           `protobuf:"bytes,4,opt,name=Page,proto3" json:"Page,omitempty"`
@@ -2276,7 +3424,7 @@ type SendSubscribeMessageRequest struct {
 
 func (x *SendSubscribeMessageRequest) Reset() {
 	*x = SendSubscribeMessageRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[36]
+	mi := &file_v1_wxproxy_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2288,7 +3436,7 @@ func (x *SendSubscribeMessageRequest) String() string {
 func (*SendSubscribeMessageRequest) ProtoMessage() {}
 
 func (x *SendSubscribeMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[36]
+	mi := &file_v1_wxproxy_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2301,7 +3449,7 @@ func (x *SendSubscribeMessageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendSubscribeMessageRequest.ProtoReflect.Descriptor instead.
 func (*SendSubscribeMessageRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{36}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *SendSubscribeMessageRequest) GetAccessToken() string {
@@ -2311,6 +3459,13 @@ func (x *SendSubscribeMessageRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *SendSubscribeMessageRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *SendSubscribeMessageRequest) GetTouser() string {
 	if x != nil {
 		return x.Touser
@@ -2355,7 +3510,7 @@ type GetSubscribePrivateTplReply struct {
 
 func (x *GetSubscribePrivateTplReply) Reset() {
 	*x = GetSubscribePrivateTplReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[37]
+	mi := &file_v1_wxproxy_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2367,7 +3522,7 @@ func (x *GetSubscribePrivateTplReply) String() string {
 func (*GetSubscribePrivateTplReply) ProtoMessage() {}
 
 func (x *GetSubscribePrivateTplReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[37]
+	mi := &file_v1_wxproxy_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2380,7 +3535,7 @@ func (x *GetSubscribePrivateTplReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSubscribePrivateTplReply.ProtoReflect.Descriptor instead.
 func (*GetSubscribePrivateTplReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{37}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *GetSubscribePrivateTplReply) GetData() []*GetSubscribePrivateTplReply_Item {
@@ -2400,7 +3555,7 @@ type GetSubscribeTplTitlesReply struct {
 
 func (x *GetSubscribeTplTitlesReply) Reset() {
 	*x = GetSubscribeTplTitlesReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[38]
+	mi := &file_v1_wxproxy_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2412,7 +3567,7 @@ func (x *GetSubscribeTplTitlesReply) String() string {
 func (*GetSubscribeTplTitlesReply) ProtoMessage() {}
 
 func (x *GetSubscribeTplTitlesReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[38]
+	mi := &file_v1_wxproxy_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2425,7 +3580,7 @@ func (x *GetSubscribeTplTitlesReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSubscribeTplTitlesReply.ProtoReflect.Descriptor instead.
 func (*GetSubscribeTplTitlesReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{38}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *GetSubscribeTplTitlesReply) GetCount() int64 {
@@ -2445,6 +3600,7 @@ func (x *GetSubscribeTplTitlesReply) GetData() []*GetSubscribeTplTitlesReply_Ite
 type GetSubscribeTplTitlesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,5,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	Ids           string                 `protobuf:"bytes,2,opt,name=Ids,proto3" json:"Ids,omitempty"`
 	Limit         int64                  `protobuf:"varint,3,opt,name=Limit,proto3" json:"Limit,omitempty"`
 	Start         int64                  `protobuf:"varint,4,opt,name=Start,proto3" json:"Start,omitempty"`
@@ -2454,7 +3610,7 @@ type GetSubscribeTplTitlesRequest struct {
 
 func (x *GetSubscribeTplTitlesRequest) Reset() {
 	*x = GetSubscribeTplTitlesRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[39]
+	mi := &file_v1_wxproxy_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2466,7 +3622,7 @@ func (x *GetSubscribeTplTitlesRequest) String() string {
 func (*GetSubscribeTplTitlesRequest) ProtoMessage() {}
 
 func (x *GetSubscribeTplTitlesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[39]
+	mi := &file_v1_wxproxy_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2479,7 +3635,7 @@ func (x *GetSubscribeTplTitlesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSubscribeTplTitlesRequest.ProtoReflect.Descriptor instead.
 func (*GetSubscribeTplTitlesRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{39}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *GetSubscribeTplTitlesRequest) GetAccessToken() string {
@@ -2489,6 +3645,13 @@ func (x *GetSubscribeTplTitlesRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *GetSubscribeTplTitlesRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *GetSubscribeTplTitlesRequest) GetIds() string {
 	if x != nil {
 		return x.Ids
@@ -2520,7 +3683,7 @@ type GetSubscribeTplKeywordsReply struct {
 
 func (x *GetSubscribeTplKeywordsReply) Reset() {
 	*x = GetSubscribeTplKeywordsReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[40]
+	mi := &file_v1_wxproxy_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2532,7 +3695,7 @@ func (x *GetSubscribeTplKeywordsReply) String() string {
 func (*GetSubscribeTplKeywordsReply) ProtoMessage() {}
 
 func (x *GetSubscribeTplKeywordsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[40]
+	mi := &file_v1_wxproxy_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2545,7 +3708,7 @@ func (x *GetSubscribeTplKeywordsReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSubscribeTplKeywordsReply.ProtoReflect.Descriptor instead.
 func (*GetSubscribeTplKeywordsReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{40}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *GetSubscribeTplKeywordsReply) GetCount() int64 {
@@ -2565,6 +3728,7 @@ func (x *GetSubscribeTplKeywordsReply) GetData() []*GetSubscribeTplKeywordsReply
 type GetSubscribeTplKeywordsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,3,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	TemplateId    string                 `protobuf:"bytes,2,opt,name=TemplateId,proto3" json:"TemplateId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -2572,7 +3736,7 @@ type GetSubscribeTplKeywordsRequest struct {
 
 func (x *GetSubscribeTplKeywordsRequest) Reset() {
 	*x = GetSubscribeTplKeywordsRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[41]
+	mi := &file_v1_wxproxy_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2584,7 +3748,7 @@ func (x *GetSubscribeTplKeywordsRequest) String() string {
 func (*GetSubscribeTplKeywordsRequest) ProtoMessage() {}
 
 func (x *GetSubscribeTplKeywordsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[41]
+	mi := &file_v1_wxproxy_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2597,7 +3761,7 @@ func (x *GetSubscribeTplKeywordsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSubscribeTplKeywordsRequest.ProtoReflect.Descriptor instead.
 func (*GetSubscribeTplKeywordsRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{41}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *GetSubscribeTplKeywordsRequest) GetAccessToken() string {
@@ -2607,6 +3771,13 @@ func (x *GetSubscribeTplKeywordsRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *GetSubscribeTplKeywordsRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *GetSubscribeTplKeywordsRequest) GetTemplateId() string {
 	if x != nil {
 		return x.TemplateId
@@ -2623,7 +3794,7 @@ type GetSubscribeCategoryReply struct {
 
 func (x *GetSubscribeCategoryReply) Reset() {
 	*x = GetSubscribeCategoryReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[42]
+	mi := &file_v1_wxproxy_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2635,7 +3806,7 @@ func (x *GetSubscribeCategoryReply) String() string {
 func (*GetSubscribeCategoryReply) ProtoMessage() {}
 
 func (x *GetSubscribeCategoryReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[42]
+	mi := &file_v1_wxproxy_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2648,7 +3819,7 @@ func (x *GetSubscribeCategoryReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSubscribeCategoryReply.ProtoReflect.Descriptor instead.
 func (*GetSubscribeCategoryReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{42}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *GetSubscribeCategoryReply) GetData() []*GetSubscribeCategoryReply_Category {
@@ -2661,6 +3832,7 @@ func (x *GetSubscribeCategoryReply) GetData() []*GetSubscribeCategoryReply_Categ
 type DelSubscribeTplRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,3,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	TemplateId    string                 `protobuf:"bytes,2,opt,name=TemplateId,proto3" json:"TemplateId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -2668,7 +3840,7 @@ type DelSubscribeTplRequest struct {
 
 func (x *DelSubscribeTplRequest) Reset() {
 	*x = DelSubscribeTplRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[43]
+	mi := &file_v1_wxproxy_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2680,7 +3852,7 @@ func (x *DelSubscribeTplRequest) String() string {
 func (*DelSubscribeTplRequest) ProtoMessage() {}
 
 func (x *DelSubscribeTplRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[43]
+	mi := &file_v1_wxproxy_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2693,7 +3865,7 @@ func (x *DelSubscribeTplRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DelSubscribeTplRequest.ProtoReflect.Descriptor instead.
 func (*DelSubscribeTplRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{43}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *DelSubscribeTplRequest) GetAccessToken() string {
@@ -2703,6 +3875,13 @@ func (x *DelSubscribeTplRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *DelSubscribeTplRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *DelSubscribeTplRequest) GetTemplateId() string {
 	if x != nil {
 		return x.TemplateId
@@ -2713,6 +3892,7 @@ func (x *DelSubscribeTplRequest) GetTemplateId() string {
 type AddSubscribeTplRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,5,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	Tid           string                 `protobuf:"bytes,2,opt,name=Tid,proto3" json:"Tid,omitempty"`
 	SceneDesc     string                 `protobuf:"bytes,3,opt,name=SceneDesc,proto3" json:"SceneDesc,omitempty"`
 	KidList       []int64                `protobuf:"varint,4,rep,packed,name=KidList,proto3" json:"KidList,omitempty"`
@@ -2722,7 +3902,7 @@ type AddSubscribeTplRequest struct {
 
 func (x *AddSubscribeTplRequest) Reset() {
 	*x = AddSubscribeTplRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[44]
+	mi := &file_v1_wxproxy_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2734,7 +3914,7 @@ func (x *AddSubscribeTplRequest) String() string {
 func (*AddSubscribeTplRequest) ProtoMessage() {}
 
 func (x *AddSubscribeTplRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[44]
+	mi := &file_v1_wxproxy_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2747,7 +3927,7 @@ func (x *AddSubscribeTplRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddSubscribeTplRequest.ProtoReflect.Descriptor instead.
 func (*AddSubscribeTplRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{44}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *AddSubscribeTplRequest) GetAccessToken() string {
@@ -2757,6 +3937,13 @@ func (x *AddSubscribeTplRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *AddSubscribeTplRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *AddSubscribeTplRequest) GetTid() string {
 	if x != nil {
 		return x.Tid
@@ -2787,7 +3974,7 @@ type AddSubscribeTplReply struct {
 
 func (x *AddSubscribeTplReply) Reset() {
 	*x = AddSubscribeTplReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[45]
+	mi := &file_v1_wxproxy_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2799,7 +3986,7 @@ func (x *AddSubscribeTplReply) String() string {
 func (*AddSubscribeTplReply) ProtoMessage() {}
 
 func (x *AddSubscribeTplReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[45]
+	mi := &file_v1_wxproxy_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2812,7 +3999,7 @@ func (x *AddSubscribeTplReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddSubscribeTplReply.ProtoReflect.Descriptor instead.
 func (*AddSubscribeTplReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{45}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *AddSubscribeTplReply) GetTemplateId() string {
@@ -2825,6 +4012,7 @@ func (x *AddSubscribeTplReply) GetTemplateId() string {
 type GetBlockedTplRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,5,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	TmplMsgId     string                 `protobuf:"bytes,2,opt,name=TmplMsgId,proto3" json:"TmplMsgId,omitempty"`
 	LargestId     int64                  `protobuf:"varint,3,opt,name=LargestId,proto3" json:"LargestId,omitempty"`
 	Limit         int64                  `protobuf:"varint,4,opt,name=Limit,proto3" json:"Limit,omitempty"`
@@ -2834,7 +4022,7 @@ type GetBlockedTplRequest struct {
 
 func (x *GetBlockedTplRequest) Reset() {
 	*x = GetBlockedTplRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[46]
+	mi := &file_v1_wxproxy_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2846,7 +4034,7 @@ func (x *GetBlockedTplRequest) String() string {
 func (*GetBlockedTplRequest) ProtoMessage() {}
 
 func (x *GetBlockedTplRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[46]
+	mi := &file_v1_wxproxy_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2859,7 +4047,7 @@ func (x *GetBlockedTplRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockedTplRequest.ProtoReflect.Descriptor instead.
 func (*GetBlockedTplRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{46}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *GetBlockedTplRequest) GetAccessToken() string {
@@ -2869,6 +4057,13 @@ func (x *GetBlockedTplRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *GetBlockedTplRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *GetBlockedTplRequest) GetTmplMsgId() string {
 	if x != nil {
 		return x.TmplMsgId
@@ -2899,7 +4094,7 @@ type GetBlockedTplMsgReply struct {
 
 func (x *GetBlockedTplMsgReply) Reset() {
 	*x = GetBlockedTplMsgReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[47]
+	mi := &file_v1_wxproxy_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2911,7 +4106,7 @@ func (x *GetBlockedTplMsgReply) String() string {
 func (*GetBlockedTplMsgReply) ProtoMessage() {}
 
 func (x *GetBlockedTplMsgReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[47]
+	mi := &file_v1_wxproxy_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2924,7 +4119,7 @@ func (x *GetBlockedTplMsgReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockedTplMsgReply.ProtoReflect.Descriptor instead.
 func (*GetBlockedTplMsgReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{47}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *GetBlockedTplMsgReply) GetMsginfo() []*GetBlockedTplMsgReply_BlockedMsgInfo {
@@ -2937,6 +4132,7 @@ func (x *GetBlockedTplMsgReply) GetMsginfo() []*GetBlockedTplMsgReply_BlockedMsg
 type SendSubscribeMsgRequest struct {
 	state         protoimpl.MessageState                       `protogen:"open.v1"`
 	AccessToken   string                                       `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                                       `protobuf:"bytes,10,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	Touser        string                                       `protobuf:"bytes,2,opt,name=Touser,proto3" json:"Touser,omitempty"`
 	TemplateId    string                                       `protobuf:"bytes,3,opt,name=TemplateId,proto3" json:"TemplateId,omitempty"`
 	Url           string                                       `protobuf:"bytes,4,opt,name=Url,proto3" json:"Url,omitempty"`
@@ -2951,7 +4147,7 @@ type SendSubscribeMsgRequest struct {
 
 func (x *SendSubscribeMsgRequest) Reset() {
 	*x = SendSubscribeMsgRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[48]
+	mi := &file_v1_wxproxy_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2963,7 +4159,7 @@ func (x *SendSubscribeMsgRequest) String() string {
 func (*SendSubscribeMsgRequest) ProtoMessage() {}
 
 func (x *SendSubscribeMsgRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[48]
+	mi := &file_v1_wxproxy_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2976,7 +4172,7 @@ func (x *SendSubscribeMsgRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendSubscribeMsgRequest.ProtoReflect.Descriptor instead.
 func (*SendSubscribeMsgRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{48}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *SendSubscribeMsgRequest) GetAccessToken() string {
@@ -2986,6 +4182,13 @@ func (x *SendSubscribeMsgRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *SendSubscribeMsgRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *SendSubscribeMsgRequest) GetTouser() string {
 	if x != nil {
 		return x.Touser
@@ -3051,7 +4254,7 @@ type SendTplMsgReply struct {
 
 func (x *SendTplMsgReply) Reset() {
 	*x = SendTplMsgReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[49]
+	mi := &file_v1_wxproxy_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3063,7 +4266,7 @@ func (x *SendTplMsgReply) String() string {
 func (*SendTplMsgReply) ProtoMessage() {}
 
 func (x *SendTplMsgReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[49]
+	mi := &file_v1_wxproxy_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3076,7 +4279,7 @@ func (x *SendTplMsgReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendTplMsgReply.ProtoReflect.Descriptor instead.
 func (*SendTplMsgReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{49}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *SendTplMsgReply) GetMsgid() int64 {
@@ -3089,6 +4292,7 @@ func (x *SendTplMsgReply) GetMsgid() int64 {
 type SendTplMsgRequest struct {
 	state         protoimpl.MessageState                 `protogen:"open.v1"`
 	AccessToken   string                                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                                 `protobuf:"bytes,8,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	Touser        string                                 `protobuf:"bytes,2,opt,name=Touser,proto3" json:"Touser,omitempty"`
 	TemplateId    string                                 `protobuf:"bytes,3,opt,name=TemplateId,proto3" json:"TemplateId,omitempty"`
 	Url           string                                 `protobuf:"bytes,4,opt,name=Url,proto3" json:"Url,omitempty"`
@@ -3101,7 +4305,7 @@ type SendTplMsgRequest struct {
 
 func (x *SendTplMsgRequest) Reset() {
 	*x = SendTplMsgRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[50]
+	mi := &file_v1_wxproxy_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3113,7 +4317,7 @@ func (x *SendTplMsgRequest) String() string {
 func (*SendTplMsgRequest) ProtoMessage() {}
 
 func (x *SendTplMsgRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[50]
+	mi := &file_v1_wxproxy_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3126,7 +4330,7 @@ func (x *SendTplMsgRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendTplMsgRequest.ProtoReflect.Descriptor instead.
 func (*SendTplMsgRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{50}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *SendTplMsgRequest) GetAccessToken() string {
@@ -3136,6 +4340,13 @@ func (x *SendTplMsgRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *SendTplMsgRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *SendTplMsgRequest) GetTouser() string {
 	if x != nil {
 		return x.Touser
@@ -3188,7 +4399,7 @@ type MiniProgram struct {
 
 func (x *MiniProgram) Reset() {
 	*x = MiniProgram{}
-	mi := &file_v1_wxproxy_proto_msgTypes[51]
+	mi := &file_v1_wxproxy_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3200,7 +4411,7 @@ func (x *MiniProgram) String() string {
 func (*MiniProgram) ProtoMessage() {}
 
 func (x *MiniProgram) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[51]
+	mi := &file_v1_wxproxy_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3213,7 +4424,7 @@ func (x *MiniProgram) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MiniProgram.ProtoReflect.Descriptor instead.
 func (*MiniProgram) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{51}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *MiniProgram) GetAppid() string {
@@ -3233,6 +4444,7 @@ func (x *MiniProgram) GetPagePath() string {
 type DeleteMessageTplRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,3,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	TemplateId    string                 `protobuf:"bytes,2,opt,name=TemplateId,proto3" json:"TemplateId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -3240,7 +4452,7 @@ type DeleteMessageTplRequest struct {
 
 func (x *DeleteMessageTplRequest) Reset() {
 	*x = DeleteMessageTplRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[52]
+	mi := &file_v1_wxproxy_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3252,7 +4464,7 @@ func (x *DeleteMessageTplRequest) String() string {
 func (*DeleteMessageTplRequest) ProtoMessage() {}
 
 func (x *DeleteMessageTplRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[52]
+	mi := &file_v1_wxproxy_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3265,7 +4477,7 @@ func (x *DeleteMessageTplRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteMessageTplRequest.ProtoReflect.Descriptor instead.
 func (*DeleteMessageTplRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{52}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *DeleteMessageTplRequest) GetAccessToken() string {
@@ -3275,6 +4487,13 @@ func (x *DeleteMessageTplRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *DeleteMessageTplRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *DeleteMessageTplRequest) GetTemplateId() string {
 	if x != nil {
 		return x.TemplateId
@@ -3285,6 +4504,7 @@ func (x *DeleteMessageTplRequest) GetTemplateId() string {
 type AddTemplateRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken     string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId           string                 `protobuf:"bytes,4,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	TemplateIdShort string                 `protobuf:"bytes,2,opt,name=TemplateIdShort,proto3" json:"TemplateIdShort,omitempty"`
 	KeywordNameList []string               `protobuf:"bytes,3,rep,name=KeywordNameList,proto3" json:"KeywordNameList,omitempty"`
 	unknownFields   protoimpl.UnknownFields
@@ -3293,7 +4513,7 @@ type AddTemplateRequest struct {
 
 func (x *AddTemplateRequest) Reset() {
 	*x = AddTemplateRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[53]
+	mi := &file_v1_wxproxy_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3305,7 +4525,7 @@ func (x *AddTemplateRequest) String() string {
 func (*AddTemplateRequest) ProtoMessage() {}
 
 func (x *AddTemplateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[53]
+	mi := &file_v1_wxproxy_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3318,7 +4538,7 @@ func (x *AddTemplateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddTemplateRequest.ProtoReflect.Descriptor instead.
 func (*AddTemplateRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{53}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *AddTemplateRequest) GetAccessToken() string {
@@ -3328,6 +4548,13 @@ func (x *AddTemplateRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *AddTemplateRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *AddTemplateRequest) GetTemplateIdShort() string {
 	if x != nil {
 		return x.TemplateIdShort
@@ -3351,7 +4578,7 @@ type AddMessageTplReply struct {
 
 func (x *AddMessageTplReply) Reset() {
 	*x = AddMessageTplReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[54]
+	mi := &file_v1_wxproxy_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3363,7 +4590,7 @@ func (x *AddMessageTplReply) String() string {
 func (*AddMessageTplReply) ProtoMessage() {}
 
 func (x *AddMessageTplReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[54]
+	mi := &file_v1_wxproxy_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3376,7 +4603,7 @@ func (x *AddMessageTplReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddMessageTplReply.ProtoReflect.Descriptor instead.
 func (*AddMessageTplReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{54}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *AddMessageTplReply) GetTemplateId() string {
@@ -3395,7 +4622,7 @@ type GetAllPrivateTplReply struct {
 
 func (x *GetAllPrivateTplReply) Reset() {
 	*x = GetAllPrivateTplReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[55]
+	mi := &file_v1_wxproxy_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3407,7 +4634,7 @@ func (x *GetAllPrivateTplReply) String() string {
 func (*GetAllPrivateTplReply) ProtoMessage() {}
 
 func (x *GetAllPrivateTplReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[55]
+	mi := &file_v1_wxproxy_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3420,7 +4647,7 @@ func (x *GetAllPrivateTplReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllPrivateTplReply.ProtoReflect.Descriptor instead.
 func (*GetAllPrivateTplReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{55}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *GetAllPrivateTplReply) GetTemplateList() []*GetAllPrivateTplReply_TplInfo {
@@ -3433,6 +4660,7 @@ func (x *GetAllPrivateTplReply) GetTemplateList() []*GetAllPrivateTplReply_TplIn
 type SetIndustryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,4,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	IndustryId1   string                 `protobuf:"bytes,2,opt,name=IndustryId1,proto3" json:"IndustryId1,omitempty"`
 	IndustryId2   string                 `protobuf:"bytes,3,opt,name=IndustryId2,proto3" json:"IndustryId2,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -3441,7 +4669,7 @@ type SetIndustryRequest struct {
 
 func (x *SetIndustryRequest) Reset() {
 	*x = SetIndustryRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[56]
+	mi := &file_v1_wxproxy_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3453,7 +4681,7 @@ func (x *SetIndustryRequest) String() string {
 func (*SetIndustryRequest) ProtoMessage() {}
 
 func (x *SetIndustryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[56]
+	mi := &file_v1_wxproxy_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3466,7 +4694,7 @@ func (x *SetIndustryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetIndustryRequest.ProtoReflect.Descriptor instead.
 func (*SetIndustryRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{56}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *SetIndustryRequest) GetAccessToken() string {
@@ -3476,6 +4704,13 @@ func (x *SetIndustryRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *SetIndustryRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *SetIndustryRequest) GetIndustryId1() string {
 	if x != nil {
 		return x.IndustryId1
@@ -3500,7 +4735,7 @@ type GetIndustryReply struct {
 
 func (x *GetIndustryReply) Reset() {
 	*x = GetIndustryReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[57]
+	mi := &file_v1_wxproxy_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3512,7 +4747,7 @@ func (x *GetIndustryReply) String() string {
 func (*GetIndustryReply) ProtoMessage() {}
 
 func (x *GetIndustryReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[57]
+	mi := &file_v1_wxproxy_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3525,7 +4760,7 @@ func (x *GetIndustryReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetIndustryReply.ProtoReflect.Descriptor instead.
 func (*GetIndustryReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{57}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *GetIndustryReply) GetPrimaryIndustry() *GetIndustryReply_Industry {
@@ -3545,6 +4780,7 @@ func (x *GetIndustryReply) GetSecondaryIndustry() *GetIndustryReply_Industry {
 type DeleteConditionalMenuRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,3,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	Menuid        int64                  `protobuf:"varint,2,opt,name=Menuid,proto3" json:"Menuid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -3552,7 +4788,7 @@ type DeleteConditionalMenuRequest struct {
 
 func (x *DeleteConditionalMenuRequest) Reset() {
 	*x = DeleteConditionalMenuRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[58]
+	mi := &file_v1_wxproxy_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3564,7 +4800,7 @@ func (x *DeleteConditionalMenuRequest) String() string {
 func (*DeleteConditionalMenuRequest) ProtoMessage() {}
 
 func (x *DeleteConditionalMenuRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[58]
+	mi := &file_v1_wxproxy_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3577,7 +4813,7 @@ func (x *DeleteConditionalMenuRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteConditionalMenuRequest.ProtoReflect.Descriptor instead.
 func (*DeleteConditionalMenuRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{58}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *DeleteConditionalMenuRequest) GetAccessToken() string {
@@ -3587,6 +4823,13 @@ func (x *DeleteConditionalMenuRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *DeleteConditionalMenuRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *DeleteConditionalMenuRequest) GetMenuid() int64 {
 	if x != nil {
 		return x.Menuid
@@ -3597,6 +4840,7 @@ func (x *DeleteConditionalMenuRequest) GetMenuid() int64 {
 type CreateMenuRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,4,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	Button        []*MenuButton          `protobuf:"bytes,2,rep,name=Button,proto3" json:"Button,omitempty"`
 	Matchrule     *ConditionalMatchRule  `protobuf:"bytes,3,opt,name=Matchrule,proto3" json:"Matchrule,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -3605,7 +4849,7 @@ type CreateMenuRequest struct {
 
 func (x *CreateMenuRequest) Reset() {
 	*x = CreateMenuRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[59]
+	mi := &file_v1_wxproxy_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3617,7 +4861,7 @@ func (x *CreateMenuRequest) String() string {
 func (*CreateMenuRequest) ProtoMessage() {}
 
 func (x *CreateMenuRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[59]
+	mi := &file_v1_wxproxy_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3630,7 +4874,7 @@ func (x *CreateMenuRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateMenuRequest.ProtoReflect.Descriptor instead.
 func (*CreateMenuRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{59}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *CreateMenuRequest) GetAccessToken() string {
@@ -3640,6 +4884,13 @@ func (x *CreateMenuRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *CreateMenuRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *CreateMenuRequest) GetButton() []*MenuButton {
 	if x != nil {
 		return x.Button
@@ -3664,7 +4915,7 @@ type SelfMenuReply struct {
 
 func (x *SelfMenuReply) Reset() {
 	*x = SelfMenuReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[60]
+	mi := &file_v1_wxproxy_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3676,7 +4927,7 @@ func (x *SelfMenuReply) String() string {
 func (*SelfMenuReply) ProtoMessage() {}
 
 func (x *SelfMenuReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[60]
+	mi := &file_v1_wxproxy_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3689,7 +4940,7 @@ func (x *SelfMenuReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SelfMenuReply.ProtoReflect.Descriptor instead.
 func (*SelfMenuReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{60}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *SelfMenuReply) GetIsMenuOpen() int64 {
@@ -3721,7 +4972,7 @@ type SelfMenuButton struct {
 
 func (x *SelfMenuButton) Reset() {
 	*x = SelfMenuButton{}
-	mi := &file_v1_wxproxy_proto_msgTypes[61]
+	mi := &file_v1_wxproxy_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3733,7 +4984,7 @@ func (x *SelfMenuButton) String() string {
 func (*SelfMenuButton) ProtoMessage() {}
 
 func (x *SelfMenuButton) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[61]
+	mi := &file_v1_wxproxy_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3746,7 +4997,7 @@ func (x *SelfMenuButton) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SelfMenuButton.ProtoReflect.Descriptor instead.
 func (*SelfMenuButton) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{61}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *SelfMenuButton) GetType() string {
@@ -3813,7 +5064,7 @@ type NewsButton struct {
 
 func (x *NewsButton) Reset() {
 	*x = NewsButton{}
-	mi := &file_v1_wxproxy_proto_msgTypes[62]
+	mi := &file_v1_wxproxy_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3825,7 +5076,7 @@ func (x *NewsButton) String() string {
 func (*NewsButton) ProtoMessage() {}
 
 func (x *NewsButton) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[62]
+	mi := &file_v1_wxproxy_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3838,7 +5089,7 @@ func (x *NewsButton) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NewsButton.ProtoReflect.Descriptor instead.
 func (*NewsButton) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{62}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *NewsButton) GetTitle() string {
@@ -3893,6 +5144,7 @@ func (x *NewsButton) GetShowCover() int64 {
 type TryMatchMenuRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,3,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	UserId        string                 `protobuf:"bytes,2,opt,name=UserId,proto3" json:"UserId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -3900,7 +5152,7 @@ type TryMatchMenuRequest struct {
 
 func (x *TryMatchMenuRequest) Reset() {
 	*x = TryMatchMenuRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[63]
+	mi := &file_v1_wxproxy_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3912,7 +5164,7 @@ func (x *TryMatchMenuRequest) String() string {
 func (*TryMatchMenuRequest) ProtoMessage() {}
 
 func (x *TryMatchMenuRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[63]
+	mi := &file_v1_wxproxy_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3925,7 +5177,7 @@ func (x *TryMatchMenuRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TryMatchMenuRequest.ProtoReflect.Descriptor instead.
 func (*TryMatchMenuRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{63}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *TryMatchMenuRequest) GetAccessToken() string {
@@ -3935,6 +5187,13 @@ func (x *TryMatchMenuRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *TryMatchMenuRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *TryMatchMenuRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
@@ -3951,7 +5210,7 @@ type TryMatchMenuReply struct {
 
 func (x *TryMatchMenuReply) Reset() {
 	*x = TryMatchMenuReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[64]
+	mi := &file_v1_wxproxy_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3963,7 +5222,7 @@ func (x *TryMatchMenuReply) String() string {
 func (*TryMatchMenuReply) ProtoMessage() {}
 
 func (x *TryMatchMenuReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[64]
+	mi := &file_v1_wxproxy_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3976,7 +5235,7 @@ func (x *TryMatchMenuReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TryMatchMenuReply.ProtoReflect.Descriptor instead.
 func (*TryMatchMenuReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{64}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *TryMatchMenuReply) GetButton() []*MenuButton {
@@ -3996,7 +5255,7 @@ type MenuInfoReply struct {
 
 func (x *MenuInfoReply) Reset() {
 	*x = MenuInfoReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[65]
+	mi := &file_v1_wxproxy_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4008,7 +5267,7 @@ func (x *MenuInfoReply) String() string {
 func (*MenuInfoReply) ProtoMessage() {}
 
 func (x *MenuInfoReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[65]
+	mi := &file_v1_wxproxy_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4021,7 +5280,7 @@ func (x *MenuInfoReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MenuInfoReply.ProtoReflect.Descriptor instead.
 func (*MenuInfoReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{65}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *MenuInfoReply) GetMenu() *MenuInfoReply_MenuType {
@@ -4054,7 +5313,7 @@ type MenuButton struct {
 
 func (x *MenuButton) Reset() {
 	*x = MenuButton{}
-	mi := &file_v1_wxproxy_proto_msgTypes[66]
+	mi := &file_v1_wxproxy_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4066,7 +5325,7 @@ func (x *MenuButton) String() string {
 func (*MenuButton) ProtoMessage() {}
 
 func (x *MenuButton) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[66]
+	mi := &file_v1_wxproxy_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4079,7 +5338,7 @@ func (x *MenuButton) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MenuButton.ProtoReflect.Descriptor instead.
 func (*MenuButton) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{66}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *MenuButton) GetType() string {
@@ -4149,7 +5408,7 @@ type ConditionalMenu struct {
 
 func (x *ConditionalMenu) Reset() {
 	*x = ConditionalMenu{}
-	mi := &file_v1_wxproxy_proto_msgTypes[67]
+	mi := &file_v1_wxproxy_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4161,7 +5420,7 @@ func (x *ConditionalMenu) String() string {
 func (*ConditionalMenu) ProtoMessage() {}
 
 func (x *ConditionalMenu) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[67]
+	mi := &file_v1_wxproxy_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4174,7 +5433,7 @@ func (x *ConditionalMenu) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConditionalMenu.ProtoReflect.Descriptor instead.
 func (*ConditionalMenu) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{67}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *ConditionalMenu) GetMenuid() int64 {
@@ -4208,7 +5467,7 @@ type ConditionalMatchRule struct {
 
 func (x *ConditionalMatchRule) Reset() {
 	*x = ConditionalMatchRule{}
-	mi := &file_v1_wxproxy_proto_msgTypes[68]
+	mi := &file_v1_wxproxy_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4220,7 +5479,7 @@ func (x *ConditionalMatchRule) String() string {
 func (*ConditionalMatchRule) ProtoMessage() {}
 
 func (x *ConditionalMatchRule) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[68]
+	mi := &file_v1_wxproxy_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4233,7 +5492,7 @@ func (x *ConditionalMatchRule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConditionalMatchRule.ProtoReflect.Descriptor instead.
 func (*ConditionalMatchRule) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{68}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *ConditionalMatchRule) GetTagId() string {
@@ -4254,13 +5513,14 @@ type FetchShortenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ShortKey      string                 `protobuf:"bytes,1,opt,name=ShortKey,proto3" json:"ShortKey,omitempty"`
 	AccessToken   string                 `protobuf:"bytes,2,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,3,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *FetchShortenRequest) Reset() {
 	*x = FetchShortenRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[69]
+	mi := &file_v1_wxproxy_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4272,7 +5532,7 @@ func (x *FetchShortenRequest) String() string {
 func (*FetchShortenRequest) ProtoMessage() {}
 
 func (x *FetchShortenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[69]
+	mi := &file_v1_wxproxy_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4285,7 +5545,7 @@ func (x *FetchShortenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchShortenRequest.ProtoReflect.Descriptor instead.
 func (*FetchShortenRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{69}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *FetchShortenRequest) GetShortKey() string {
@@ -4302,6 +5562,13 @@ func (x *FetchShortenRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *FetchShortenRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 type FetchShortenReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	LongData      string                 `protobuf:"bytes,1,opt,name=LongData,proto3" json:"LongData,omitempty"`
@@ -4313,7 +5580,7 @@ type FetchShortenReply struct {
 
 func (x *FetchShortenReply) Reset() {
 	*x = FetchShortenReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[70]
+	mi := &file_v1_wxproxy_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4325,7 +5592,7 @@ func (x *FetchShortenReply) String() string {
 func (*FetchShortenReply) ProtoMessage() {}
 
 func (x *FetchShortenReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[70]
+	mi := &file_v1_wxproxy_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4338,7 +5605,7 @@ func (x *FetchShortenReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchShortenReply.ProtoReflect.Descriptor instead.
 func (*FetchShortenReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{70}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *FetchShortenReply) GetLongData() string {
@@ -4365,6 +5632,7 @@ func (x *FetchShortenReply) GetExpireSeconds() int64 {
 type GenShortenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,4,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	LongData      string                 `protobuf:"bytes,2,opt,name=LongData,proto3" json:"LongData,omitempty"`
 	ExpireSeconds int64                  `protobuf:"varint,3,opt,name=ExpireSeconds,proto3" json:"ExpireSeconds,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -4373,7 +5641,7 @@ type GenShortenRequest struct {
 
 func (x *GenShortenRequest) Reset() {
 	*x = GenShortenRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[71]
+	mi := &file_v1_wxproxy_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4385,7 +5653,7 @@ func (x *GenShortenRequest) String() string {
 func (*GenShortenRequest) ProtoMessage() {}
 
 func (x *GenShortenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[71]
+	mi := &file_v1_wxproxy_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4398,7 +5666,7 @@ func (x *GenShortenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenShortenRequest.ProtoReflect.Descriptor instead.
 func (*GenShortenRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{71}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *GenShortenRequest) GetAccessToken() string {
@@ -4408,6 +5676,13 @@ func (x *GenShortenRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *GenShortenRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *GenShortenRequest) GetLongData() string {
 	if x != nil {
 		return x.LongData
@@ -4431,7 +5706,7 @@ type GenShortenReply struct {
 
 func (x *GenShortenReply) Reset() {
 	*x = GenShortenReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[72]
+	mi := &file_v1_wxproxy_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4443,7 +5718,7 @@ func (x *GenShortenReply) String() string {
 func (*GenShortenReply) ProtoMessage() {}
 
 func (x *GenShortenReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[72]
+	mi := &file_v1_wxproxy_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4456,7 +5731,7 @@ func (x *GenShortenReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenShortenReply.ProtoReflect.Descriptor instead.
 func (*GenShortenReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{72}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *GenShortenReply) GetShortKey() string {
@@ -4477,7 +5752,7 @@ type CreateQRCodeReply struct {
 
 func (x *CreateQRCodeReply) Reset() {
 	*x = CreateQRCodeReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[73]
+	mi := &file_v1_wxproxy_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4489,7 +5764,7 @@ func (x *CreateQRCodeReply) String() string {
 func (*CreateQRCodeReply) ProtoMessage() {}
 
 func (x *CreateQRCodeReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[73]
+	mi := &file_v1_wxproxy_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4502,7 +5777,7 @@ func (x *CreateQRCodeReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateQRCodeReply.ProtoReflect.Descriptor instead.
 func (*CreateQRCodeReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{73}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *CreateQRCodeReply) GetTicket() string {
@@ -4529,6 +5804,7 @@ func (x *CreateQRCodeReply) GetURL() string {
 type CreateQRCodeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,4,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	ExpireSeconds int64                  `protobuf:"varint,2,opt,name=ExpireSeconds,proto3" json:"ExpireSeconds,omitempty"`
 	Scene         string                 `protobuf:"bytes,3,opt,name=Scene,proto3" json:"Scene,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -4537,7 +5813,7 @@ type CreateQRCodeRequest struct {
 
 func (x *CreateQRCodeRequest) Reset() {
 	*x = CreateQRCodeRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[74]
+	mi := &file_v1_wxproxy_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4549,7 +5825,7 @@ func (x *CreateQRCodeRequest) String() string {
 func (*CreateQRCodeRequest) ProtoMessage() {}
 
 func (x *CreateQRCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[74]
+	mi := &file_v1_wxproxy_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4562,7 +5838,7 @@ func (x *CreateQRCodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateQRCodeRequest.ProtoReflect.Descriptor instead.
 func (*CreateQRCodeRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{74}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *CreateQRCodeRequest) GetAccessToken() string {
@@ -4572,6 +5848,13 @@ func (x *CreateQRCodeRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *CreateQRCodeRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *CreateQRCodeRequest) GetExpireSeconds() int64 {
 	if x != nil {
 		return x.ExpireSeconds
@@ -4589,6 +5872,7 @@ func (x *CreateQRCodeRequest) GetScene() string {
 type BatchUnTaggingMembersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,4,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	Id            int64                  `protobuf:"varint,2,opt,name=Id,proto3" json:"Id,omitempty"`
 	OpenidList    []string               `protobuf:"bytes,3,rep,name=OpenidList,proto3" json:"OpenidList,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -4597,7 +5881,7 @@ type BatchUnTaggingMembersRequest struct {
 
 func (x *BatchUnTaggingMembersRequest) Reset() {
 	*x = BatchUnTaggingMembersRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[75]
+	mi := &file_v1_wxproxy_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4609,7 +5893,7 @@ func (x *BatchUnTaggingMembersRequest) String() string {
 func (*BatchUnTaggingMembersRequest) ProtoMessage() {}
 
 func (x *BatchUnTaggingMembersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[75]
+	mi := &file_v1_wxproxy_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4622,7 +5906,7 @@ func (x *BatchUnTaggingMembersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchUnTaggingMembersRequest.ProtoReflect.Descriptor instead.
 func (*BatchUnTaggingMembersRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{75}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *BatchUnTaggingMembersRequest) GetAccessToken() string {
@@ -4632,6 +5916,13 @@ func (x *BatchUnTaggingMembersRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *BatchUnTaggingMembersRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *BatchUnTaggingMembersRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
@@ -4649,6 +5940,7 @@ func (x *BatchUnTaggingMembersRequest) GetOpenidList() []string {
 type BatchTaggingMembersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,4,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	Id            int64                  `protobuf:"varint,2,opt,name=Id,proto3" json:"Id,omitempty"`
 	OpenidList    []string               `protobuf:"bytes,3,rep,name=OpenidList,proto3" json:"OpenidList,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -4657,7 +5949,7 @@ type BatchTaggingMembersRequest struct {
 
 func (x *BatchTaggingMembersRequest) Reset() {
 	*x = BatchTaggingMembersRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[76]
+	mi := &file_v1_wxproxy_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4669,7 +5961,7 @@ func (x *BatchTaggingMembersRequest) String() string {
 func (*BatchTaggingMembersRequest) ProtoMessage() {}
 
 func (x *BatchTaggingMembersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[76]
+	mi := &file_v1_wxproxy_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4682,7 +5974,7 @@ func (x *BatchTaggingMembersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchTaggingMembersRequest.ProtoReflect.Descriptor instead.
 func (*BatchTaggingMembersRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{76}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *BatchTaggingMembersRequest) GetAccessToken() string {
@@ -4692,6 +5984,13 @@ func (x *BatchTaggingMembersRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *BatchTaggingMembersRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *BatchTaggingMembersRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
@@ -4717,7 +6016,7 @@ type GetTagMembersReply struct {
 
 func (x *GetTagMembersReply) Reset() {
 	*x = GetTagMembersReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[77]
+	mi := &file_v1_wxproxy_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4729,7 +6028,7 @@ func (x *GetTagMembersReply) String() string {
 func (*GetTagMembersReply) ProtoMessage() {}
 
 func (x *GetTagMembersReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[77]
+	mi := &file_v1_wxproxy_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4742,7 +6041,7 @@ func (x *GetTagMembersReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTagMembersReply.ProtoReflect.Descriptor instead.
 func (*GetTagMembersReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{77}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *GetTagMembersReply) GetCount() int64 {
@@ -4769,6 +6068,7 @@ func (x *GetTagMembersReply) GetData() *GetTagMembersReply_DataT {
 type GetTagMembersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,4,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	Id            int64                  `protobuf:"varint,2,opt,name=Id,proto3" json:"Id,omitempty"`
 	NextOpenid    string                 `protobuf:"bytes,3,opt,name=NextOpenid,proto3" json:"NextOpenid,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -4777,7 +6077,7 @@ type GetTagMembersRequest struct {
 
 func (x *GetTagMembersRequest) Reset() {
 	*x = GetTagMembersRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[78]
+	mi := &file_v1_wxproxy_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4789,7 +6089,7 @@ func (x *GetTagMembersRequest) String() string {
 func (*GetTagMembersRequest) ProtoMessage() {}
 
 func (x *GetTagMembersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[78]
+	mi := &file_v1_wxproxy_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4802,7 +6102,7 @@ func (x *GetTagMembersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTagMembersRequest.ProtoReflect.Descriptor instead.
 func (*GetTagMembersRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{78}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *GetTagMembersRequest) GetAccessToken() string {
@@ -4812,6 +6112,13 @@ func (x *GetTagMembersRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *GetTagMembersRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *GetTagMembersRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
@@ -4829,6 +6136,7 @@ func (x *GetTagMembersRequest) GetNextOpenid() string {
 type DeleteTagRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,3,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	Id            int64                  `protobuf:"varint,2,opt,name=Id,proto3" json:"Id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -4836,7 +6144,7 @@ type DeleteTagRequest struct {
 
 func (x *DeleteTagRequest) Reset() {
 	*x = DeleteTagRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[79]
+	mi := &file_v1_wxproxy_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4848,7 +6156,7 @@ func (x *DeleteTagRequest) String() string {
 func (*DeleteTagRequest) ProtoMessage() {}
 
 func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[79]
+	mi := &file_v1_wxproxy_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4861,7 +6169,7 @@ func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTagRequest.ProtoReflect.Descriptor instead.
 func (*DeleteTagRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{79}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *DeleteTagRequest) GetAccessToken() string {
@@ -4871,6 +6179,13 @@ func (x *DeleteTagRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *DeleteTagRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *DeleteTagRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
@@ -4881,6 +6196,7 @@ func (x *DeleteTagRequest) GetId() int64 {
 type UpdateTagRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,4,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	Id            int64                  `protobuf:"varint,2,opt,name=Id,proto3" json:"Id,omitempty"`
 	Name          string                 `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -4889,7 +6205,7 @@ type UpdateTagRequest struct {
 
 func (x *UpdateTagRequest) Reset() {
 	*x = UpdateTagRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[80]
+	mi := &file_v1_wxproxy_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4901,7 +6217,7 @@ func (x *UpdateTagRequest) String() string {
 func (*UpdateTagRequest) ProtoMessage() {}
 
 func (x *UpdateTagRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[80]
+	mi := &file_v1_wxproxy_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4914,7 +6230,7 @@ func (x *UpdateTagRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTagRequest.ProtoReflect.Descriptor instead.
 func (*UpdateTagRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{80}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *UpdateTagRequest) GetAccessToken() string {
@@ -4924,6 +6240,13 @@ func (x *UpdateTagRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *UpdateTagRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *UpdateTagRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
@@ -4941,6 +6264,7 @@ func (x *UpdateTagRequest) GetName() string {
 type CreateTagRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,3,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -4948,7 +6272,7 @@ type CreateTagRequest struct {
 
 func (x *CreateTagRequest) Reset() {
 	*x = CreateTagRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[81]
+	mi := &file_v1_wxproxy_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4960,7 +6284,7 @@ func (x *CreateTagRequest) String() string {
 func (*CreateTagRequest) ProtoMessage() {}
 
 func (x *CreateTagRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[81]
+	mi := &file_v1_wxproxy_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4973,7 +6297,7 @@ func (x *CreateTagRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTagRequest.ProtoReflect.Descriptor instead.
 func (*CreateTagRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{81}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *CreateTagRequest) GetAccessToken() string {
@@ -4983,6 +6307,13 @@ func (x *CreateTagRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *CreateTagRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *CreateTagRequest) GetName() string {
 	if x != nil {
 		return x.Name
@@ -4999,7 +6330,7 @@ type CreateTagReply struct {
 
 func (x *CreateTagReply) Reset() {
 	*x = CreateTagReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[82]
+	mi := &file_v1_wxproxy_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5011,7 +6342,7 @@ func (x *CreateTagReply) String() string {
 func (*CreateTagReply) ProtoMessage() {}
 
 func (x *CreateTagReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[82]
+	mi := &file_v1_wxproxy_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5024,7 +6355,7 @@ func (x *CreateTagReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTagReply.ProtoReflect.Descriptor instead.
 func (*CreateTagReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{82}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *CreateTagReply) GetTag() *Tag {
@@ -5043,7 +6374,7 @@ type GetTagListReply struct {
 
 func (x *GetTagListReply) Reset() {
 	*x = GetTagListReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[83]
+	mi := &file_v1_wxproxy_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5055,7 +6386,7 @@ func (x *GetTagListReply) String() string {
 func (*GetTagListReply) ProtoMessage() {}
 
 func (x *GetTagListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[83]
+	mi := &file_v1_wxproxy_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5068,7 +6399,7 @@ func (x *GetTagListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTagListReply.ProtoReflect.Descriptor instead.
 func (*GetTagListReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{83}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *GetTagListReply) GetTags() []*Tag {
@@ -5089,7 +6420,7 @@ type Tag struct {
 
 func (x *Tag) Reset() {
 	*x = Tag{}
-	mi := &file_v1_wxproxy_proto_msgTypes[84]
+	mi := &file_v1_wxproxy_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5101,7 +6432,7 @@ func (x *Tag) String() string {
 func (*Tag) ProtoMessage() {}
 
 func (x *Tag) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[84]
+	mi := &file_v1_wxproxy_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5114,7 +6445,7 @@ func (x *Tag) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Tag.ProtoReflect.Descriptor instead.
 func (*Tag) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{84}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *Tag) GetId() int64 {
@@ -5141,6 +6472,7 @@ func (x *Tag) GetCount() int64 {
 type UpdateMemberRemarkRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,4,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	Openid        string                 `protobuf:"bytes,2,opt,name=Openid,proto3" json:"Openid,omitempty"`
 	Remark        string                 `protobuf:"bytes,3,opt,name=Remark,proto3" json:"Remark,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -5149,7 +6481,7 @@ type UpdateMemberRemarkRequest struct {
 
 func (x *UpdateMemberRemarkRequest) Reset() {
 	*x = UpdateMemberRemarkRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[85]
+	mi := &file_v1_wxproxy_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5161,7 +6493,7 @@ func (x *UpdateMemberRemarkRequest) String() string {
 func (*UpdateMemberRemarkRequest) ProtoMessage() {}
 
 func (x *UpdateMemberRemarkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[85]
+	mi := &file_v1_wxproxy_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5174,7 +6506,7 @@ func (x *UpdateMemberRemarkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMemberRemarkRequest.ProtoReflect.Descriptor instead.
 func (*UpdateMemberRemarkRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{85}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *UpdateMemberRemarkRequest) GetAccessToken() string {
@@ -5184,6 +6516,13 @@ func (x *UpdateMemberRemarkRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *UpdateMemberRemarkRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *UpdateMemberRemarkRequest) GetOpenid() string {
 	if x != nil {
 		return x.Openid
@@ -5208,7 +6547,7 @@ type WXErrorReply struct {
 
 func (x *WXErrorReply) Reset() {
 	*x = WXErrorReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[86]
+	mi := &file_v1_wxproxy_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5220,7 +6559,7 @@ func (x *WXErrorReply) String() string {
 func (*WXErrorReply) ProtoMessage() {}
 
 func (x *WXErrorReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[86]
+	mi := &file_v1_wxproxy_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5233,7 +6572,7 @@ func (x *WXErrorReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WXErrorReply.ProtoReflect.Descriptor instead.
 func (*WXErrorReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{86}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *WXErrorReply) GetErrcode() int64 {
@@ -5253,6 +6592,7 @@ func (x *WXErrorReply) GetErrmsg() string {
 type GetMemberTagsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,3,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	Openid        string                 `protobuf:"bytes,2,opt,name=Openid,proto3" json:"Openid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -5260,7 +6600,7 @@ type GetMemberTagsRequest struct {
 
 func (x *GetMemberTagsRequest) Reset() {
 	*x = GetMemberTagsRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[87]
+	mi := &file_v1_wxproxy_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5272,7 +6612,7 @@ func (x *GetMemberTagsRequest) String() string {
 func (*GetMemberTagsRequest) ProtoMessage() {}
 
 func (x *GetMemberTagsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[87]
+	mi := &file_v1_wxproxy_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5285,7 +6625,7 @@ func (x *GetMemberTagsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMemberTagsRequest.ProtoReflect.Descriptor instead.
 func (*GetMemberTagsRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{87}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *GetMemberTagsRequest) GetAccessToken() string {
@@ -5295,6 +6635,13 @@ func (x *GetMemberTagsRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *GetMemberTagsRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *GetMemberTagsRequest) GetOpenid() string {
 	if x != nil {
 		return x.Openid
@@ -5311,7 +6658,7 @@ type GetMemberTagsReply struct {
 
 func (x *GetMemberTagsReply) Reset() {
 	*x = GetMemberTagsReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[88]
+	mi := &file_v1_wxproxy_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5323,7 +6670,7 @@ func (x *GetMemberTagsReply) String() string {
 func (*GetMemberTagsReply) ProtoMessage() {}
 
 func (x *GetMemberTagsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[88]
+	mi := &file_v1_wxproxy_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5336,7 +6683,7 @@ func (x *GetMemberTagsReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMemberTagsReply.ProtoReflect.Descriptor instead.
 func (*GetMemberTagsReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{88}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{103}
 }
 
 func (x *GetMemberTagsReply) GetTagidList() []int64 {
@@ -5349,6 +6696,7 @@ func (x *GetMemberTagsReply) GetTagidList() []int64 {
 type BatchGetMemberInfoRequest struct {
 	state         protoimpl.MessageState                  `protogen:"open.v1"`
 	AccessToken   string                                  `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                                  `protobuf:"bytes,3,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	UserList      []*BatchGetMemberInfoRequest_OpenIdList `protobuf:"bytes,2,rep,name=UserList,proto3" json:"UserList,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -5356,7 +6704,7 @@ type BatchGetMemberInfoRequest struct {
 
 func (x *BatchGetMemberInfoRequest) Reset() {
 	*x = BatchGetMemberInfoRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[89]
+	mi := &file_v1_wxproxy_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5368,7 +6716,7 @@ func (x *BatchGetMemberInfoRequest) String() string {
 func (*BatchGetMemberInfoRequest) ProtoMessage() {}
 
 func (x *BatchGetMemberInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[89]
+	mi := &file_v1_wxproxy_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5381,7 +6729,7 @@ func (x *BatchGetMemberInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetMemberInfoRequest.ProtoReflect.Descriptor instead.
 func (*BatchGetMemberInfoRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{89}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{104}
 }
 
 func (x *BatchGetMemberInfoRequest) GetAccessToken() string {
@@ -5391,6 +6739,13 @@ func (x *BatchGetMemberInfoRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *BatchGetMemberInfoRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *BatchGetMemberInfoRequest) GetUserList() []*BatchGetMemberInfoRequest_OpenIdList {
 	if x != nil {
 		return x.UserList
@@ -5407,7 +6762,7 @@ type BatchGetMemberInfoReply struct {
 
 func (x *BatchGetMemberInfoReply) Reset() {
 	*x = BatchGetMemberInfoReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[90]
+	mi := &file_v1_wxproxy_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5419,7 +6774,7 @@ func (x *BatchGetMemberInfoReply) String() string {
 func (*BatchGetMemberInfoReply) ProtoMessage() {}
 
 func (x *BatchGetMemberInfoReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[90]
+	mi := &file_v1_wxproxy_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5432,7 +6787,7 @@ func (x *BatchGetMemberInfoReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetMemberInfoReply.ProtoReflect.Descriptor instead.
 func (*BatchGetMemberInfoReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{90}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{105}
 }
 
 func (x *BatchGetMemberInfoReply) GetUserListInfo() []*GetMemberInfoReply {
@@ -5445,6 +6800,7 @@ func (x *BatchGetMemberInfoReply) GetUserListInfo() []*GetMemberInfoReply {
 type GetMemberInfoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,4,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	Openid        string                 `protobuf:"bytes,2,opt,name=Openid,proto3" json:"Openid,omitempty"`
 	Lang          string                 `protobuf:"bytes,3,opt,name=Lang,proto3" json:"Lang,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -5453,7 +6809,7 @@ type GetMemberInfoRequest struct {
 
 func (x *GetMemberInfoRequest) Reset() {
 	*x = GetMemberInfoRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[91]
+	mi := &file_v1_wxproxy_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5465,7 +6821,7 @@ func (x *GetMemberInfoRequest) String() string {
 func (*GetMemberInfoRequest) ProtoMessage() {}
 
 func (x *GetMemberInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[91]
+	mi := &file_v1_wxproxy_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5478,7 +6834,7 @@ func (x *GetMemberInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMemberInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetMemberInfoRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{91}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{106}
 }
 
 func (x *GetMemberInfoRequest) GetAccessToken() string {
@@ -5488,6 +6844,13 @@ func (x *GetMemberInfoRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *GetMemberInfoRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *GetMemberInfoRequest) GetOpenid() string {
 	if x != nil {
 		return x.Openid
@@ -5521,7 +6884,7 @@ type GetMemberInfoReply struct {
 
 func (x *GetMemberInfoReply) Reset() {
 	*x = GetMemberInfoReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[92]
+	mi := &file_v1_wxproxy_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5533,7 +6896,7 @@ func (x *GetMemberInfoReply) String() string {
 func (*GetMemberInfoReply) ProtoMessage() {}
 
 func (x *GetMemberInfoReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[92]
+	mi := &file_v1_wxproxy_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5546,7 +6909,7 @@ func (x *GetMemberInfoReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMemberInfoReply.ProtoReflect.Descriptor instead.
 func (*GetMemberInfoReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{92}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{107}
 }
 
 func (x *GetMemberInfoReply) GetSubscribe() int64 {
@@ -5629,6 +6992,7 @@ func (x *GetMemberInfoReply) GetLanguage() string {
 type GetMemberListRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,3,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	NextOpenid    string                 `protobuf:"bytes,2,opt,name=NextOpenid,proto3" json:"NextOpenid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -5636,7 +7000,7 @@ type GetMemberListRequest struct {
 
 func (x *GetMemberListRequest) Reset() {
 	*x = GetMemberListRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[93]
+	mi := &file_v1_wxproxy_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5648,7 +7012,7 @@ func (x *GetMemberListRequest) String() string {
 func (*GetMemberListRequest) ProtoMessage() {}
 
 func (x *GetMemberListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[93]
+	mi := &file_v1_wxproxy_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5661,7 +7025,7 @@ func (x *GetMemberListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMemberListRequest.ProtoReflect.Descriptor instead.
 func (*GetMemberListRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{93}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{108}
 }
 
 func (x *GetMemberListRequest) GetAccessToken() string {
@@ -5671,6 +7035,13 @@ func (x *GetMemberListRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *GetMemberListRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *GetMemberListRequest) GetNextOpenid() string {
 	if x != nil {
 		return x.NextOpenid
@@ -5690,7 +7061,7 @@ type GetMemberListReply struct {
 
 func (x *GetMemberListReply) Reset() {
 	*x = GetMemberListReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[94]
+	mi := &file_v1_wxproxy_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5702,7 +7073,7 @@ func (x *GetMemberListReply) String() string {
 func (*GetMemberListReply) ProtoMessage() {}
 
 func (x *GetMemberListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[94]
+	mi := &file_v1_wxproxy_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5715,7 +7086,7 @@ func (x *GetMemberListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMemberListReply.ProtoReflect.Descriptor instead.
 func (*GetMemberListReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{94}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{109}
 }
 
 func (x *GetMemberListReply) GetTotal() int64 {
@@ -5755,7 +7126,7 @@ type OpenIdList struct {
 
 func (x *OpenIdList) Reset() {
 	*x = OpenIdList{}
-	mi := &file_v1_wxproxy_proto_msgTypes[95]
+	mi := &file_v1_wxproxy_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5767,7 +7138,7 @@ func (x *OpenIdList) String() string {
 func (*OpenIdList) ProtoMessage() {}
 
 func (x *OpenIdList) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[95]
+	mi := &file_v1_wxproxy_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5780,7 +7151,7 @@ func (x *OpenIdList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OpenIdList.ProtoReflect.Descriptor instead.
 func (*OpenIdList) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{95}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{110}
 }
 
 func (x *OpenIdList) GetOpenid() string {
@@ -5790,16 +7161,19 @@ func (x *OpenIdList) GetOpenid() string {
 	return ""
 }
 
+// AccessTokenParam 调用凭证
+// AccessToken 与 AppId 二选一: 仅传 AppId 时由代理托管 AccessToken, AppId 也可通过 gRPC metadata(appid) 传递
 type AccessTokenParam struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,2,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AccessTokenParam) Reset() {
 	*x = AccessTokenParam{}
-	mi := &file_v1_wxproxy_proto_msgTypes[96]
+	mi := &file_v1_wxproxy_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5811,7 +7185,7 @@ func (x *AccessTokenParam) String() string {
 func (*AccessTokenParam) ProtoMessage() {}
 
 func (x *AccessTokenParam) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[96]
+	mi := &file_v1_wxproxy_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5824,7 +7198,7 @@ func (x *AccessTokenParam) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AccessTokenParam.ProtoReflect.Descriptor instead.
 func (*AccessTokenParam) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{96}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{111}
 }
 
 func (x *AccessTokenParam) GetAccessToken() string {
@@ -5834,9 +7208,17 @@ func (x *AccessTokenParam) GetAccessToken() string {
 	return ""
 }
 
+func (x *AccessTokenParam) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 type DeleteMaterialReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,3,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	MediaId       string                 `protobuf:"bytes,2,opt,name=MediaId,proto3" json:"MediaId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -5844,7 +7226,7 @@ type DeleteMaterialReq struct {
 
 func (x *DeleteMaterialReq) Reset() {
 	*x = DeleteMaterialReq{}
-	mi := &file_v1_wxproxy_proto_msgTypes[97]
+	mi := &file_v1_wxproxy_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5856,7 +7238,7 @@ func (x *DeleteMaterialReq) String() string {
 func (*DeleteMaterialReq) ProtoMessage() {}
 
 func (x *DeleteMaterialReq) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[97]
+	mi := &file_v1_wxproxy_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5869,7 +7251,7 @@ func (x *DeleteMaterialReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteMaterialReq.ProtoReflect.Descriptor instead.
 func (*DeleteMaterialReq) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{97}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{112}
 }
 
 func (x *DeleteMaterialReq) GetAccessToken() string {
@@ -5879,6 +7261,13 @@ func (x *DeleteMaterialReq) GetAccessToken() string {
 	return ""
 }
 
+func (x *DeleteMaterialReq) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *DeleteMaterialReq) GetMediaId() string {
 	if x != nil {
 		return x.MediaId
@@ -5898,7 +7287,7 @@ type GetMaterialCountReply struct {
 
 func (x *GetMaterialCountReply) Reset() {
 	*x = GetMaterialCountReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[98]
+	mi := &file_v1_wxproxy_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5910,7 +7299,7 @@ func (x *GetMaterialCountReply) String() string {
 func (*GetMaterialCountReply) ProtoMessage() {}
 
 func (x *GetMaterialCountReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[98]
+	mi := &file_v1_wxproxy_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5923,7 +7312,7 @@ func (x *GetMaterialCountReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMaterialCountReply.ProtoReflect.Descriptor instead.
 func (*GetMaterialCountReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{98}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{113}
 }
 
 func (x *GetMaterialCountReply) GetVoiceCount() int64 {
@@ -5957,6 +7346,7 @@ func (x *GetMaterialCountReply) GetNewsCount() int64 {
 type GetMaterialListRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AppId         string                 `protobuf:"bytes,5,opt,name=AppId,proto3" json:"AppId,omitempty"`
 	Type          string                 `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
 	Offset        int64                  `protobuf:"varint,3,opt,name=Offset,proto3" json:"Offset,omitempty"`
 	Count         int64                  `protobuf:"varint,4,opt,name=Count,proto3" json:"Count,omitempty"`
@@ -5966,7 +7356,7 @@ type GetMaterialListRequest struct {
 
 func (x *GetMaterialListRequest) Reset() {
 	*x = GetMaterialListRequest{}
-	mi := &file_v1_wxproxy_proto_msgTypes[99]
+	mi := &file_v1_wxproxy_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5978,7 +7368,7 @@ func (x *GetMaterialListRequest) String() string {
 func (*GetMaterialListRequest) ProtoMessage() {}
 
 func (x *GetMaterialListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[99]
+	mi := &file_v1_wxproxy_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5991,7 +7381,7 @@ func (x *GetMaterialListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMaterialListRequest.ProtoReflect.Descriptor instead.
 func (*GetMaterialListRequest) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{99}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{114}
 }
 
 func (x *GetMaterialListRequest) GetAccessToken() string {
@@ -6001,6 +7391,13 @@ func (x *GetMaterialListRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *GetMaterialListRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 func (x *GetMaterialListRequest) GetType() string {
 	if x != nil {
 		return x.Type
@@ -6034,7 +7431,7 @@ type GetMaterialListReply struct {
 
 func (x *GetMaterialListReply) Reset() {
 	*x = GetMaterialListReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[100]
+	mi := &file_v1_wxproxy_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6046,7 +7443,7 @@ func (x *GetMaterialListReply) String() string {
 func (*GetMaterialListReply) ProtoMessage() {}
 
 func (x *GetMaterialListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[100]
+	mi := &file_v1_wxproxy_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6059,7 +7456,7 @@ func (x *GetMaterialListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMaterialListReply.ProtoReflect.Descriptor instead.
 func (*GetMaterialListReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{100}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{115}
 }
 
 func (x *GetMaterialListReply) GetTotalCount() int64 {
@@ -6096,7 +7493,7 @@ type MaterialItem struct {
 
 func (x *MaterialItem) Reset() {
 	*x = MaterialItem{}
-	mi := &file_v1_wxproxy_proto_msgTypes[101]
+	mi := &file_v1_wxproxy_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6108,7 +7505,7 @@ func (x *MaterialItem) String() string {
 func (*MaterialItem) ProtoMessage() {}
 
 func (x *MaterialItem) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[101]
+	mi := &file_v1_wxproxy_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6121,7 +7518,7 @@ func (x *MaterialItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MaterialItem.ProtoReflect.Descriptor instead.
 func (*MaterialItem) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{101}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{116}
 }
 
 func (x *MaterialItem) GetMediaId() string {
@@ -6164,7 +7561,7 @@ type GetMaterialNewsListReply struct {
 
 func (x *GetMaterialNewsListReply) Reset() {
 	*x = GetMaterialNewsListReply{}
-	mi := &file_v1_wxproxy_proto_msgTypes[102]
+	mi := &file_v1_wxproxy_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6176,7 +7573,7 @@ func (x *GetMaterialNewsListReply) String() string {
 func (*GetMaterialNewsListReply) ProtoMessage() {}
 
 func (x *GetMaterialNewsListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[102]
+	mi := &file_v1_wxproxy_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6189,7 +7586,7 @@ func (x *GetMaterialNewsListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMaterialNewsListReply.ProtoReflect.Descriptor instead.
 func (*GetMaterialNewsListReply) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{102}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{117}
 }
 
 func (x *GetMaterialNewsListReply) GetTotalCount() int64 {
@@ -6224,7 +7621,7 @@ type MaterialNewsItem struct {
 
 func (x *MaterialNewsItem) Reset() {
 	*x = MaterialNewsItem{}
-	mi := &file_v1_wxproxy_proto_msgTypes[103]
+	mi := &file_v1_wxproxy_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6236,7 +7633,7 @@ func (x *MaterialNewsItem) String() string {
 func (*MaterialNewsItem) ProtoMessage() {}
 
 func (x *MaterialNewsItem) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[103]
+	mi := &file_v1_wxproxy_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6249,7 +7646,7 @@ func (x *MaterialNewsItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MaterialNewsItem.ProtoReflect.Descriptor instead.
 func (*MaterialNewsItem) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{103}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{118}
 }
 
 func (x *MaterialNewsItem) GetMediaId() string {
@@ -6290,7 +7687,7 @@ type NewsArticle struct {
 
 func (x *NewsArticle) Reset() {
 	*x = NewsArticle{}
-	mi := &file_v1_wxproxy_proto_msgTypes[104]
+	mi := &file_v1_wxproxy_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6302,7 +7699,7 @@ func (x *NewsArticle) String() string {
 func (*NewsArticle) ProtoMessage() {}
 
 func (x *NewsArticle) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[104]
+	mi := &file_v1_wxproxy_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6315,7 +7712,7 @@ func (x *NewsArticle) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NewsArticle.ProtoReflect.Descriptor instead.
 func (*NewsArticle) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{104}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{119}
 }
 
 func (x *NewsArticle) GetTitle() string {
@@ -6386,7 +7783,7 @@ type SendKFMiniProgramMsgRequest_KFMiniProgramMsg struct {
 
 func (x *SendKFMiniProgramMsgRequest_KFMiniProgramMsg) Reset() {
 	*x = SendKFMiniProgramMsgRequest_KFMiniProgramMsg{}
-	mi := &file_v1_wxproxy_proto_msgTypes[105]
+	mi := &file_v1_wxproxy_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6398,7 +7795,7 @@ func (x *SendKFMiniProgramMsgRequest_KFMiniProgramMsg) String() string {
 func (*SendKFMiniProgramMsgRequest_KFMiniProgramMsg) ProtoMessage() {}
 
 func (x *SendKFMiniProgramMsgRequest_KFMiniProgramMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[105]
+	mi := &file_v1_wxproxy_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6411,7 +7808,7 @@ func (x *SendKFMiniProgramMsgRequest_KFMiniProgramMsg) ProtoReflect() protorefle
 
 // Deprecated: Use SendKFMiniProgramMsgRequest_KFMiniProgramMsg.ProtoReflect.Descriptor instead.
 func (*SendKFMiniProgramMsgRequest_KFMiniProgramMsg) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{3, 0}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{18, 0}
 }
 
 func (x *SendKFMiniProgramMsgRequest_KFMiniProgramMsg) GetTitle() string {
@@ -6451,7 +7848,7 @@ type SendKFCardMsgRequest_KFCardMsg struct {
 
 func (x *SendKFCardMsgRequest_KFCardMsg) Reset() {
 	*x = SendKFCardMsgRequest_KFCardMsg{}
-	mi := &file_v1_wxproxy_proto_msgTypes[106]
+	mi := &file_v1_wxproxy_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6463,7 +7860,7 @@ func (x *SendKFCardMsgRequest_KFCardMsg) String() string {
 func (*SendKFCardMsgRequest_KFCardMsg) ProtoMessage() {}
 
 func (x *SendKFCardMsgRequest_KFCardMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[106]
+	mi := &file_v1_wxproxy_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6476,7 +7873,7 @@ func (x *SendKFCardMsgRequest_KFCardMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendKFCardMsgRequest_KFCardMsg.ProtoReflect.Descriptor instead.
 func (*SendKFCardMsgRequest_KFCardMsg) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{4, 0}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{19, 0}
 }
 
 func (x *SendKFCardMsgRequest_KFCardMsg) GetCardId() string {
@@ -6496,7 +7893,7 @@ type SendKFMenuMsgRequest_Item struct {
 
 func (x *SendKFMenuMsgRequest_Item) Reset() {
 	*x = SendKFMenuMsgRequest_Item{}
-	mi := &file_v1_wxproxy_proto_msgTypes[107]
+	mi := &file_v1_wxproxy_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6508,7 +7905,7 @@ func (x *SendKFMenuMsgRequest_Item) String() string {
 func (*SendKFMenuMsgRequest_Item) ProtoMessage() {}
 
 func (x *SendKFMenuMsgRequest_Item) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[107]
+	mi := &file_v1_wxproxy_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6521,7 +7918,7 @@ func (x *SendKFMenuMsgRequest_Item) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendKFMenuMsgRequest_Item.ProtoReflect.Descriptor instead.
 func (*SendKFMenuMsgRequest_Item) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{5, 0}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{20, 0}
 }
 
 func (x *SendKFMenuMsgRequest_Item) GetId() string {
@@ -6549,7 +7946,7 @@ type SendKFMenuMsgRequest_MenuMsg struct {
 
 func (x *SendKFMenuMsgRequest_MenuMsg) Reset() {
 	*x = SendKFMenuMsgRequest_MenuMsg{}
-	mi := &file_v1_wxproxy_proto_msgTypes[108]
+	mi := &file_v1_wxproxy_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6561,7 +7958,7 @@ func (x *SendKFMenuMsgRequest_MenuMsg) String() string {
 func (*SendKFMenuMsgRequest_MenuMsg) ProtoMessage() {}
 
 func (x *SendKFMenuMsgRequest_MenuMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[108]
+	mi := &file_v1_wxproxy_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6574,7 +7971,7 @@ func (x *SendKFMenuMsgRequest_MenuMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendKFMenuMsgRequest_MenuMsg.ProtoReflect.Descriptor instead.
 func (*SendKFMenuMsgRequest_MenuMsg) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{5, 1}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{20, 1}
 }
 
 func (x *SendKFMenuMsgRequest_MenuMsg) GetHeadContent() string {
@@ -6607,7 +8004,7 @@ type SendKFToArticleMsgRequest_ToArticleMsg struct {
 
 func (x *SendKFToArticleMsgRequest_ToArticleMsg) Reset() {
 	*x = SendKFToArticleMsgRequest_ToArticleMsg{}
-	mi := &file_v1_wxproxy_proto_msgTypes[109]
+	mi := &file_v1_wxproxy_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6619,7 +8016,7 @@ func (x *SendKFToArticleMsgRequest_ToArticleMsg) String() string {
 func (*SendKFToArticleMsgRequest_ToArticleMsg) ProtoMessage() {}
 
 func (x *SendKFToArticleMsgRequest_ToArticleMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[109]
+	mi := &file_v1_wxproxy_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6632,7 +8029,7 @@ func (x *SendKFToArticleMsgRequest_ToArticleMsg) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use SendKFToArticleMsgRequest_ToArticleMsg.ProtoReflect.Descriptor instead.
 func (*SendKFToArticleMsgRequest_ToArticleMsg) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{6, 0}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{21, 0}
 }
 
 func (x *SendKFToArticleMsgRequest_ToArticleMsg) GetArticleId() string {
@@ -6651,7 +8048,7 @@ type SendKFNewsPageMsgRequest_KFNewsPageMsg struct {
 
 func (x *SendKFNewsPageMsgRequest_KFNewsPageMsg) Reset() {
 	*x = SendKFNewsPageMsgRequest_KFNewsPageMsg{}
-	mi := &file_v1_wxproxy_proto_msgTypes[110]
+	mi := &file_v1_wxproxy_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6663,7 +8060,7 @@ func (x *SendKFNewsPageMsgRequest_KFNewsPageMsg) String() string {
 func (*SendKFNewsPageMsgRequest_KFNewsPageMsg) ProtoMessage() {}
 
 func (x *SendKFNewsPageMsgRequest_KFNewsPageMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[110]
+	mi := &file_v1_wxproxy_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6676,7 +8073,7 @@ func (x *SendKFNewsPageMsgRequest_KFNewsPageMsg) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use SendKFNewsPageMsgRequest_KFNewsPageMsg.ProtoReflect.Descriptor instead.
 func (*SendKFNewsPageMsgRequest_KFNewsPageMsg) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{7, 0}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{22, 0}
 }
 
 func (x *SendKFNewsPageMsgRequest_KFNewsPageMsg) GetMediaId() string {
@@ -6698,7 +8095,7 @@ type SendKFNewsCardMsgRequest_KFNewsCardMsg struct {
 
 func (x *SendKFNewsCardMsgRequest_KFNewsCardMsg) Reset() {
 	*x = SendKFNewsCardMsgRequest_KFNewsCardMsg{}
-	mi := &file_v1_wxproxy_proto_msgTypes[111]
+	mi := &file_v1_wxproxy_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6710,7 +8107,7 @@ func (x *SendKFNewsCardMsgRequest_KFNewsCardMsg) String() string {
 func (*SendKFNewsCardMsgRequest_KFNewsCardMsg) ProtoMessage() {}
 
 func (x *SendKFNewsCardMsgRequest_KFNewsCardMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[111]
+	mi := &file_v1_wxproxy_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6723,7 +8120,7 @@ func (x *SendKFNewsCardMsgRequest_KFNewsCardMsg) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use SendKFNewsCardMsgRequest_KFNewsCardMsg.ProtoReflect.Descriptor instead.
 func (*SendKFNewsCardMsgRequest_KFNewsCardMsg) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{8, 0}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{23, 0}
 }
 
 func (x *SendKFNewsCardMsgRequest_KFNewsCardMsg) GetTitle() string {
@@ -6767,7 +8164,7 @@ type SendKFMusicMsgRequest_KFMusicMsg struct {
 
 func (x *SendKFMusicMsgRequest_KFMusicMsg) Reset() {
 	*x = SendKFMusicMsgRequest_KFMusicMsg{}
-	mi := &file_v1_wxproxy_proto_msgTypes[112]
+	mi := &file_v1_wxproxy_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6779,7 +8176,7 @@ func (x *SendKFMusicMsgRequest_KFMusicMsg) String() string {
 func (*SendKFMusicMsgRequest_KFMusicMsg) ProtoMessage() {}
 
 func (x *SendKFMusicMsgRequest_KFMusicMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[112]
+	mi := &file_v1_wxproxy_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6792,7 +8189,7 @@ func (x *SendKFMusicMsgRequest_KFMusicMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendKFMusicMsgRequest_KFMusicMsg.ProtoReflect.Descriptor instead.
 func (*SendKFMusicMsgRequest_KFMusicMsg) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{9, 0}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{24, 0}
 }
 
 func (x *SendKFMusicMsgRequest_KFMusicMsg) GetMusicUrl() string {
@@ -6842,7 +8239,7 @@ type SendKFVideoMsgRequest_KFVideoMsg struct {
 
 func (x *SendKFVideoMsgRequest_KFVideoMsg) Reset() {
 	*x = SendKFVideoMsgRequest_KFVideoMsg{}
-	mi := &file_v1_wxproxy_proto_msgTypes[113]
+	mi := &file_v1_wxproxy_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6854,7 +8251,7 @@ func (x *SendKFVideoMsgRequest_KFVideoMsg) String() string {
 func (*SendKFVideoMsgRequest_KFVideoMsg) ProtoMessage() {}
 
 func (x *SendKFVideoMsgRequest_KFVideoMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[113]
+	mi := &file_v1_wxproxy_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6867,7 +8264,7 @@ func (x *SendKFVideoMsgRequest_KFVideoMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendKFVideoMsgRequest_KFVideoMsg.ProtoReflect.Descriptor instead.
 func (*SendKFVideoMsgRequest_KFVideoMsg) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{10, 0}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{25, 0}
 }
 
 func (x *SendKFVideoMsgRequest_KFVideoMsg) GetMediaId() string {
@@ -6907,7 +8304,7 @@ type SendKFVoiceMsgRequest_KFVoiceMsg struct {
 
 func (x *SendKFVoiceMsgRequest_KFVoiceMsg) Reset() {
 	*x = SendKFVoiceMsgRequest_KFVoiceMsg{}
-	mi := &file_v1_wxproxy_proto_msgTypes[114]
+	mi := &file_v1_wxproxy_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6919,7 +8316,7 @@ func (x *SendKFVoiceMsgRequest_KFVoiceMsg) String() string {
 func (*SendKFVoiceMsgRequest_KFVoiceMsg) ProtoMessage() {}
 
 func (x *SendKFVoiceMsgRequest_KFVoiceMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[114]
+	mi := &file_v1_wxproxy_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6932,7 +8329,7 @@ func (x *SendKFVoiceMsgRequest_KFVoiceMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendKFVoiceMsgRequest_KFVoiceMsg.ProtoReflect.Descriptor instead.
 func (*SendKFVoiceMsgRequest_KFVoiceMsg) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{11, 0}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{26, 0}
 }
 
 func (x *SendKFVoiceMsgRequest_KFVoiceMsg) GetMediaId() string {
@@ -6951,7 +8348,7 @@ type SendKFImageMsgRequest_KFImageMsg struct {
 
 func (x *SendKFImageMsgRequest_KFImageMsg) Reset() {
 	*x = SendKFImageMsgRequest_KFImageMsg{}
-	mi := &file_v1_wxproxy_proto_msgTypes[115]
+	mi := &file_v1_wxproxy_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6963,7 +8360,7 @@ func (x *SendKFImageMsgRequest_KFImageMsg) String() string {
 func (*SendKFImageMsgRequest_KFImageMsg) ProtoMessage() {}
 
 func (x *SendKFImageMsgRequest_KFImageMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[115]
+	mi := &file_v1_wxproxy_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6976,7 +8373,7 @@ func (x *SendKFImageMsgRequest_KFImageMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendKFImageMsgRequest_KFImageMsg.ProtoReflect.Descriptor instead.
 func (*SendKFImageMsgRequest_KFImageMsg) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{12, 0}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{27, 0}
 }
 
 func (x *SendKFImageMsgRequest_KFImageMsg) GetMediaId() string {
@@ -6995,7 +8392,7 @@ type KFMessageCommon_KFAccount struct {
 
 func (x *KFMessageCommon_KFAccount) Reset() {
 	*x = KFMessageCommon_KFAccount{}
-	mi := &file_v1_wxproxy_proto_msgTypes[116]
+	mi := &file_v1_wxproxy_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7007,7 +8404,7 @@ func (x *KFMessageCommon_KFAccount) String() string {
 func (*KFMessageCommon_KFAccount) ProtoMessage() {}
 
 func (x *KFMessageCommon_KFAccount) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[116]
+	mi := &file_v1_wxproxy_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7020,7 +8417,7 @@ func (x *KFMessageCommon_KFAccount) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KFMessageCommon_KFAccount.ProtoReflect.Descriptor instead.
 func (*KFMessageCommon_KFAccount) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{13, 0}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{28, 0}
 }
 
 func (x *KFMessageCommon_KFAccount) GetKfAccount() string {
@@ -7039,7 +8436,7 @@ type SendKFTextMsgRequest_KFTextMsg struct {
 
 func (x *SendKFTextMsgRequest_KFTextMsg) Reset() {
 	*x = SendKFTextMsgRequest_KFTextMsg{}
-	mi := &file_v1_wxproxy_proto_msgTypes[117]
+	mi := &file_v1_wxproxy_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7051,7 +8448,7 @@ func (x *SendKFTextMsgRequest_KFTextMsg) String() string {
 func (*SendKFTextMsgRequest_KFTextMsg) ProtoMessage() {}
 
 func (x *SendKFTextMsgRequest_KFTextMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[117]
+	mi := &file_v1_wxproxy_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7064,7 +8461,7 @@ func (x *SendKFTextMsgRequest_KFTextMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendKFTextMsgRequest_KFTextMsg.ProtoReflect.Descriptor instead.
 func (*SendKFTextMsgRequest_KFTextMsg) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{14, 0}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{29, 0}
 }
 
 func (x *SendKFTextMsgRequest_KFTextMsg) GetContent() string {
@@ -7084,7 +8481,7 @@ type GetKFSessionUnacceptedReply_WaitCase struct {
 
 func (x *GetKFSessionUnacceptedReply_WaitCase) Reset() {
 	*x = GetKFSessionUnacceptedReply_WaitCase{}
-	mi := &file_v1_wxproxy_proto_msgTypes[118]
+	mi := &file_v1_wxproxy_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7096,7 +8493,7 @@ func (x *GetKFSessionUnacceptedReply_WaitCase) String() string {
 func (*GetKFSessionUnacceptedReply_WaitCase) ProtoMessage() {}
 
 func (x *GetKFSessionUnacceptedReply_WaitCase) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[118]
+	mi := &file_v1_wxproxy_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7109,7 +8506,7 @@ func (x *GetKFSessionUnacceptedReply_WaitCase) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use GetKFSessionUnacceptedReply_WaitCase.ProtoReflect.Descriptor instead.
 func (*GetKFSessionUnacceptedReply_WaitCase) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{17, 0}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{32, 0}
 }
 
 func (x *GetKFSessionUnacceptedReply_WaitCase) GetLatestTime() int64 {
@@ -7135,7 +8532,7 @@ type SendSubscribeMessageRequest_DataItem struct {
 
 func (x *SendSubscribeMessageRequest_DataItem) Reset() {
 	*x = SendSubscribeMessageRequest_DataItem{}
-	mi := &file_v1_wxproxy_proto_msgTypes[119]
+	mi := &file_v1_wxproxy_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7147,7 +8544,7 @@ func (x *SendSubscribeMessageRequest_DataItem) String() string {
 func (*SendSubscribeMessageRequest_DataItem) ProtoMessage() {}
 
 func (x *SendSubscribeMessageRequest_DataItem) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[119]
+	mi := &file_v1_wxproxy_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7160,7 +8557,7 @@ func (x *SendSubscribeMessageRequest_DataItem) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use SendSubscribeMessageRequest_DataItem.ProtoReflect.Descriptor instead.
 func (*SendSubscribeMessageRequest_DataItem) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{36, 0}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{51, 0}
 }
 
 func (x *SendSubscribeMessageRequest_DataItem) GetValue() string {
@@ -7183,7 +8580,7 @@ type GetSubscribePrivateTplReply_Item struct {
 
 func (x *GetSubscribePrivateTplReply_Item) Reset() {
 	*x = GetSubscribePrivateTplReply_Item{}
-	mi := &file_v1_wxproxy_proto_msgTypes[121]
+	mi := &file_v1_wxproxy_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7195,7 +8592,7 @@ func (x *GetSubscribePrivateTplReply_Item) String() string {
 func (*GetSubscribePrivateTplReply_Item) ProtoMessage() {}
 
 func (x *GetSubscribePrivateTplReply_Item) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[121]
+	mi := &file_v1_wxproxy_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7208,7 +8605,7 @@ func (x *GetSubscribePrivateTplReply_Item) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSubscribePrivateTplReply_Item.ProtoReflect.Descriptor instead.
 func (*GetSubscribePrivateTplReply_Item) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{37, 0}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{52, 0}
 }
 
 func (x *GetSubscribePrivateTplReply_Item) GetPriTmplId() string {
@@ -7258,7 +8655,7 @@ type GetSubscribeTplTitlesReply_Item struct {
 
 func (x *GetSubscribeTplTitlesReply_Item) Reset() {
 	*x = GetSubscribeTplTitlesReply_Item{}
-	mi := &file_v1_wxproxy_proto_msgTypes[122]
+	mi := &file_v1_wxproxy_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7270,7 +8667,7 @@ func (x *GetSubscribeTplTitlesReply_Item) String() string {
 func (*GetSubscribeTplTitlesReply_Item) ProtoMessage() {}
 
 func (x *GetSubscribeTplTitlesReply_Item) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[122]
+	mi := &file_v1_wxproxy_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7283,7 +8680,7 @@ func (x *GetSubscribeTplTitlesReply_Item) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSubscribeTplTitlesReply_Item.ProtoReflect.Descriptor instead.
 func (*GetSubscribeTplTitlesReply_Item) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{38, 0}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{53, 0}
 }
 
 func (x *GetSubscribeTplTitlesReply_Item) GetTid() string {
@@ -7326,7 +8723,7 @@ type GetSubscribeTplKeywordsReply_Item struct {
 
 func (x *GetSubscribeTplKeywordsReply_Item) Reset() {
 	*x = GetSubscribeTplKeywordsReply_Item{}
-	mi := &file_v1_wxproxy_proto_msgTypes[123]
+	mi := &file_v1_wxproxy_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7338,7 +8735,7 @@ func (x *GetSubscribeTplKeywordsReply_Item) String() string {
 func (*GetSubscribeTplKeywordsReply_Item) ProtoMessage() {}
 
 func (x *GetSubscribeTplKeywordsReply_Item) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[123]
+	mi := &file_v1_wxproxy_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7351,7 +8748,7 @@ func (x *GetSubscribeTplKeywordsReply_Item) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetSubscribeTplKeywordsReply_Item.ProtoReflect.Descriptor instead.
 func (*GetSubscribeTplKeywordsReply_Item) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{40, 0}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{55, 0}
 }
 
 func (x *GetSubscribeTplKeywordsReply_Item) GetKid() int64 {
@@ -7392,7 +8789,7 @@ type GetSubscribeCategoryReply_Category struct {
 
 func (x *GetSubscribeCategoryReply_Category) Reset() {
 	*x = GetSubscribeCategoryReply_Category{}
-	mi := &file_v1_wxproxy_proto_msgTypes[124]
+	mi := &file_v1_wxproxy_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7404,7 +8801,7 @@ func (x *GetSubscribeCategoryReply_Category) String() string {
 func (*GetSubscribeCategoryReply_Category) ProtoMessage() {}
 
 func (x *GetSubscribeCategoryReply_Category) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[124]
+	mi := &file_v1_wxproxy_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7417,7 +8814,7 @@ func (x *GetSubscribeCategoryReply_Category) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetSubscribeCategoryReply_Category.ProtoReflect.Descriptor instead.
 func (*GetSubscribeCategoryReply_Category) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{42, 0}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{57, 0}
 }
 
 func (x *GetSubscribeCategoryReply_Category) GetId() string {
@@ -7448,7 +8845,7 @@ type GetBlockedTplMsgReply_BlockedMsgInfo struct {
 
 func (x *GetBlockedTplMsgReply_BlockedMsgInfo) Reset() {
 	*x = GetBlockedTplMsgReply_BlockedMsgInfo{}
-	mi := &file_v1_wxproxy_proto_msgTypes[125]
+	mi := &file_v1_wxproxy_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7460,7 +8857,7 @@ func (x *GetBlockedTplMsgReply_BlockedMsgInfo) String() string {
 func (*GetBlockedTplMsgReply_BlockedMsgInfo) ProtoMessage() {}
 
 func (x *GetBlockedTplMsgReply_BlockedMsgInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[125]
+	mi := &file_v1_wxproxy_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7473,7 +8870,7 @@ func (x *GetBlockedTplMsgReply_BlockedMsgInfo) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use GetBlockedTplMsgReply_BlockedMsgInfo.ProtoReflect.Descriptor instead.
 func (*GetBlockedTplMsgReply_BlockedMsgInfo) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{47, 0}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{62, 0}
 }
 
 func (x *GetBlockedTplMsgReply_BlockedMsgInfo) GetId() int64 {
@@ -7528,7 +8925,7 @@ type SendSubscribeMsgRequest_DataItem struct {
 
 func (x *SendSubscribeMsgRequest_DataItem) Reset() {
 	*x = SendSubscribeMsgRequest_DataItem{}
-	mi := &file_v1_wxproxy_proto_msgTypes[126]
+	mi := &file_v1_wxproxy_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7540,7 +8937,7 @@ func (x *SendSubscribeMsgRequest_DataItem) String() string {
 func (*SendSubscribeMsgRequest_DataItem) ProtoMessage() {}
 
 func (x *SendSubscribeMsgRequest_DataItem) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[126]
+	mi := &file_v1_wxproxy_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7553,7 +8950,7 @@ func (x *SendSubscribeMsgRequest_DataItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendSubscribeMsgRequest_DataItem.ProtoReflect.Descriptor instead.
 func (*SendSubscribeMsgRequest_DataItem) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{48, 0}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{63, 0}
 }
 
 func (x *SendSubscribeMsgRequest_DataItem) GetValue() string {
@@ -7580,7 +8977,7 @@ type SendTplMsgRequest_DataItem struct {
 
 func (x *SendTplMsgRequest_DataItem) Reset() {
 	*x = SendTplMsgRequest_DataItem{}
-	mi := &file_v1_wxproxy_proto_msgTypes[128]
+	mi := &file_v1_wxproxy_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7592,7 +8989,7 @@ func (x *SendTplMsgRequest_DataItem) String() string {
 func (*SendTplMsgRequest_DataItem) ProtoMessage() {}
 
 func (x *SendTplMsgRequest_DataItem) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[128]
+	mi := &file_v1_wxproxy_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7605,7 +9002,7 @@ func (x *SendTplMsgRequest_DataItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendTplMsgRequest_DataItem.ProtoReflect.Descriptor instead.
 func (*SendTplMsgRequest_DataItem) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{50, 0}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{65, 0}
 }
 
 func (x *SendTplMsgRequest_DataItem) GetValue() string {
@@ -7636,7 +9033,7 @@ type GetAllPrivateTplReply_TplInfo struct {
 
 func (x *GetAllPrivateTplReply_TplInfo) Reset() {
 	*x = GetAllPrivateTplReply_TplInfo{}
-	mi := &file_v1_wxproxy_proto_msgTypes[130]
+	mi := &file_v1_wxproxy_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7648,7 +9045,7 @@ func (x *GetAllPrivateTplReply_TplInfo) String() string {
 func (*GetAllPrivateTplReply_TplInfo) ProtoMessage() {}
 
 func (x *GetAllPrivateTplReply_TplInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[130]
+	mi := &file_v1_wxproxy_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7661,7 +9058,7 @@ func (x *GetAllPrivateTplReply_TplInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllPrivateTplReply_TplInfo.ProtoReflect.Descriptor instead.
 func (*GetAllPrivateTplReply_TplInfo) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{55, 0}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{70, 0}
 }
 
 func (x *GetAllPrivateTplReply_TplInfo) GetTemplateId() string {
@@ -7716,7 +9113,7 @@ type GetIndustryReply_Industry struct {
 
 func (x *GetIndustryReply_Industry) Reset() {
 	*x = GetIndustryReply_Industry{}
-	mi := &file_v1_wxproxy_proto_msgTypes[131]
+	mi := &file_v1_wxproxy_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7728,7 +9125,7 @@ func (x *GetIndustryReply_Industry) String() string {
 func (*GetIndustryReply_Industry) ProtoMessage() {}
 
 func (x *GetIndustryReply_Industry) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[131]
+	mi := &file_v1_wxproxy_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7741,7 +9138,7 @@ func (x *GetIndustryReply_Industry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetIndustryReply_Industry.ProtoReflect.Descriptor instead.
 func (*GetIndustryReply_Industry) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{57, 0}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{72, 0}
 }
 
 func (x *GetIndustryReply_Industry) GetFirstClass() string {
@@ -7767,7 +9164,7 @@ type SelfMenuReply_MenuInfoType struct {
 
 func (x *SelfMenuReply_MenuInfoType) Reset() {
 	*x = SelfMenuReply_MenuInfoType{}
-	mi := &file_v1_wxproxy_proto_msgTypes[132]
+	mi := &file_v1_wxproxy_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7779,7 +9176,7 @@ func (x *SelfMenuReply_MenuInfoType) String() string {
 func (*SelfMenuReply_MenuInfoType) ProtoMessage() {}
 
 func (x *SelfMenuReply_MenuInfoType) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[132]
+	mi := &file_v1_wxproxy_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7792,7 +9189,7 @@ func (x *SelfMenuReply_MenuInfoType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SelfMenuReply_MenuInfoType.ProtoReflect.Descriptor instead.
 func (*SelfMenuReply_MenuInfoType) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{60, 0}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{75, 0}
 }
 
 func (x *SelfMenuReply_MenuInfoType) GetButton() []*SelfMenuButton {
@@ -7811,7 +9208,7 @@ type SelfMenuButton_SubButtonType struct {
 
 func (x *SelfMenuButton_SubButtonType) Reset() {
 	*x = SelfMenuButton_SubButtonType{}
-	mi := &file_v1_wxproxy_proto_msgTypes[133]
+	mi := &file_v1_wxproxy_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7823,7 +9220,7 @@ func (x *SelfMenuButton_SubButtonType) String() string {
 func (*SelfMenuButton_SubButtonType) ProtoMessage() {}
 
 func (x *SelfMenuButton_SubButtonType) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[133]
+	mi := &file_v1_wxproxy_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7836,7 +9233,7 @@ func (x *SelfMenuButton_SubButtonType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SelfMenuButton_SubButtonType.ProtoReflect.Descriptor instead.
 func (*SelfMenuButton_SubButtonType) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{61, 0}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{76, 0}
 }
 
 func (x *SelfMenuButton_SubButtonType) GetList() []*SelfMenuButton {
@@ -7855,7 +9252,7 @@ type SelfMenuButton_NewsButtonType struct {
 
 func (x *SelfMenuButton_NewsButtonType) Reset() {
 	*x = SelfMenuButton_NewsButtonType{}
-	mi := &file_v1_wxproxy_proto_msgTypes[134]
+	mi := &file_v1_wxproxy_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7867,7 +9264,7 @@ func (x *SelfMenuButton_NewsButtonType) String() string {
 func (*SelfMenuButton_NewsButtonType) ProtoMessage() {}
 
 func (x *SelfMenuButton_NewsButtonType) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[134]
+	mi := &file_v1_wxproxy_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7880,7 +9277,7 @@ func (x *SelfMenuButton_NewsButtonType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SelfMenuButton_NewsButtonType.ProtoReflect.Descriptor instead.
 func (*SelfMenuButton_NewsButtonType) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{61, 1}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{76, 1}
 }
 
 func (x *SelfMenuButton_NewsButtonType) GetList() []*NewsButton {
@@ -7900,7 +9297,7 @@ type MenuInfoReply_MenuType struct {
 
 func (x *MenuInfoReply_MenuType) Reset() {
 	*x = MenuInfoReply_MenuType{}
-	mi := &file_v1_wxproxy_proto_msgTypes[135]
+	mi := &file_v1_wxproxy_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7912,7 +9309,7 @@ func (x *MenuInfoReply_MenuType) String() string {
 func (*MenuInfoReply_MenuType) ProtoMessage() {}
 
 func (x *MenuInfoReply_MenuType) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[135]
+	mi := &file_v1_wxproxy_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7925,7 +9322,7 @@ func (x *MenuInfoReply_MenuType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MenuInfoReply_MenuType.ProtoReflect.Descriptor instead.
 func (*MenuInfoReply_MenuType) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{65, 0}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{80, 0}
 }
 
 func (x *MenuInfoReply_MenuType) GetMenuid() int64 {
@@ -7951,7 +9348,7 @@ type GetTagMembersReply_DataT struct {
 
 func (x *GetTagMembersReply_DataT) Reset() {
 	*x = GetTagMembersReply_DataT{}
-	mi := &file_v1_wxproxy_proto_msgTypes[136]
+	mi := &file_v1_wxproxy_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7963,7 +9360,7 @@ func (x *GetTagMembersReply_DataT) String() string {
 func (*GetTagMembersReply_DataT) ProtoMessage() {}
 
 func (x *GetTagMembersReply_DataT) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[136]
+	mi := &file_v1_wxproxy_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7976,7 +9373,7 @@ func (x *GetTagMembersReply_DataT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTagMembersReply_DataT.ProtoReflect.Descriptor instead.
 func (*GetTagMembersReply_DataT) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{77, 0}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{92, 0}
 }
 
 func (x *GetTagMembersReply_DataT) GetOpenid() []string {
@@ -7995,7 +9392,7 @@ type BatchGetMemberInfoRequest_OpenIdList struct {
 
 func (x *BatchGetMemberInfoRequest_OpenIdList) Reset() {
 	*x = BatchGetMemberInfoRequest_OpenIdList{}
-	mi := &file_v1_wxproxy_proto_msgTypes[137]
+	mi := &file_v1_wxproxy_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8007,7 +9404,7 @@ func (x *BatchGetMemberInfoRequest_OpenIdList) String() string {
 func (*BatchGetMemberInfoRequest_OpenIdList) ProtoMessage() {}
 
 func (x *BatchGetMemberInfoRequest_OpenIdList) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[137]
+	mi := &file_v1_wxproxy_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8020,7 +9417,7 @@ func (x *BatchGetMemberInfoRequest_OpenIdList) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use BatchGetMemberInfoRequest_OpenIdList.ProtoReflect.Descriptor instead.
 func (*BatchGetMemberInfoRequest_OpenIdList) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{89, 0}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{104, 0}
 }
 
 func (x *BatchGetMemberInfoRequest_OpenIdList) GetOpenid() string {
@@ -8039,7 +9436,7 @@ type GetMemberListReply_IdList struct {
 
 func (x *GetMemberListReply_IdList) Reset() {
 	*x = GetMemberListReply_IdList{}
-	mi := &file_v1_wxproxy_proto_msgTypes[138]
+	mi := &file_v1_wxproxy_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8051,7 +9448,7 @@ func (x *GetMemberListReply_IdList) String() string {
 func (*GetMemberListReply_IdList) ProtoMessage() {}
 
 func (x *GetMemberListReply_IdList) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_wxproxy_proto_msgTypes[138]
+	mi := &file_v1_wxproxy_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8064,7 +9461,7 @@ func (x *GetMemberListReply_IdList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMemberListReply_IdList.ProtoReflect.Descriptor instead.
 func (*GetMemberListReply_IdList) Descriptor() ([]byte, []int) {
-	return file_v1_wxproxy_proto_rawDescGZIP(), []int{94, 0}
+	return file_v1_wxproxy_proto_rawDescGZIP(), []int{109, 0}
 }
 
 func (x *GetMemberListReply_IdList) GetOpenid() []*OpenIdList {
@@ -8078,9 +9475,82 @@ var File_v1_wxproxy_proto protoreflect.FileDescriptor
 
 const file_v1_wxproxy_proto_rawDesc = "" +
 	"\n" +
-	"\x10v1/wxproxy.proto\x12\x0eapi.wxproxy.v1\x1a\x1cgoogle/api/annotations.proto\"S\n" +
-	"\x0fGetBlacklistReq\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x1e\n" +
+	"\x10v1/wxproxy.proto\x12\x0eapi.wxproxy.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1copenapi/v3/annotations.proto\x1a\x17validate/validate.proto\"6\n" +
+	"\x15GetAccessTokenRequest\x12\x1d\n" +
+	"\x05AppId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05AppId\"s\n" +
+	"\x13GetAccessTokenReply\x12 \n" +
+	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x1c\n" +
+	"\tExpiresAt\x18\x02 \x01(\x03R\tExpiresAt\x12\x1c\n" +
+	"\tExpiresIn\x18\x03 \x01(\x03R\tExpiresIn\"^\n" +
+	"\x19RefreshAccessTokenRequest\x12\x1d\n" +
+	"\x05AppId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05AppId\x12\"\n" +
+	"\fForceRefresh\x18\x02 \x01(\bR\fForceRefresh\"\x93\x01\n" +
+	"\x17RefreshAccessTokenReply\x12\x1c\n" +
+	"\tExpiresAt\x18\x01 \x01(\x03R\tExpiresAt\x12,\n" +
+	"\x11ForceRefreshCount\x18\x02 \x01(\x03R\x11ForceRefreshCount\x12,\n" +
+	"\x11ForceRefreshLimit\x18\x03 \x01(\x03R\x11ForceRefreshLimit\"4\n" +
+	"\x18DescribeErrorCodeRequest\x12\x18\n" +
+	"\aErrcode\x18\x01 \x01(\x03R\aErrcode\"\xfa\x01\n" +
+	"\x16DescribeErrorCodeReply\x12\x18\n" +
+	"\aErrcode\x18\x01 \x01(\x03R\aErrcode\x12\x16\n" +
+	"\x06Reason\x18\x02 \x01(\tR\x06Reason\x12\x1a\n" +
+	"\bCategory\x18\x03 \x01(\tR\bCategory\x12\x1c\n" +
+	"\tRetryable\x18\x04 \x01(\bR\tRetryable\x12$\n" +
+	"\rDescriptionZh\x18\x05 \x01(\tR\rDescriptionZh\x12$\n" +
+	"\rDescriptionEn\x18\x06 \x01(\tR\rDescriptionEn\x12(\n" +
+	"\x0fSuggestedAction\x18\a \x01(\tR\x0fSuggestedAction\"o\n" +
+	"\x11GetRidInfoRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x19\n" +
+	"\x03Rid\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x03Rid\x12\x14\n" +
+	"\x05AppId\x18\x03 \x01(\tR\x05AppId\"\xcf\x01\n" +
+	"\x0fGetRidInfoReply\x12\x1e\n" +
+	"\n" +
+	"InvokeTime\x18\x01 \x01(\x03R\n" +
+	"InvokeTime\x12\x1a\n" +
+	"\bCostInMs\x18\x02 \x01(\x03R\bCostInMs\x12\x1e\n" +
+	"\n" +
+	"RequestUrl\x18\x03 \x01(\tR\n" +
+	"RequestUrl\x12 \n" +
+	"\vRequestBody\x18\x04 \x01(\tR\vRequestBody\x12\"\n" +
+	"\fResponseBody\x18\x05 \x01(\tR\fResponseBody\x12\x1a\n" +
+	"\bClientIp\x18\x06 \x01(\tR\bClientIp\"y\n" +
+	"\x12GetApiQuotaRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\"\n" +
+	"\aCgiPath\x18\x02 \x01(\tB\b\xfaB\x05r\x03:\x01/R\aCgiPath\x12\x14\n" +
+	"\x05AppId\x18\x03 \x01(\tR\x05AppId\"\xc6\x01\n" +
+	"\x10GetApiQuotaReply\x12\x1e\n" +
+	"\n" +
+	"DailyLimit\x18\x01 \x01(\x03R\n" +
+	"DailyLimit\x12\x12\n" +
+	"\x04Used\x18\x02 \x01(\x03R\x04Used\x12\x16\n" +
+	"\x06Remain\x18\x03 \x01(\x03R\x06Remain\x12.\n" +
+	"\x12RateLimitCallCount\x18\x04 \x01(\x03R\x12RateLimitCallCount\x126\n" +
+	"\x16RateLimitRefreshSecond\x18\x05 \x01(\x03R\x16RateLimitRefreshSecond\"]\n" +
+	"\x11ClearQuotaRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x1d\n" +
+	"\x05AppId\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05AppId\"4\n" +
+	"\x13ClearQuotaV2Request\x12\x1d\n" +
+	"\x05AppId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05AppId\"\x9f\x01\n" +
+	"\x14GetQuotaUsageRequest\x12 \n" +
+	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x1d\n" +
+	"\x05AppId\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05AppId\x12(\n" +
+	"\x04Date\x18\x03 \x01(\tB\x14\xfaB\x11r\x0f2\r^([0-9]{8})?$R\x04Date\x12\x1c\n" +
+	"\tWithLimit\x18\x04 \x01(\bR\tWithLimit\"\x88\x01\n" +
+	"\n" +
+	"QuotaUsage\x12\x18\n" +
+	"\aCgiPath\x18\x01 \x01(\tR\aCgiPath\x12\x14\n" +
+	"\x05Count\x18\x02 \x01(\x03R\x05Count\x12\x1e\n" +
+	"\n" +
+	"DailyLimit\x18\x03 \x01(\x03R\n" +
+	"DailyLimit\x12\x12\n" +
+	"\x04Used\x18\x04 \x01(\x03R\x04Used\x12\x16\n" +
+	"\x06Remain\x18\x05 \x01(\x03R\x06Remain\"Z\n" +
+	"\x12GetQuotaUsageReply\x12\x12\n" +
+	"\x04Date\x18\x01 \x01(\tR\x04Date\x120\n" +
+	"\x05Items\x18\x02 \x03(\v2\x1a.api.wxproxy.v1.QuotaUsageR\x05Items\"r\n" +
+	"\x0fGetBlacklistReq\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x03 \x01(\tR\x05AppId\x12\x1e\n" +
 	"\n" +
 	"NextOpenid\x18\x02 \x01(\tR\n" +
 	"NextOpenid\"y\n" +
@@ -8090,31 +9560,35 @@ const file_v1_wxproxy_proto_rawDesc = "" +
 	"\aOpenIDs\x18\x03 \x03(\tR\aOpenIDs\x12\x1e\n" +
 	"\n" +
 	"NextOpenid\x18\x04 \x01(\tR\n" +
-	"NextOpenid\"L\n" +
-	"\x0eBlockMemberReq\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x18\n" +
-	"\aOpenIds\x18\x02 \x03(\tR\aOpenIds\"\xf4\x02\n" +
-	"\x1bSendKFMiniProgramMsgRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x12\n" +
-	"\x04Type\x18\x02 \x01(\tR\x04Type\x127\n" +
-	"\x06Common\x18\x03 \x01(\v2\x1f.api.wxproxy.v1.KFMessageCommonR\x06Common\x12f\n" +
-	"\x0fMiniProgramPage\x18\x04 \x01(\v2<.api.wxproxy.v1.SendKFMiniProgramMsgRequest.KFMiniProgramMsgR\x0fMiniProgramPage\x1a~\n" +
+	"NextOpenid\"{\n" +
+	"\x0eBlockMemberReq\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x03 \x01(\tR\x05AppId\x12(\n" +
+	"\aOpenIds\x18\x02 \x03(\tB\x0e\xfaB\v\x92\x01\b\b\x01\"\x04r\x02\x10\x01R\aOpenIds\"\xa7\x03\n" +
+	"\x1bSendKFMiniProgramMsgRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x05 \x01(\tR\x05AppId\x12\x12\n" +
+	"\x04Type\x18\x02 \x01(\tR\x04Type\x12A\n" +
+	"\x06Common\x18\x03 \x01(\v2\x1f.api.wxproxy.v1.KFMessageCommonB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06Common\x12p\n" +
+	"\x0fMiniProgramPage\x18\x04 \x01(\v2<.api.wxproxy.v1.SendKFMiniProgramMsgRequest.KFMiniProgramMsgB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x0fMiniProgramPage\x1a~\n" +
 	"\x10KFMiniProgramMsg\x12\x14\n" +
 	"\x05Title\x18\x01 \x01(\tR\x05Title\x12\x1a\n" +
 	"\bPagePath\x18\x02 \x01(\tR\bPagePath\x12\"\n" +
 	"\fThumbMediaId\x18\x03 \x01(\tR\fThumbMediaId\x12\x14\n" +
-	"\x05AppId\x18\x04 \x01(\tR\x05AppId\"\xf2\x01\n" +
-	"\x14SendKFCardMsgRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x12\n" +
-	"\x04Type\x18\x02 \x01(\tR\x04Type\x127\n" +
-	"\x06Common\x18\x03 \x01(\v2\x1f.api.wxproxy.v1.KFMessageCommonR\x06Common\x12F\n" +
-	"\x06WxCard\x18\x04 \x01(\v2..api.wxproxy.v1.SendKFCardMsgRequest.KFCardMsgR\x06WxCard\x1a#\n" +
+	"\x05AppId\x18\x04 \x01(\tR\x05AppId\"\xa5\x02\n" +
+	"\x14SendKFCardMsgRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x05 \x01(\tR\x05AppId\x12\x12\n" +
+	"\x04Type\x18\x02 \x01(\tR\x04Type\x12A\n" +
+	"\x06Common\x18\x03 \x01(\v2\x1f.api.wxproxy.v1.KFMessageCommonB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06Common\x12P\n" +
+	"\x06WxCard\x18\x04 \x01(\v2..api.wxproxy.v1.SendKFCardMsgRequest.KFCardMsgB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06WxCard\x1a#\n" +
 	"\tKFCardMsg\x12\x16\n" +
-	"\x06CardId\x18\x01 \x01(\tR\x06CardId\"\x8e\x03\n" +
-	"\x14SendKFMenuMsgRequest\x127\n" +
-	"\x06Common\x18\x01 \x01(\v2\x1f.api.wxproxy.v1.KFMessageCommonR\x06Common\x12F\n" +
-	"\aMsgMenu\x18\x02 \x01(\v2,.api.wxproxy.v1.SendKFMenuMsgRequest.MenuMsgR\aMsgMenu\x12 \n" +
-	"\vAccessToken\x18\x03 \x01(\tR\vAccessToken\x12\x12\n" +
+	"\x06CardId\x18\x01 \x01(\tR\x06CardId\"\xc1\x03\n" +
+	"\x14SendKFMenuMsgRequest\x12A\n" +
+	"\x06Common\x18\x01 \x01(\v2\x1f.api.wxproxy.v1.KFMessageCommonB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06Common\x12P\n" +
+	"\aMsgMenu\x18\x02 \x01(\v2,.api.wxproxy.v1.SendKFMenuMsgRequest.MenuMsgB\b\xfaB\x05\x8a\x01\x02\x10\x01R\aMsgMenu\x12)\n" +
+	"\vAccessToken\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x05 \x01(\tR\x05AppId\x12\x12\n" +
 	"\x04Type\x18\x04 \x01(\tR\x04Type\x1a0\n" +
 	"\x04Item\x12\x0e\n" +
 	"\x02Id\x18\x01 \x01(\tR\x02Id\x12\x18\n" +
@@ -8122,36 +9596,40 @@ const file_v1_wxproxy_proto_rawDesc = "" +
 	"\aMenuMsg\x12 \n" +
 	"\vHeadContent\x18\x01 \x01(\tR\vHeadContent\x12=\n" +
 	"\x04List\x18\x02 \x03(\v2).api.wxproxy.v1.SendKFMenuMsgRequest.ItemR\x04List\x12 \n" +
-	"\vTailContent\x18\x03 \x01(\tR\vTailContent\"\x96\x02\n" +
-	"\x19SendKFToArticleMsgRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x12\n" +
-	"\x04Type\x18\x02 \x01(\tR\x04Type\x127\n" +
-	"\x06Common\x18\x03 \x01(\v2\x1f.api.wxproxy.v1.KFMessageCommonR\x06Common\x12\\\n" +
-	"\rMpNewsArticle\x18\x04 \x01(\v26.api.wxproxy.v1.SendKFToArticleMsgRequest.ToArticleMsgR\rMpNewsArticle\x1a,\n" +
+	"\vTailContent\x18\x03 \x01(\tR\vTailContent\"\xc9\x02\n" +
+	"\x19SendKFToArticleMsgRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x05 \x01(\tR\x05AppId\x12\x12\n" +
+	"\x04Type\x18\x02 \x01(\tR\x04Type\x12A\n" +
+	"\x06Common\x18\x03 \x01(\v2\x1f.api.wxproxy.v1.KFMessageCommonB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06Common\x12f\n" +
+	"\rMpNewsArticle\x18\x04 \x01(\v26.api.wxproxy.v1.SendKFToArticleMsgRequest.ToArticleMsgB\b\xfaB\x05\x8a\x01\x02\x10\x01R\rMpNewsArticle\x1a,\n" +
 	"\fToArticleMsg\x12\x1c\n" +
-	"\tArticleId\x18\x01 \x01(\tR\tArticleId\"\x84\x02\n" +
-	"\x18SendKFNewsPageMsgRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x12\n" +
-	"\x04Type\x18\x02 \x01(\tR\x04Type\x127\n" +
-	"\x06Common\x18\x03 \x01(\v2\x1f.api.wxproxy.v1.KFMessageCommonR\x06Common\x12N\n" +
-	"\x06MpNews\x18\x04 \x01(\v26.api.wxproxy.v1.SendKFNewsPageMsgRequest.KFNewsPageMsgR\x06MpNews\x1a)\n" +
+	"\tArticleId\x18\x01 \x01(\tR\tArticleId\"\xb7\x02\n" +
+	"\x18SendKFNewsPageMsgRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x05 \x01(\tR\x05AppId\x12\x12\n" +
+	"\x04Type\x18\x02 \x01(\tR\x04Type\x12A\n" +
+	"\x06Common\x18\x03 \x01(\v2\x1f.api.wxproxy.v1.KFMessageCommonB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06Common\x12X\n" +
+	"\x06MpNews\x18\x04 \x01(\v26.api.wxproxy.v1.SendKFNewsPageMsgRequest.KFNewsPageMsgB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06MpNews\x1a)\n" +
 	"\rKFNewsPageMsg\x12\x18\n" +
-	"\aMediaId\x18\x01 \x01(\tR\aMediaId\"\xc8\x02\n" +
-	"\x18SendKFNewsCardMsgRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x12\n" +
-	"\x04Type\x18\x02 \x01(\tR\x04Type\x127\n" +
-	"\x06Common\x18\x03 \x01(\v2\x1f.api.wxproxy.v1.KFMessageCommonR\x06Common\x12J\n" +
-	"\x04News\x18\x04 \x01(\v26.api.wxproxy.v1.SendKFNewsCardMsgRequest.KFNewsCardMsgR\x04News\x1aq\n" +
+	"\aMediaId\x18\x01 \x01(\tR\aMediaId\"\xfb\x02\n" +
+	"\x18SendKFNewsCardMsgRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x05 \x01(\tR\x05AppId\x12\x12\n" +
+	"\x04Type\x18\x02 \x01(\tR\x04Type\x12A\n" +
+	"\x06Common\x18\x03 \x01(\v2\x1f.api.wxproxy.v1.KFMessageCommonB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06Common\x12T\n" +
+	"\x04News\x18\x04 \x01(\v26.api.wxproxy.v1.SendKFNewsCardMsgRequest.KFNewsCardMsgB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x04News\x1aq\n" +
 	"\rKFNewsCardMsg\x12\x14\n" +
 	"\x05Title\x18\x01 \x01(\tR\x05Title\x12 \n" +
 	"\vDescription\x18\x02 \x01(\tR\vDescription\x12\x10\n" +
 	"\x03Url\x18\x03 \x01(\tR\x03Url\x12\x16\n" +
-	"\x06PicUrl\x18\x04 \x01(\tR\x06PicUrl\"\xf5\x02\n" +
-	"\x15SendKFMusicMsgRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x12\n" +
-	"\x04Type\x18\x02 \x01(\tR\x04Type\x127\n" +
-	"\x06Common\x18\x03 \x01(\v2\x1f.api.wxproxy.v1.KFMessageCommonR\x06Common\x12F\n" +
-	"\x05Music\x18\x04 \x01(\v20.api.wxproxy.v1.SendKFMusicMsgRequest.KFMusicMsgR\x05Music\x1a\xa4\x01\n" +
+	"\x06PicUrl\x18\x04 \x01(\tR\x06PicUrl\"\xa8\x03\n" +
+	"\x15SendKFMusicMsgRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x05 \x01(\tR\x05AppId\x12\x12\n" +
+	"\x04Type\x18\x02 \x01(\tR\x04Type\x12A\n" +
+	"\x06Common\x18\x03 \x01(\v2\x1f.api.wxproxy.v1.KFMessageCommonB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06Common\x12P\n" +
+	"\x05Music\x18\x04 \x01(\v20.api.wxproxy.v1.SendKFMusicMsgRequest.KFMusicMsgB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x05Music\x1a\xa4\x01\n" +
 	"\n" +
 	"KFMusicMsg\x12\x1a\n" +
 	"\bMusicUrl\x18\x01 \x01(\tR\bMusicUrl\x12\x1e\n" +
@@ -8160,55 +9638,61 @@ const file_v1_wxproxy_proto_rawDesc = "" +
 	"HQMusicUrl\x12\"\n" +
 	"\fThumbMediaId\x18\x03 \x01(\tR\fThumbMediaId\x12\x14\n" +
 	"\x05Title\x18\x04 \x01(\tR\x05Title\x12 \n" +
-	"\vDescription\x18\x05 \x01(\tR\vDescription\"\xd3\x02\n" +
-	"\x15SendKFVideoMsgRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x12\n" +
-	"\x04Type\x18\x02 \x01(\tR\x04Type\x127\n" +
-	"\x06Common\x18\x03 \x01(\v2\x1f.api.wxproxy.v1.KFMessageCommonR\x06Common\x12F\n" +
-	"\x05Video\x18\x04 \x01(\v20.api.wxproxy.v1.SendKFVideoMsgRequest.KFVideoMsgR\x05Video\x1a\x82\x01\n" +
+	"\vDescription\x18\x05 \x01(\tR\vDescription\"\x86\x03\n" +
+	"\x15SendKFVideoMsgRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x05 \x01(\tR\x05AppId\x12\x12\n" +
+	"\x04Type\x18\x02 \x01(\tR\x04Type\x12A\n" +
+	"\x06Common\x18\x03 \x01(\v2\x1f.api.wxproxy.v1.KFMessageCommonB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06Common\x12P\n" +
+	"\x05Video\x18\x04 \x01(\v20.api.wxproxy.v1.SendKFVideoMsgRequest.KFVideoMsgB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x05Video\x1a\x82\x01\n" +
 	"\n" +
 	"KFVideoMsg\x12\x18\n" +
 	"\aMediaId\x18\x01 \x01(\tR\aMediaId\x12\"\n" +
 	"\fThumbMediaId\x18\x02 \x01(\tR\fThumbMediaId\x12\x14\n" +
 	"\x05Title\x18\x03 \x01(\tR\x05Title\x12 \n" +
-	"\vDescription\x18\x04 \x01(\tR\vDescription\"\xf6\x01\n" +
-	"\x15SendKFVoiceMsgRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x12\n" +
-	"\x04Type\x18\x02 \x01(\tR\x04Type\x127\n" +
-	"\x06Common\x18\x03 \x01(\v2\x1f.api.wxproxy.v1.KFMessageCommonR\x06Common\x12F\n" +
-	"\x05Voice\x18\x04 \x01(\v20.api.wxproxy.v1.SendKFVoiceMsgRequest.KFVoiceMsgR\x05Voice\x1a&\n" +
+	"\vDescription\x18\x04 \x01(\tR\vDescription\"\xa9\x02\n" +
+	"\x15SendKFVoiceMsgRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x05 \x01(\tR\x05AppId\x12\x12\n" +
+	"\x04Type\x18\x02 \x01(\tR\x04Type\x12A\n" +
+	"\x06Common\x18\x03 \x01(\v2\x1f.api.wxproxy.v1.KFMessageCommonB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06Common\x12P\n" +
+	"\x05Voice\x18\x04 \x01(\v20.api.wxproxy.v1.SendKFVoiceMsgRequest.KFVoiceMsgB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x05Voice\x1a&\n" +
 	"\n" +
 	"KFVoiceMsg\x12\x18\n" +
-	"\aMediaId\x18\x01 \x01(\tR\aMediaId\"\xf6\x01\n" +
-	"\x15SendKFImageMsgRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x12\n" +
-	"\x04Type\x18\x02 \x01(\tR\x04Type\x127\n" +
-	"\x06Common\x18\x03 \x01(\v2\x1f.api.wxproxy.v1.KFMessageCommonR\x06Common\x12F\n" +
-	"\x05Image\x18\x04 \x01(\v20.api.wxproxy.v1.SendKFImageMsgRequest.KFImageMsgR\x05Image\x1a&\n" +
+	"\aMediaId\x18\x01 \x01(\tR\aMediaId\"\xa9\x02\n" +
+	"\x15SendKFImageMsgRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x05 \x01(\tR\x05AppId\x12\x12\n" +
+	"\x04Type\x18\x02 \x01(\tR\x04Type\x12A\n" +
+	"\x06Common\x18\x03 \x01(\v2\x1f.api.wxproxy.v1.KFMessageCommonB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06Common\x12P\n" +
+	"\x05Image\x18\x04 \x01(\v20.api.wxproxy.v1.SendKFImageMsgRequest.KFImageMsgB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x05Image\x1a&\n" +
 	"\n" +
 	"KFImageMsg\x12\x18\n" +
-	"\aMediaId\x18\x01 \x01(\tR\aMediaId\"\xc3\x01\n" +
-	"\x0fKFMessageCommon\x12\x16\n" +
-	"\x06ToUser\x18\x01 \x01(\tR\x06ToUser\x12\x18\n" +
+	"\aMediaId\x18\x01 \x01(\tR\aMediaId\"\xcc\x01\n" +
+	"\x0fKFMessageCommon\x12\x1f\n" +
+	"\x06ToUser\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06ToUser\x12\x18\n" +
 	"\aMsgType\x18\x02 \x01(\tR\aMsgType\x12S\n" +
 	"\x0fCustomerService\x18\x03 \x01(\v2).api.wxproxy.v1.KFMessageCommon.KFAccountR\x0fCustomerService\x1a)\n" +
 	"\tKFAccount\x12\x1c\n" +
-	"\tKfAccount\x18\x01 \x01(\tR\tKfAccount\"\xf0\x01\n" +
-	"\x14SendKFTextMsgRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x12\n" +
-	"\x04Type\x18\x02 \x01(\tR\x04Type\x127\n" +
-	"\x06Common\x18\x03 \x01(\v2\x1f.api.wxproxy.v1.KFMessageCommonR\x06Common\x12B\n" +
-	"\x04Text\x18\x04 \x01(\v2..api.wxproxy.v1.SendKFTextMsgRequest.KFTextMsgR\x04Text\x1a%\n" +
+	"\tKfAccount\x18\x01 \x01(\tR\tKfAccount\"\xa3\x02\n" +
+	"\x14SendKFTextMsgRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x05 \x01(\tR\x05AppId\x12\x12\n" +
+	"\x04Type\x18\x02 \x01(\tR\x04Type\x12A\n" +
+	"\x06Common\x18\x03 \x01(\v2\x1f.api.wxproxy.v1.KFMessageCommonB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06Common\x12L\n" +
+	"\x04Text\x18\x04 \x01(\v2..api.wxproxy.v1.SendKFTextMsgRequest.KFTextMsgB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x04Text\x1a%\n" +
 	"\tKFTextMsg\x12\x18\n" +
-	"\aContent\x18\x01 \x01(\tR\aContent\"m\n" +
-	"\x13NewKFSessionRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x16\n" +
-	"\x06OpenId\x18\x02 \x01(\tR\x06OpenId\x12\x1c\n" +
-	"\tKfAccount\x18\x03 \x01(\tR\tKfAccount\"o\n" +
-	"\x15CloseKFSessionRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x16\n" +
-	"\x06OpenId\x18\x02 \x01(\tR\x06OpenId\x12\x1c\n" +
-	"\tKfAccount\x18\x03 \x01(\tR\tKfAccount\"\xd1\x01\n" +
+	"\aContent\x18\x01 \x01(\tR\aContent\"\x9e\x01\n" +
+	"\x13NewKFSessionRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x04 \x01(\tR\x05AppId\x12\x1f\n" +
+	"\x06OpenId\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06OpenId\x12%\n" +
+	"\tKfAccount\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tKfAccount\"\xa0\x01\n" +
+	"\x15CloseKFSessionRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x04 \x01(\tR\x05AppId\x12\x1f\n" +
+	"\x06OpenId\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06OpenId\x12%\n" +
+	"\tKfAccount\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tKfAccount\"\xd1\x01\n" +
 	"\x1bGetKFSessionUnacceptedReply\x12\x14\n" +
 	"\x05Count\x18\x01 \x01(\x03R\x05Count\x12X\n" +
 	"\fWaitCaseList\x18\x02 \x03(\v24.api.wxproxy.v1.GetKFSessionUnacceptedReply.WaitCaseR\fWaitCaseList\x1aB\n" +
@@ -8221,43 +9705,51 @@ const file_v1_wxproxy_proto_rawDesc = "" +
 	"\tKfAccount\x18\x01 \x01(\tR\tKfAccount\x12\x1e\n" +
 	"\n" +
 	"CreateTime\x18\x02 \x01(\x03R\n" +
-	"CreateTime\"U\n" +
-	"\x19GetKFSessionStatusRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x16\n" +
-	"\x06OpenId\x18\x02 \x01(\tR\x06OpenId\"T\n" +
+	"CreateTime\"}\n" +
+	"\x19GetKFSessionStatusRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x03 \x01(\tR\x05AppId\x12\x1f\n" +
+	"\x06OpenId\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06OpenId\"T\n" +
 	"\x15GetKFSessionListReply\x12;\n" +
 	"\vSessionList\x18\x01 \x03(\v2\x19.api.wxproxy.v1.KFSessionR\vSessionList\"C\n" +
 	"\tKFSession\x12\x16\n" +
 	"\x06OpenId\x18\x01 \x01(\tR\x06OpenId\x12\x1e\n" +
 	"\n" +
 	"CreateTime\x18\x02 \x01(\x03R\n" +
-	"CreateTime\"Y\n" +
-	"\x17GetKFSessionListRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x1c\n" +
-	"\tKfAccount\x18\x02 \x01(\tR\tKfAccount\"k\n" +
-	"\x15UpdateKFTypingRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x16\n" +
-	"\x06Touser\x18\x02 \x01(\tR\x06Touser\x12\x18\n" +
-	"\aCommand\x18\x03 \x01(\tR\aCommand\"}\n" +
-	"\x15UpdateKFAvatarRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x1c\n" +
-	"\tKfAccount\x18\x02 \x01(\tR\tKfAccount\x12$\n" +
-	"\rAvatarMediaId\x18\x03 \x01(\tR\rAvatarMediaId\"s\n" +
-	"\x15InviteKFWorkerRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x1c\n" +
-	"\tKfAccount\x18\x02 \x01(\tR\tKfAccount\x12\x1a\n" +
-	"\bInviteWx\x18\x03 \x01(\tR\bInviteWx\"U\n" +
-	"\x13DelKFAccountRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x1c\n" +
-	"\tKfAccount\x18\x02 \x01(\tR\tKfAccount\"\x90\x01\n" +
-	"\x16UpdateKFAccountRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x1c\n" +
-	"\tKfAccount\x18\x02 \x01(\tR\tKfAccount\x12\x1a\n" +
+	"CreateTime\"\x81\x01\n" +
+	"\x17GetKFSessionListRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x03 \x01(\tR\x05AppId\x12%\n" +
+	"\tKfAccount\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tKfAccount\"\xb0\x01\n" +
+	"\x15UpdateKFTypingRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x04 \x01(\tR\x05AppId\x12\x1f\n" +
+	"\x06Touser\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06Touser\x125\n" +
+	"\aCommand\x18\x03 \x01(\tB\x1b\xfaB\x18r\x16R\x06TypingR\fCancelTypingR\aCommand\"\xae\x01\n" +
+	"\x15UpdateKFAvatarRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x04 \x01(\tR\x05AppId\x12%\n" +
+	"\tKfAccount\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tKfAccount\x12-\n" +
+	"\rAvatarMediaId\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\rAvatarMediaId\"\xa4\x01\n" +
+	"\x15InviteKFWorkerRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x04 \x01(\tR\x05AppId\x12%\n" +
+	"\tKfAccount\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tKfAccount\x12#\n" +
+	"\bInviteWx\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bInviteWx\"}\n" +
+	"\x13DelKFAccountRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x03 \x01(\tR\x05AppId\x12%\n" +
+	"\tKfAccount\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tKfAccount\"\xb8\x01\n" +
+	"\x16UpdateKFAccountRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x05 \x01(\tR\x05AppId\x12%\n" +
+	"\tKfAccount\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tKfAccount\x12\x1a\n" +
 	"\bNickname\x18\x03 \x01(\tR\bNickname\x12\x1a\n" +
-	"\bPassword\x18\x04 \x01(\tR\bPassword\"\x8d\x01\n" +
-	"\x13AddKFAccountRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x1c\n" +
-	"\tKfAccount\x18\x02 \x01(\tR\tKfAccount\x12\x1a\n" +
+	"\bPassword\x18\x04 \x01(\tR\bPassword\"\xb5\x01\n" +
+	"\x13AddKFAccountRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x05 \x01(\tR\x05AppId\x12%\n" +
+	"\tKfAccount\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tKfAccount\x12\x1a\n" +
 	"\bNickname\x18\x03 \x01(\tR\bNickname\x12\x1a\n" +
 	"\bPassword\x18\x04 \x01(\tR\bPassword\"\x82\x01\n" +
 	"\x14GetKFMsgHistoryReply\x12\x14\n" +
@@ -8271,9 +9763,10 @@ const file_v1_wxproxy_proto_rawDesc = "" +
 	"\x06OpenId\x18\x02 \x01(\tR\x06OpenId\x12\x12\n" +
 	"\x04Text\x18\x03 \x01(\tR\x04Text\x12\x12\n" +
 	"\x04Time\x18\x04 \x01(\x03R\x04Time\x12\x16\n" +
-	"\x06OpCode\x18\x05 \x01(\x03R\x06OpCode\"\xa0\x01\n" +
-	"\x16GetKFMsgHistoryRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x1c\n" +
+	"\x06OpCode\x18\x05 \x01(\x03R\x06OpCode\"\xbf\x01\n" +
+	"\x16GetKFMsgHistoryRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x06 \x01(\tR\x05AppId\x12\x1c\n" +
 	"\tStartTime\x18\x02 \x01(\x03R\tStartTime\x12\x18\n" +
 	"\aEndTime\x18\x03 \x01(\x03R\aEndTime\x12\x14\n" +
 	"\x05MsgId\x18\x04 \x01(\x03R\x05MsgId\x12\x16\n" +
@@ -8295,12 +9788,13 @@ const file_v1_wxproxy_proto_rawDesc = "" +
 	"\fKfHeadImgUrl\x18\x05 \x01(\tR\fKfHeadImgUrl\x12\x1a\n" +
 	"\bInviteWx\x18\x06 \x01(\tR\bInviteWx\x12\"\n" +
 	"\fInviteStatus\x18\a \x01(\tR\fInviteStatus\x12*\n" +
-	"\x10InviteExpireTime\x18\b \x01(\x03R\x10InviteExpireTime\"\xa6\x03\n" +
-	"\x1bSendSubscribeMessageRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x16\n" +
-	"\x06Touser\x18\x02 \x01(\tR\x06Touser\x12\x1e\n" +
+	"\x10InviteExpireTime\x18\b \x01(\x03R\x10InviteExpireTime\"\xd7\x03\n" +
+	"\x1bSendSubscribeMessageRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\a \x01(\tR\x05AppId\x12\x1f\n" +
+	"\x06Touser\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06Touser\x12'\n" +
 	"\n" +
-	"TemplateId\x18\x03 \x01(\tR\n" +
+	"TemplateId\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
 	"TemplateId\x12\x12\n" +
 	"\x04Page\x18\x04 \x01(\tR\x04Page\x12I\n" +
 	"\x04Data\x18\x05 \x03(\v25.api.wxproxy.v1.SendSubscribeMessageRequest.DataEntryR\x04Data\x12=\n" +
@@ -8327,9 +9821,10 @@ const file_v1_wxproxy_proto_rawDesc = "" +
 	"\x04Type\x18\x03 \x01(\x03R\x04Type\x12\x1e\n" +
 	"\n" +
 	"CategoryId\x18\x04 \x01(\tR\n" +
-	"CategoryId\"~\n" +
-	"\x1cGetSubscribeTplTitlesRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x10\n" +
+	"CategoryId\"\x9d\x01\n" +
+	"\x1cGetSubscribeTplTitlesRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x05 \x01(\tR\x05AppId\x12\x10\n" +
 	"\x03Ids\x18\x02 \x01(\tR\x03Ids\x12\x14\n" +
 	"\x05Limit\x18\x03 \x01(\x03R\x05Limit\x12\x14\n" +
 	"\x05Start\x18\x04 \x01(\x03R\x05Start\"\xd7\x01\n" +
@@ -8340,33 +9835,37 @@ const file_v1_wxproxy_proto_rawDesc = "" +
 	"\x03Kid\x18\x01 \x01(\x03R\x03Kid\x12\x12\n" +
 	"\x04Name\x18\x02 \x01(\tR\x04Name\x12\x12\n" +
 	"\x04Rule\x18\x03 \x01(\tR\x04Rule\x12\x18\n" +
-	"\aExample\x18\x04 \x01(\tR\aExample\"b\n" +
-	"\x1eGetSubscribeTplKeywordsRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x1e\n" +
+	"\aExample\x18\x04 \x01(\tR\aExample\"\x8a\x01\n" +
+	"\x1eGetSubscribeTplKeywordsRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x03 \x01(\tR\x05AppId\x12'\n" +
 	"\n" +
-	"TemplateId\x18\x02 \x01(\tR\n" +
+	"TemplateId\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
 	"TemplateId\"\x93\x01\n" +
 	"\x19GetSubscribeCategoryReply\x12F\n" +
 	"\x04Data\x18\x01 \x03(\v22.api.wxproxy.v1.GetSubscribeCategoryReply.CategoryR\x04Data\x1a.\n" +
 	"\bCategory\x12\x0e\n" +
 	"\x02Id\x18\x01 \x01(\tR\x02Id\x12\x12\n" +
-	"\x04Name\x18\x02 \x01(\tR\x04Name\"Z\n" +
-	"\x16DelSubscribeTplRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x1e\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\"\x82\x01\n" +
+	"\x16DelSubscribeTplRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x03 \x01(\tR\x05AppId\x12'\n" +
 	"\n" +
-	"TemplateId\x18\x02 \x01(\tR\n" +
-	"TemplateId\"\x84\x01\n" +
-	"\x16AddSubscribeTplRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x10\n" +
+	"TemplateId\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"TemplateId\"\xa3\x01\n" +
+	"\x16AddSubscribeTplRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x05 \x01(\tR\x05AppId\x12\x10\n" +
 	"\x03Tid\x18\x02 \x01(\tR\x03Tid\x12\x1c\n" +
 	"\tSceneDesc\x18\x03 \x01(\tR\tSceneDesc\x12\x18\n" +
 	"\aKidList\x18\x04 \x03(\x03R\aKidList\"6\n" +
 	"\x14AddSubscribeTplReply\x12\x1e\n" +
 	"\n" +
 	"TemplateId\x18\x01 \x01(\tR\n" +
-	"TemplateId\"\x8a\x01\n" +
-	"\x14GetBlockedTplRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x1c\n" +
+	"TemplateId\"\xa9\x01\n" +
+	"\x14GetBlockedTplRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x05 \x01(\tR\x05AppId\x12\x1c\n" +
 	"\tTmplMsgId\x18\x02 \x01(\tR\tTmplMsgId\x12\x1c\n" +
 	"\tLargestId\x18\x03 \x01(\x03R\tLargestId\x12\x14\n" +
 	"\x05Limit\x18\x04 \x01(\x03R\x05Limit\"\x96\x02\n" +
@@ -8378,12 +9877,14 @@ const file_v1_wxproxy_proto_rawDesc = "" +
 	"\tTmplMsgId\x18\x03 \x01(\tR\tTmplMsgId\x12\x14\n" +
 	"\x05Title\x18\x04 \x01(\tR\x05Title\x12\x18\n" +
 	"\aContent\x18\x05 \x01(\tR\aContent\x12$\n" +
-	"\rSendTimestamp\x18\x06 \x01(\x03R\rSendTimestamp\"\xfc\x03\n" +
-	"\x17SendSubscribeMsgRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x16\n" +
-	"\x06Touser\x18\x02 \x01(\tR\x06Touser\x12\x1e\n" +
+	"\rSendTimestamp\x18\x06 \x01(\x03R\rSendTimestamp\"\xad\x04\n" +
+	"\x17SendSubscribeMsgRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\n" +
+	" \x01(\tR\x05AppId\x12\x1f\n" +
+	"\x06Touser\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06Touser\x12'\n" +
 	"\n" +
-	"TemplateId\x18\x03 \x01(\tR\n" +
+	"TemplateId\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
 	"TemplateId\x12\x10\n" +
 	"\x03Url\x18\x04 \x01(\tR\x03Url\x12 \n" +
 	"\vClientMsgId\x18\x05 \x01(\tR\vClientMsgId\x12E\n" +
@@ -8398,12 +9899,13 @@ const file_v1_wxproxy_proto_rawDesc = "" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12F\n" +
 	"\x05value\x18\x02 \x01(\v20.api.wxproxy.v1.SendSubscribeMsgRequest.DataItemR\x05value:\x028\x01\"'\n" +
 	"\x0fSendTplMsgReply\x12\x14\n" +
-	"\x05Msgid\x18\x01 \x01(\x03R\x05Msgid\"\xbe\x03\n" +
-	"\x11SendTplMsgRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x16\n" +
-	"\x06Touser\x18\x02 \x01(\tR\x06Touser\x12\x1e\n" +
+	"\x05Msgid\x18\x01 \x01(\x03R\x05Msgid\"\xef\x03\n" +
+	"\x11SendTplMsgRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\b \x01(\tR\x05AppId\x12\x1f\n" +
+	"\x06Touser\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06Touser\x12'\n" +
 	"\n" +
-	"TemplateId\x18\x03 \x01(\tR\n" +
+	"TemplateId\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
 	"TemplateId\x12\x10\n" +
 	"\x03Url\x18\x04 \x01(\tR\x03Url\x12 \n" +
 	"\vClientMsgId\x18\x05 \x01(\tR\vClientMsgId\x12?\n" +
@@ -8417,14 +9919,16 @@ const file_v1_wxproxy_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\v2*.api.wxproxy.v1.SendTplMsgRequest.DataItemR\x05value:\x028\x01\"?\n" +
 	"\vMiniProgram\x12\x14\n" +
 	"\x05Appid\x18\x01 \x01(\tR\x05Appid\x12\x1a\n" +
-	"\bPagePath\x18\x02 \x01(\tR\bPagePath\"[\n" +
-	"\x17DeleteMessageTplRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x1e\n" +
+	"\bPagePath\x18\x02 \x01(\tR\bPagePath\"\x83\x01\n" +
+	"\x17DeleteMessageTplRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x03 \x01(\tR\x05AppId\x12'\n" +
 	"\n" +
-	"TemplateId\x18\x02 \x01(\tR\n" +
-	"TemplateId\"\x8a\x01\n" +
-	"\x12AddTemplateRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12(\n" +
+	"TemplateId\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"TemplateId\"\xa9\x01\n" +
+	"\x12AddTemplateRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x04 \x01(\tR\x05AppId\x12(\n" +
 	"\x0fTemplateIdShort\x18\x02 \x01(\tR\x0fTemplateIdShort\x12(\n" +
 	"\x0fKeywordNameList\x18\x03 \x03(\tR\x0fKeywordNameList\"4\n" +
 	"\x12AddMessageTplReply\x12\x1e\n" +
@@ -8441,9 +9945,10 @@ const file_v1_wxproxy_proto_rawDesc = "" +
 	"\aContent\x18\x03 \x01(\tR\aContent\x12\x18\n" +
 	"\aExample\x18\x04 \x01(\tR\aExample\x12(\n" +
 	"\x0fPrimaryIndustry\x18\x05 \x01(\tR\x0fPrimaryIndustry\x12,\n" +
-	"\x11SecondaryIndustry\x18\x06 \x01(\tR\x11SecondaryIndustry\"z\n" +
-	"\x12SetIndustryRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12 \n" +
+	"\x11SecondaryIndustry\x18\x06 \x01(\tR\x11SecondaryIndustry\"\x99\x01\n" +
+	"\x12SetIndustryRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x04 \x01(\tR\x05AppId\x12 \n" +
 	"\vIndustryId1\x18\x02 \x01(\tR\vIndustryId1\x12 \n" +
 	"\vIndustryId2\x18\x03 \x01(\tR\vIndustryId2\"\x8e\x02\n" +
 	"\x10GetIndustryReply\x12S\n" +
@@ -8453,13 +9958,15 @@ const file_v1_wxproxy_proto_rawDesc = "" +
 	"\n" +
 	"FirstClass\x18\x01 \x01(\tR\n" +
 	"FirstClass\x12 \n" +
-	"\vSecondClass\x18\x02 \x01(\tR\vSecondClass\"X\n" +
-	"\x1cDeleteConditionalMenuRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x16\n" +
-	"\x06Menuid\x18\x02 \x01(\x03R\x06Menuid\"\xad\x01\n" +
-	"\x11CreateMenuRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x122\n" +
-	"\x06Button\x18\x02 \x03(\v2\x1a.api.wxproxy.v1.MenuButtonR\x06Button\x12B\n" +
+	"\vSecondClass\x18\x02 \x01(\tR\vSecondClass\"w\n" +
+	"\x1cDeleteConditionalMenuRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x03 \x01(\tR\x05AppId\x12\x16\n" +
+	"\x06Menuid\x18\x02 \x01(\x03R\x06Menuid\"\xd6\x01\n" +
+	"\x11CreateMenuRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x04 \x01(\tR\x05AppId\x12<\n" +
+	"\x06Button\x18\x02 \x03(\v2\x1a.api.wxproxy.v1.MenuButtonB\b\xfaB\x05\x92\x01\x02\b\x01R\x06Button\x12B\n" +
 	"\tMatchrule\x18\x03 \x01(\v2$.api.wxproxy.v1.ConditionalMatchRuleR\tMatchrule\"\xc7\x01\n" +
 	"\rSelfMenuReply\x12\x1e\n" +
 	"\n" +
@@ -8491,9 +9998,10 @@ const file_v1_wxproxy_proto_rawDesc = "" +
 	"ContentUrl\x18\x05 \x01(\tR\n" +
 	"ContentUrl\x12\x1c\n" +
 	"\tSourceUrl\x18\x06 \x01(\tR\tSourceUrl\x12\x1c\n" +
-	"\tShowCover\x18\a \x01(\x03R\tShowCover\"O\n" +
-	"\x13TryMatchMenuRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x16\n" +
+	"\tShowCover\x18\a \x01(\x03R\tShowCover\"n\n" +
+	"\x13TryMatchMenuRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x03 \x01(\tR\x05AppId\x12\x16\n" +
 	"\x06UserId\x18\x02 \x01(\tR\x06UserId\"G\n" +
 	"\x11TryMatchMenuReply\x122\n" +
 	"\x06Button\x18\x01 \x03(\v2\x1a.api.wxproxy.v1.MenuButtonR\x06Button\"\xee\x01\n" +
@@ -8520,41 +10028,46 @@ const file_v1_wxproxy_proto_rawDesc = "" +
 	"\tMatchrule\x18\x03 \x01(\v2$.api.wxproxy.v1.ConditionalMatchRuleR\tMatchrule\"\\\n" +
 	"\x14ConditionalMatchRule\x12\x14\n" +
 	"\x05TagId\x18\x01 \x01(\tR\x05TagId\x12.\n" +
-	"\x12ClientPlatformType\x18\x02 \x01(\tR\x12ClientPlatformType\"S\n" +
-	"\x13FetchShortenRequest\x12\x1a\n" +
-	"\bShortKey\x18\x01 \x01(\tR\bShortKey\x12 \n" +
-	"\vAccessToken\x18\x02 \x01(\tR\vAccessToken\"u\n" +
+	"\x12ClientPlatformType\x18\x02 \x01(\tR\x12ClientPlatformType\"{\n" +
+	"\x13FetchShortenRequest\x12#\n" +
+	"\bShortKey\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bShortKey\x12)\n" +
+	"\vAccessToken\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x03 \x01(\tR\x05AppId\"u\n" +
 	"\x11FetchShortenReply\x12\x1a\n" +
 	"\bLongData\x18\x01 \x01(\tR\bLongData\x12\x1e\n" +
 	"\n" +
 	"CreateTime\x18\x02 \x01(\x03R\n" +
 	"CreateTime\x12$\n" +
-	"\rExpireSeconds\x18\x03 \x01(\x03R\rExpireSeconds\"w\n" +
-	"\x11GenShortenRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x1a\n" +
-	"\bLongData\x18\x02 \x01(\tR\bLongData\x12$\n" +
+	"\rExpireSeconds\x18\x03 \x01(\x03R\rExpireSeconds\"\x9f\x01\n" +
+	"\x11GenShortenRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x04 \x01(\tR\x05AppId\x12#\n" +
+	"\bLongData\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bLongData\x12$\n" +
 	"\rExpireSeconds\x18\x03 \x01(\x03R\rExpireSeconds\"-\n" +
 	"\x0fGenShortenReply\x12\x1a\n" +
 	"\bShortKey\x18\x01 \x01(\tR\bShortKey\"c\n" +
 	"\x11CreateQRCodeReply\x12\x16\n" +
 	"\x06Ticket\x18\x01 \x01(\tR\x06Ticket\x12$\n" +
 	"\rExpireSeconds\x18\x02 \x01(\x03R\rExpireSeconds\x12\x10\n" +
-	"\x03URL\x18\x03 \x01(\tR\x03URL\"s\n" +
-	"\x13CreateQRCodeRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12$\n" +
+	"\x03URL\x18\x03 \x01(\tR\x03URL\"\x92\x01\n" +
+	"\x13CreateQRCodeRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x04 \x01(\tR\x05AppId\x12$\n" +
 	"\rExpireSeconds\x18\x02 \x01(\x03R\rExpireSeconds\x12\x14\n" +
-	"\x05Scene\x18\x03 \x01(\tR\x05Scene\"p\n" +
-	"\x1cBatchUnTaggingMembersRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x0e\n" +
-	"\x02Id\x18\x02 \x01(\x03R\x02Id\x12\x1e\n" +
+	"\x05Scene\x18\x03 \x01(\tR\x05Scene\"\x99\x01\n" +
+	"\x1cBatchUnTaggingMembersRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x04 \x01(\tR\x05AppId\x12\x0e\n" +
+	"\x02Id\x18\x02 \x01(\x03R\x02Id\x12(\n" +
 	"\n" +
-	"OpenidList\x18\x03 \x03(\tR\n" +
-	"OpenidList\"n\n" +
-	"\x1aBatchTaggingMembersRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x0e\n" +
-	"\x02Id\x18\x02 \x01(\x03R\x02Id\x12\x1e\n" +
+	"OpenidList\x18\x03 \x03(\tB\b\xfaB\x05\x92\x01\x02\b\x01R\n" +
+	"OpenidList\"\x97\x01\n" +
+	"\x1aBatchTaggingMembersRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x04 \x01(\tR\x05AppId\x12\x0e\n" +
+	"\x02Id\x18\x02 \x01(\x03R\x02Id\x12(\n" +
 	"\n" +
-	"OpenidList\x18\x03 \x03(\tR\n" +
+	"OpenidList\x18\x03 \x03(\tB\b\xfaB\x05\x92\x01\x02\b\x01R\n" +
 	"OpenidList\"\xa9\x01\n" +
 	"\x12GetTagMembersReply\x12\x14\n" +
 	"\x05Count\x18\x01 \x01(\x03R\x05Count\x12\x1e\n" +
@@ -8563,23 +10076,27 @@ const file_v1_wxproxy_proto_rawDesc = "" +
 	"NextOpenid\x12<\n" +
 	"\x04Data\x18\x03 \x01(\v2(.api.wxproxy.v1.GetTagMembersReply.DataTR\x04Data\x1a\x1f\n" +
 	"\x05DataT\x12\x16\n" +
-	"\x06Openid\x18\x01 \x03(\tR\x06Openid\"h\n" +
-	"\x14GetTagMembersRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x0e\n" +
+	"\x06Openid\x18\x01 \x03(\tR\x06Openid\"\x87\x01\n" +
+	"\x14GetTagMembersRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x04 \x01(\tR\x05AppId\x12\x0e\n" +
 	"\x02Id\x18\x02 \x01(\x03R\x02Id\x12\x1e\n" +
 	"\n" +
 	"NextOpenid\x18\x03 \x01(\tR\n" +
-	"NextOpenid\"D\n" +
-	"\x10DeleteTagRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x0e\n" +
-	"\x02Id\x18\x02 \x01(\x03R\x02Id\"X\n" +
-	"\x10UpdateTagRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x0e\n" +
-	"\x02Id\x18\x02 \x01(\x03R\x02Id\x12\x12\n" +
-	"\x04Name\x18\x03 \x01(\tR\x04Name\"H\n" +
-	"\x10CreateTagRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x12\n" +
-	"\x04Name\x18\x02 \x01(\tR\x04Name\"7\n" +
+	"NextOpenid\"c\n" +
+	"\x10DeleteTagRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x03 \x01(\tR\x05AppId\x12\x0e\n" +
+	"\x02Id\x18\x02 \x01(\x03R\x02Id\"\x80\x01\n" +
+	"\x10UpdateTagRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x04 \x01(\tR\x05AppId\x12\x0e\n" +
+	"\x02Id\x18\x02 \x01(\x03R\x02Id\x12\x1b\n" +
+	"\x04Name\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04Name\"p\n" +
+	"\x10CreateTagRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x03 \x01(\tR\x05AppId\x12\x1b\n" +
+	"\x04Name\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04Name\"7\n" +
 	"\x0eCreateTagReply\x12%\n" +
 	"\x03tag\x18\x01 \x01(\v2\x13.api.wxproxy.v1.TagR\x03tag\":\n" +
 	"\x0fGetTagListReply\x12'\n" +
@@ -8587,30 +10104,34 @@ const file_v1_wxproxy_proto_rawDesc = "" +
 	"\x03Tag\x12\x0e\n" +
 	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x12\n" +
 	"\x04Name\x18\x02 \x01(\tR\x04Name\x12\x14\n" +
-	"\x05Count\x18\x03 \x01(\x03R\x05Count\"m\n" +
-	"\x19UpdateMemberRemarkRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x16\n" +
-	"\x06Openid\x18\x02 \x01(\tR\x06Openid\x12\x16\n" +
+	"\x05Count\x18\x03 \x01(\x03R\x05Count\"\x95\x01\n" +
+	"\x19UpdateMemberRemarkRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x04 \x01(\tR\x05AppId\x12\x1f\n" +
+	"\x06Openid\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06Openid\x12\x16\n" +
 	"\x06Remark\x18\x03 \x01(\tR\x06Remark\"@\n" +
 	"\fWXErrorReply\x12\x18\n" +
 	"\aErrcode\x18\x01 \x01(\x03R\aErrcode\x12\x16\n" +
-	"\x06Errmsg\x18\x02 \x01(\tR\x06Errmsg\"P\n" +
-	"\x14GetMemberTagsRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x16\n" +
-	"\x06Openid\x18\x02 \x01(\tR\x06Openid\"2\n" +
+	"\x06Errmsg\x18\x02 \x01(\tR\x06Errmsg\"x\n" +
+	"\x14GetMemberTagsRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x03 \x01(\tR\x05AppId\x12\x1f\n" +
+	"\x06Openid\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06Openid\"2\n" +
 	"\x12GetMemberTagsReply\x12\x1c\n" +
-	"\tTagidList\x18\x01 \x03(\x03R\tTagidList\"\xb5\x01\n" +
-	"\x19BatchGetMemberInfoRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12P\n" +
-	"\bUserList\x18\x02 \x03(\v24.api.wxproxy.v1.BatchGetMemberInfoRequest.OpenIdListR\bUserList\x1a$\n" +
+	"\tTagidList\x18\x01 \x03(\x03R\tTagidList\"\xde\x01\n" +
+	"\x19BatchGetMemberInfoRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x03 \x01(\tR\x05AppId\x12Z\n" +
+	"\bUserList\x18\x02 \x03(\v24.api.wxproxy.v1.BatchGetMemberInfoRequest.OpenIdListB\b\xfaB\x05\x92\x01\x02\b\x01R\bUserList\x1a$\n" +
 	"\n" +
 	"OpenIdList\x12\x16\n" +
 	"\x06Openid\x18\x01 \x01(\tR\x06Openid\"a\n" +
 	"\x17BatchGetMemberInfoReply\x12F\n" +
-	"\fUserListInfo\x18\x01 \x03(\v2\".api.wxproxy.v1.GetMemberInfoReplyR\fUserListInfo\"d\n" +
-	"\x14GetMemberInfoRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x16\n" +
-	"\x06Openid\x18\x02 \x01(\tR\x06Openid\x12\x12\n" +
+	"\fUserListInfo\x18\x01 \x03(\v2\".api.wxproxy.v1.GetMemberInfoReplyR\fUserListInfo\"\x8c\x01\n" +
+	"\x14GetMemberInfoRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x04 \x01(\tR\x05AppId\x12\x1f\n" +
+	"\x06Openid\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06Openid\x12\x12\n" +
 	"\x04Lang\x18\x03 \x01(\tR\x04Lang\"\xd8\x02\n" +
 	"\x12GetMemberInfoReply\x12\x1c\n" +
 	"\tSubscribe\x18\x01 \x01(\x03R\tSubscribe\x12\x16\n" +
@@ -8626,9 +10147,10 @@ const file_v1_wxproxy_proto_rawDesc = "" +
 	"\n" +
 	"QrSceneStr\x18\v \x01(\tR\n" +
 	"QrSceneStr\x12\x1a\n" +
-	"\bLanguage\x18\f \x01(\tR\bLanguage\"X\n" +
-	"\x14GetMemberListRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x1e\n" +
+	"\bLanguage\x18\f \x01(\tR\bLanguage\"w\n" +
+	"\x14GetMemberListRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x03 \x01(\tR\x05AppId\x12\x1e\n" +
 	"\n" +
 	"NextOpenid\x18\x02 \x01(\tR\n" +
 	"NextOpenid\"\xdd\x01\n" +
@@ -8643,12 +10165,14 @@ const file_v1_wxproxy_proto_rawDesc = "" +
 	"\x06openid\x18\x01 \x03(\v2\x1a.api.wxproxy.v1.OpenIdListR\x06openid\"$\n" +
 	"\n" +
 	"OpenIdList\x12\x16\n" +
-	"\x06Openid\x18\x01 \x01(\tR\x06Openid\"4\n" +
-	"\x10AccessTokenParam\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\"O\n" +
-	"\x11DeleteMaterialReq\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x18\n" +
-	"\aMediaId\x18\x02 \x01(\tR\aMediaId\"\x95\x01\n" +
+	"\x06Openid\x18\x01 \x01(\tR\x06Openid\"S\n" +
+	"\x10AccessTokenParam\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x02 \x01(\tR\x05AppId\"w\n" +
+	"\x11DeleteMaterialReq\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x03 \x01(\tR\x05AppId\x12!\n" +
+	"\aMediaId\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\aMediaId\"\x95\x01\n" +
 	"\x15GetMaterialCountReply\x12\x1e\n" +
 	"\n" +
 	"voiceCount\x18\x01 \x01(\x03R\n" +
@@ -8659,12 +10183,13 @@ const file_v1_wxproxy_proto_rawDesc = "" +
 	"\n" +
 	"imageCount\x18\x03 \x01(\x03R\n" +
 	"imageCount\x12\x1c\n" +
-	"\tnewsCount\x18\x04 \x01(\x03R\tnewsCount\"|\n" +
-	"\x16GetMaterialListRequest\x12 \n" +
-	"\vAccessToken\x18\x01 \x01(\tR\vAccessToken\x12\x12\n" +
-	"\x04Type\x18\x02 \x01(\tR\x04Type\x12\x16\n" +
-	"\x06Offset\x18\x03 \x01(\x03R\x06Offset\x12\x14\n" +
-	"\x05Count\x18\x04 \x01(\x03R\x05Count\"\x86\x01\n" +
+	"\tnewsCount\x18\x04 \x01(\x03R\tnewsCount\"\xd1\x01\n" +
+	"\x16GetMaterialListRequest\x12)\n" +
+	"\vAccessToken\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vAccessToken\x12\x14\n" +
+	"\x05AppId\x18\x05 \x01(\tR\x05AppId\x124\n" +
+	"\x04Type\x18\x02 \x01(\tB \xfaB\x1dr\x1bR\x05imageR\x05videoR\x05voiceR\x04newsR\x04Type\x12\x1f\n" +
+	"\x06Offset\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x06Offset\x12\x1f\n" +
+	"\x05Count\x18\x04 \x01(\x03B\t\xfaB\x06\"\x04\x18\x14(\x01R\x05Count\"\x86\x01\n" +
 	"\x14GetMaterialListReply\x12\x1e\n" +
 	"\n" +
 	"TotalCount\x18\x01 \x01(\x03R\n" +
@@ -8698,7 +10223,7 @@ const file_v1_wxproxy_proto_rawDesc = "" +
 	"\aContent\x18\x05 \x01(\tR\aContent\x12*\n" +
 	"\x10ContentSourceUrl\x18\x06 \x01(\tR\x10ContentSourceUrl\x12\x10\n" +
 	"\x03Url\x18\a \x01(\tR\x03Url\x12\"\n" +
-	"\fThumbMediaId\x18\b \x01(\tR\fThumbMediaId2\xe8F\n" +
+	"\fThumbMediaId\x18\b \x01(\tR\fThumbMediaId2\xcdN\n" +
 	"\aMpproxy\x12S\n" +
 	"\x0eDeleteMaterial\x12!.api.wxproxy.v1.DeleteMaterialReq\x1a\x1c.api.wxproxy.v1.WXErrorReply\"\x00\x12\x80\x01\n" +
 	"\x10GetMaterialCount\x12 .api.wxproxy.v1.AccessTokenParam\x1a%.api.wxproxy.v1.GetMaterialCountReply\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/mpproxy/v1/materials/count\x12i\n" +
@@ -8774,7 +10299,18 @@ const file_v1_wxproxy_proto_rawDesc = "" +
 	"\x14SendKFMiniProgramMsg\x12+.api.wxproxy.v1.SendKFMiniProgramMsgRequest\x1a\x1c.api.wxproxy.v1.WXErrorReply\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/mpproxy/v1/kf/message/sendmp\x12M\n" +
 	"\vBlockMember\x12\x1e.api.wxproxy.v1.BlockMemberReq\x1a\x1c.api.wxproxy.v1.WXErrorReply\"\x00\x12O\n" +
 	"\rUnBlockMember\x12\x1e.api.wxproxy.v1.BlockMemberReq\x1a\x1c.api.wxproxy.v1.WXErrorReply\"\x00\x12T\n" +
-	"\fGetBlacklist\x12\x1f.api.wxproxy.v1.GetBlacklistReq\x1a!.api.wxproxy.v1.GetBlacklistReply\"\x00B2\n" +
+	"\fGetBlacklist\x12\x1f.api.wxproxy.v1.GetBlacklistReq\x1a!.api.wxproxy.v1.GetBlacklistReply\"\x00\x12w\n" +
+	"\x0eGetAccessToken\x12%.api.wxproxy.v1.GetAccessTokenRequest\x1a#.api.wxproxy.v1.GetAccessTokenReply\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/mpproxy/v1/token\x12\x8e\x01\n" +
+	"\x12RefreshAccessToken\x12).api.wxproxy.v1.RefreshAccessTokenRequest\x1a'.api.wxproxy.v1.RefreshAccessTokenReply\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/mpproxy/v1/token/refresh\x12\x8d\x01\n" +
+	"\x11DescribeErrorCode\x12(.api.wxproxy.v1.DescribeErrorCodeRequest\x1a&.api.wxproxy.v1.DescribeErrorCodeReply\"&\x82\xd3\xe4\x93\x02 \x12\x1e/mpproxy/v1/errcodes/{Errcode}\x12p\n" +
+	"\n" +
+	"GetRidInfo\x12!.api.wxproxy.v1.GetRidInfoRequest\x1a\x1f.api.wxproxy.v1.GetRidInfoReply\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/mpproxy/v1/rids/{Rid}\x12n\n" +
+	"\vGetApiQuota\x12\".api.wxproxy.v1.GetApiQuotaRequest\x1a .api.wxproxy.v1.GetApiQuotaReply\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/mpproxy/v1/quota\x12q\n" +
+	"\n" +
+	"ClearQuota\x12!.api.wxproxy.v1.ClearQuotaRequest\x1a\x1c.api.wxproxy.v1.WXErrorReply\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/mpproxy/v1/quota/clear\x12x\n" +
+	"\fClearQuotaV2\x12#.api.wxproxy.v1.ClearQuotaV2Request\x1a\x1c.api.wxproxy.v1.WXErrorReply\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/mpproxy/v1/quota/clear/v2\x12z\n" +
+	"\rGetQuotaUsage\x12$.api.wxproxy.v1.GetQuotaUsageRequest\x1a\".api.wxproxy.v1.GetQuotaUsageReply\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/mpproxy/v1/quota/usageB\x9e\x01\xbaGi\x12g\n" +
+	"\aWXProxy\x12X微信公众号接口代理的HTTP/JSON接口, 由proto中的google.api.http路由生成2\x02v1\n" +
 	"\x06api.v1P\x01Z&github.com/seth16888/wxproxy/api/v1;v1b\x06proto3"
 
 var (
@@ -8789,365 +10325,397 @@ func file_v1_wxproxy_proto_rawDescGZIP() []byte {
 	return file_v1_wxproxy_proto_rawDescData
 }
 
-var file_v1_wxproxy_proto_msgTypes = make([]protoimpl.MessageInfo, 139)
+var file_v1_wxproxy_proto_msgTypes = make([]protoimpl.MessageInfo, 154)
 var file_v1_wxproxy_proto_goTypes = []any{
-	(*GetBlacklistReq)(nil),                              // 0: api.wxproxy.v1.GetBlacklistReq
-	(*GetBlacklistReply)(nil),                            // 1: api.wxproxy.v1.GetBlacklistReply
-	(*BlockMemberReq)(nil),                               // 2: api.wxproxy.v1.BlockMemberReq
-	(*SendKFMiniProgramMsgRequest)(nil),                  // 3: api.wxproxy.v1.SendKFMiniProgramMsgRequest
-	(*SendKFCardMsgRequest)(nil),                         // 4: api.wxproxy.v1.SendKFCardMsgRequest
-	(*SendKFMenuMsgRequest)(nil),                         // 5: api.wxproxy.v1.SendKFMenuMsgRequest
-	(*SendKFToArticleMsgRequest)(nil),                    // 6: api.wxproxy.v1.SendKFToArticleMsgRequest
-	(*SendKFNewsPageMsgRequest)(nil),                     // 7: api.wxproxy.v1.SendKFNewsPageMsgRequest
-	(*SendKFNewsCardMsgRequest)(nil),                     // 8: api.wxproxy.v1.SendKFNewsCardMsgRequest
-	(*SendKFMusicMsgRequest)(nil),                        // 9: api.wxproxy.v1.SendKFMusicMsgRequest
-	(*SendKFVideoMsgRequest)(nil),                        // 10: api.wxproxy.v1.SendKFVideoMsgRequest
-	(*SendKFVoiceMsgRequest)(nil),                        // 11: api.wxproxy.v1.SendKFVoiceMsgRequest
-	(*SendKFImageMsgRequest)(nil),                        // 12: api.wxproxy.v1.SendKFImageMsgRequest
-	(*KFMessageCommon)(nil),                              // 13: api.wxproxy.v1.KFMessageCommon
-	(*SendKFTextMsgRequest)(nil),                         // 14: api.wxproxy.v1.SendKFTextMsgRequest
-	(*NewKFSessionRequest)(nil),                          // 15: api.wxproxy.v1.NewKFSessionRequest
-	(*CloseKFSessionRequest)(nil),                        // 16: api.wxproxy.v1.CloseKFSessionRequest
-	(*GetKFSessionUnacceptedReply)(nil),                  // 17: api.wxproxy.v1.GetKFSessionUnacceptedReply
-	(*GetKFSessionStatusReply)(nil),                      // 18: api.wxproxy.v1.GetKFSessionStatusReply
-	(*GetKFSessionStatusRequest)(nil),                    // 19: api.wxproxy.v1.GetKFSessionStatusRequest
-	(*GetKFSessionListReply)(nil),                        // 20: api.wxproxy.v1.GetKFSessionListReply
-	(*KFSession)(nil),                                    // 21: api.wxproxy.v1.KFSession
-	(*GetKFSessionListRequest)(nil),                      // 22: api.wxproxy.v1.GetKFSessionListRequest
-	(*UpdateKFTypingRequest)(nil),                        // 23: api.wxproxy.v1.UpdateKFTypingRequest
-	(*UpdateKFAvatarRequest)(nil),                        // 24: api.wxproxy.v1.UpdateKFAvatarRequest
-	(*InviteKFWorkerRequest)(nil),                        // 25: api.wxproxy.v1.InviteKFWorkerRequest
-	(*DelKFAccountRequest)(nil),                          // 26: api.wxproxy.v1.DelKFAccountRequest
-	(*UpdateKFAccountRequest)(nil),                       // 27: api.wxproxy.v1.UpdateKFAccountRequest
-	(*AddKFAccountRequest)(nil),                          // 28: api.wxproxy.v1.AddKFAccountRequest
-	(*GetKFMsgHistoryReply)(nil),                         // 29: api.wxproxy.v1.GetKFMsgHistoryReply
-	(*KFMsgHistory)(nil),                                 // 30: api.wxproxy.v1.KFMsgHistory
-	(*GetKFMsgHistoryRequest)(nil),                       // 31: api.wxproxy.v1.GetKFMsgHistoryRequest
-	(*GetKFOnlineListReply)(nil),                         // 32: api.wxproxy.v1.GetKFOnlineListReply
-	(*KFOnlineInfo)(nil),                                 // 33: api.wxproxy.v1.KFOnlineInfo
-	(*GetKFListReply)(nil),                               // 34: api.wxproxy.v1.GetKFListReply
-	(*KeFuInfo)(nil),                                     // 35: api.wxproxy.v1.KeFuInfo
-	(*SendSubscribeMessageRequest)(nil),                  // 36: api.wxproxy.v1.SendSubscribeMessageRequest
-	(*GetSubscribePrivateTplReply)(nil),                  // 37: api.wxproxy.v1.GetSubscribePrivateTplReply
-	(*GetSubscribeTplTitlesReply)(nil),                   // 38: api.wxproxy.v1.GetSubscribeTplTitlesReply
-	(*GetSubscribeTplTitlesRequest)(nil),                 // 39: api.wxproxy.v1.GetSubscribeTplTitlesRequest
-	(*GetSubscribeTplKeywordsReply)(nil),                 // 40: api.wxproxy.v1.GetSubscribeTplKeywordsReply
-	(*GetSubscribeTplKeywordsRequest)(nil),               // 41: api.wxproxy.v1.GetSubscribeTplKeywordsRequest
-	(*GetSubscribeCategoryReply)(nil),                    // 42: api.wxproxy.v1.GetSubscribeCategoryReply
-	(*DelSubscribeTplRequest)(nil),                       // 43: api.wxproxy.v1.DelSubscribeTplRequest
-	(*AddSubscribeTplRequest)(nil),                       // 44: api.wxproxy.v1.AddSubscribeTplRequest
-	(*AddSubscribeTplReply)(nil),                         // 45: api.wxproxy.v1.AddSubscribeTplReply
-	(*GetBlockedTplRequest)(nil),                         // 46: api.wxproxy.v1.GetBlockedTplRequest
-	(*GetBlockedTplMsgReply)(nil),                        // 47: api.wxproxy.v1.GetBlockedTplMsgReply
-	(*SendSubscribeMsgRequest)(nil),                      // 48: api.wxproxy.v1.SendSubscribeMsgRequest
-	(*SendTplMsgReply)(nil),                              // 49: api.wxproxy.v1.SendTplMsgReply
-	(*SendTplMsgRequest)(nil),                            // 50: api.wxproxy.v1.SendTplMsgRequest
-	(*MiniProgram)(nil),                                  // 51: api.wxproxy.v1.MiniProgram
-	(*DeleteMessageTplRequest)(nil),                      // 52: api.wxproxy.v1.DeleteMessageTplRequest
-	(*AddTemplateRequest)(nil),                           // 53: api.wxproxy.v1.AddTemplateRequest
-	(*AddMessageTplReply)(nil),                           // 54: api.wxproxy.v1.AddMessageTplReply
-	(*GetAllPrivateTplReply)(nil),                        // 55: api.wxproxy.v1.GetAllPrivateTplReply
-	(*SetIndustryRequest)(nil),                           // 56: api.wxproxy.v1.SetIndustryRequest
-	(*GetIndustryReply)(nil),                             // 57: api.wxproxy.v1.GetIndustryReply
-	(*DeleteConditionalMenuRequest)(nil),                 // 58: api.wxproxy.v1.DeleteConditionalMenuRequest
-	(*CreateMenuRequest)(nil),                            // 59: api.wxproxy.v1.CreateMenuRequest
-	(*SelfMenuReply)(nil),                                // 60: api.wxproxy.v1.SelfMenuReply
-	(*SelfMenuButton)(nil),                               // 61: api.wxproxy.v1.SelfMenuButton
-	(*NewsButton)(nil),                                   // 62: api.wxproxy.v1.NewsButton
-	(*TryMatchMenuRequest)(nil),                          // 63: api.wxproxy.v1.TryMatchMenuRequest
-	(*TryMatchMenuReply)(nil),                            // 64: api.wxproxy.v1.TryMatchMenuReply
-	(*MenuInfoReply)(nil),                                // 65: api.wxproxy.v1.MenuInfoReply
-	(*MenuButton)(nil),                                   // 66: api.wxproxy.v1.MenuButton
-	(*ConditionalMenu)(nil),                              // 67: api.wxproxy.v1.ConditionalMenu
-	(*ConditionalMatchRule)(nil),                         // 68: api.wxproxy.v1.ConditionalMatchRule
-	(*FetchShortenRequest)(nil),                          // 69: api.wxproxy.v1.FetchShortenRequest
-	(*FetchShortenReply)(nil),                            // 70: api.wxproxy.v1.FetchShortenReply
-	(*GenShortenRequest)(nil),                            // 71: api.wxproxy.v1.GenShortenRequest
-	(*GenShortenReply)(nil),                              // 72: api.wxproxy.v1.GenShortenReply
-	(*CreateQRCodeReply)(nil),                            // 73: api.wxproxy.v1.CreateQRCodeReply
-	(*CreateQRCodeRequest)(nil),                          // 74: api.wxproxy.v1.CreateQRCodeRequest
-	(*BatchUnTaggingMembersRequest)(nil),                 // 75: api.wxproxy.v1.BatchUnTaggingMembersRequest
-	(*BatchTaggingMembersRequest)(nil),                   // 76: api.wxproxy.v1.BatchTaggingMembersRequest
-	(*GetTagMembersReply)(nil),                           // 77: api.wxproxy.v1.GetTagMembersReply
-	(*GetTagMembersRequest)(nil),                         // 78: api.wxproxy.v1.GetTagMembersRequest
-	(*DeleteTagRequest)(nil),                             // 79: api.wxproxy.v1.DeleteTagRequest
-	(*UpdateTagRequest)(nil),                             // 80: api.wxproxy.v1.UpdateTagRequest
-	(*CreateTagRequest)(nil),                             // 81: api.wxproxy.v1.CreateTagRequest
-	(*CreateTagReply)(nil),                               // 82: api.wxproxy.v1.CreateTagReply
-	(*GetTagListReply)(nil),                              // 83: api.wxproxy.v1.GetTagListReply
-	(*Tag)(nil),                                          // 84: api.wxproxy.v1.Tag
-	(*UpdateMemberRemarkRequest)(nil),                    // 85: api.wxproxy.v1.UpdateMemberRemarkRequest
-	(*WXErrorReply)(nil),                                 // 86: api.wxproxy.v1.WXErrorReply
-	(*GetMemberTagsRequest)(nil),                         // 87: api.wxproxy.v1.GetMemberTagsRequest
-	(*GetMemberTagsReply)(nil),                           // 88: api.wxproxy.v1.GetMemberTagsReply
-	(*BatchGetMemberInfoRequest)(nil),                    // 89: api.wxproxy.v1.BatchGetMemberInfoRequest
-	(*BatchGetMemberInfoReply)(nil),                      // 90: api.wxproxy.v1.BatchGetMemberInfoReply
-	(*GetMemberInfoRequest)(nil),                         // 91: api.wxproxy.v1.GetMemberInfoRequest
-	(*GetMemberInfoReply)(nil),                           // 92: api.wxproxy.v1.GetMemberInfoReply
-	(*GetMemberListRequest)(nil),                         // 93: api.wxproxy.v1.GetMemberListRequest
-	(*GetMemberListReply)(nil),                           // 94: api.wxproxy.v1.GetMemberListReply
-	(*OpenIdList)(nil),                                   // 95: api.wxproxy.v1.OpenIdList
-	(*AccessTokenParam)(nil),                             // 96: api.wxproxy.v1.AccessTokenParam
-	(*DeleteMaterialReq)(nil),                            // 97: api.wxproxy.v1.DeleteMaterialReq
-	(*GetMaterialCountReply)(nil),                        // 98: api.wxproxy.v1.GetMaterialCountReply
-	(*GetMaterialListRequest)(nil),                       // 99: api.wxproxy.v1.GetMaterialListRequest
-	(*GetMaterialListReply)(nil),                         // 100: api.wxproxy.v1.GetMaterialListReply
-	(*MaterialItem)(nil),                                 // 101: api.wxproxy.v1.MaterialItem
-	(*GetMaterialNewsListReply)(nil),                     // 102: api.wxproxy.v1.GetMaterialNewsListReply
-	(*MaterialNewsItem)(nil),                             // 103: api.wxproxy.v1.MaterialNewsItem
-	(*NewsArticle)(nil),                                  // 104: api.wxproxy.v1.NewsArticle
-	(*SendKFMiniProgramMsgRequest_KFMiniProgramMsg)(nil), // 105: api.wxproxy.v1.SendKFMiniProgramMsgRequest.KFMiniProgramMsg
-	(*SendKFCardMsgRequest_KFCardMsg)(nil),               // 106: api.wxproxy.v1.SendKFCardMsgRequest.KFCardMsg
-	(*SendKFMenuMsgRequest_Item)(nil),                    // 107: api.wxproxy.v1.SendKFMenuMsgRequest.Item
-	(*SendKFMenuMsgRequest_MenuMsg)(nil),                 // 108: api.wxproxy.v1.SendKFMenuMsgRequest.MenuMsg
-	(*SendKFToArticleMsgRequest_ToArticleMsg)(nil),       // 109: api.wxproxy.v1.SendKFToArticleMsgRequest.ToArticleMsg
-	(*SendKFNewsPageMsgRequest_KFNewsPageMsg)(nil),       // 110: api.wxproxy.v1.SendKFNewsPageMsgRequest.KFNewsPageMsg
-	(*SendKFNewsCardMsgRequest_KFNewsCardMsg)(nil),       // 111: api.wxproxy.v1.SendKFNewsCardMsgRequest.KFNewsCardMsg
-	(*SendKFMusicMsgRequest_KFMusicMsg)(nil),             // 112: api.wxproxy.v1.SendKFMusicMsgRequest.KFMusicMsg
-	(*SendKFVideoMsgRequest_KFVideoMsg)(nil),             // 113: api.wxproxy.v1.SendKFVideoMsgRequest.KFVideoMsg
-	(*SendKFVoiceMsgRequest_KFVoiceMsg)(nil),             // 114: api.wxproxy.v1.SendKFVoiceMsgRequest.KFVoiceMsg
-	(*SendKFImageMsgRequest_KFImageMsg)(nil),             // 115: api.wxproxy.v1.SendKFImageMsgRequest.KFImageMsg
-	(*KFMessageCommon_KFAccount)(nil),                    // 116: api.wxproxy.v1.KFMessageCommon.KFAccount
-	(*SendKFTextMsgRequest_KFTextMsg)(nil),               // 117: api.wxproxy.v1.SendKFTextMsgRequest.KFTextMsg
-	(*GetKFSessionUnacceptedReply_WaitCase)(nil),         // 118: api.wxproxy.v1.GetKFSessionUnacceptedReply.WaitCase
-	(*SendSubscribeMessageRequest_DataItem)(nil),         // 119: api.wxproxy.v1.SendSubscribeMessageRequest.DataItem
-	nil,                                          // 120: api.wxproxy.v1.SendSubscribeMessageRequest.DataEntry
-	(*GetSubscribePrivateTplReply_Item)(nil),     // 121: api.wxproxy.v1.GetSubscribePrivateTplReply.Item
-	(*GetSubscribeTplTitlesReply_Item)(nil),      // 122: api.wxproxy.v1.GetSubscribeTplTitlesReply.Item
-	(*GetSubscribeTplKeywordsReply_Item)(nil),    // 123: api.wxproxy.v1.GetSubscribeTplKeywordsReply.Item
-	(*GetSubscribeCategoryReply_Category)(nil),   // 124: api.wxproxy.v1.GetSubscribeCategoryReply.Category
-	(*GetBlockedTplMsgReply_BlockedMsgInfo)(nil), // 125: api.wxproxy.v1.GetBlockedTplMsgReply.BlockedMsgInfo
-	(*SendSubscribeMsgRequest_DataItem)(nil),     // 126: api.wxproxy.v1.SendSubscribeMsgRequest.DataItem
-	nil,                                          // 127: api.wxproxy.v1.SendSubscribeMsgRequest.DataEntry
-	(*SendTplMsgRequest_DataItem)(nil),           // 128: api.wxproxy.v1.SendTplMsgRequest.DataItem
-	nil,                                          // 129: api.wxproxy.v1.SendTplMsgRequest.DataEntry
-	(*GetAllPrivateTplReply_TplInfo)(nil),        // 130: api.wxproxy.v1.GetAllPrivateTplReply.TplInfo
-	(*GetIndustryReply_Industry)(nil),            // 131: api.wxproxy.v1.GetIndustryReply.Industry
-	(*SelfMenuReply_MenuInfoType)(nil),           // 132: api.wxproxy.v1.SelfMenuReply.MenuInfoType
-	(*SelfMenuButton_SubButtonType)(nil),         // 133: api.wxproxy.v1.SelfMenuButton.SubButtonType
-	(*SelfMenuButton_NewsButtonType)(nil),        // 134: api.wxproxy.v1.SelfMenuButton.NewsButtonType
-	(*MenuInfoReply_MenuType)(nil),               // 135: api.wxproxy.v1.MenuInfoReply.MenuType
-	(*GetTagMembersReply_DataT)(nil),             // 136: api.wxproxy.v1.GetTagMembersReply.DataT
-	(*BatchGetMemberInfoRequest_OpenIdList)(nil), // 137: api.wxproxy.v1.BatchGetMemberInfoRequest.OpenIdList
-	(*GetMemberListReply_IdList)(nil),            // 138: api.wxproxy.v1.GetMemberListReply.IdList
+	(*GetAccessTokenRequest)(nil),                        // 0: api.wxproxy.v1.GetAccessTokenRequest
+	(*GetAccessTokenReply)(nil),                          // 1: api.wxproxy.v1.GetAccessTokenReply
+	(*RefreshAccessTokenRequest)(nil),                    // 2: api.wxproxy.v1.RefreshAccessTokenRequest
+	(*RefreshAccessTokenReply)(nil),                      // 3: api.wxproxy.v1.RefreshAccessTokenReply
+	(*DescribeErrorCodeRequest)(nil),                     // 4: api.wxproxy.v1.DescribeErrorCodeRequest
+	(*DescribeErrorCodeReply)(nil),                       // 5: api.wxproxy.v1.DescribeErrorCodeReply
+	(*GetRidInfoRequest)(nil),                            // 6: api.wxproxy.v1.GetRidInfoRequest
+	(*GetRidInfoReply)(nil),                              // 7: api.wxproxy.v1.GetRidInfoReply
+	(*GetApiQuotaRequest)(nil),                           // 8: api.wxproxy.v1.GetApiQuotaRequest
+	(*GetApiQuotaReply)(nil),                             // 9: api.wxproxy.v1.GetApiQuotaReply
+	(*ClearQuotaRequest)(nil),                            // 10: api.wxproxy.v1.ClearQuotaRequest
+	(*ClearQuotaV2Request)(nil),                          // 11: api.wxproxy.v1.ClearQuotaV2Request
+	(*GetQuotaUsageRequest)(nil),                         // 12: api.wxproxy.v1.GetQuotaUsageRequest
+	(*QuotaUsage)(nil),                                   // 13: api.wxproxy.v1.QuotaUsage
+	(*GetQuotaUsageReply)(nil),                           // 14: api.wxproxy.v1.GetQuotaUsageReply
+	(*GetBlacklistReq)(nil),                              // 15: api.wxproxy.v1.GetBlacklistReq
+	(*GetBlacklistReply)(nil),                            // 16: api.wxproxy.v1.GetBlacklistReply
+	(*BlockMemberReq)(nil),                               // 17: api.wxproxy.v1.BlockMemberReq
+	(*SendKFMiniProgramMsgRequest)(nil),                  // 18: api.wxproxy.v1.SendKFMiniProgramMsgRequest
+	(*SendKFCardMsgRequest)(nil),                         // 19: api.wxproxy.v1.SendKFCardMsgRequest
+	(*SendKFMenuMsgRequest)(nil),                         // 20: api.wxproxy.v1.SendKFMenuMsgRequest
+	(*SendKFToArticleMsgRequest)(nil),                    // 21: api.wxproxy.v1.SendKFToArticleMsgRequest
+	(*SendKFNewsPageMsgRequest)(nil),                     // 22: api.wxproxy.v1.SendKFNewsPageMsgRequest
+	(*SendKFNewsCardMsgRequest)(nil),                     // 23: api.wxproxy.v1.SendKFNewsCardMsgRequest
+	(*SendKFMusicMsgRequest)(nil),                        // 24: api.wxproxy.v1.SendKFMusicMsgRequest
+	(*SendKFVideoMsgRequest)(nil),                        // 25: api.wxproxy.v1.SendKFVideoMsgRequest
+	(*SendKFVoiceMsgRequest)(nil),                        // 26: api.wxproxy.v1.SendKFVoiceMsgRequest
+	(*SendKFImageMsgRequest)(nil),                        // 27: api.wxproxy.v1.SendKFImageMsgRequest
+	(*KFMessageCommon)(nil),                              // 28: api.wxproxy.v1.KFMessageCommon
+	(*SendKFTextMsgRequest)(nil),                         // 29: api.wxproxy.v1.SendKFTextMsgRequest
+	(*NewKFSessionRequest)(nil),                          // 30: api.wxproxy.v1.NewKFSessionRequest
+	(*CloseKFSessionRequest)(nil),                        // 31: api.wxproxy.v1.CloseKFSessionRequest
+	(*GetKFSessionUnacceptedReply)(nil),                  // 32: api.wxproxy.v1.GetKFSessionUnacceptedReply
+	(*GetKFSessionStatusReply)(nil),                      // 33: api.wxproxy.v1.GetKFSessionStatusReply
+	(*GetKFSessionStatusRequest)(nil),                    // 34: api.wxproxy.v1.GetKFSessionStatusRequest
+	(*GetKFSessionListReply)(nil),                        // 35: api.wxproxy.v1.GetKFSessionListReply
+	(*KFSession)(nil),                                    // 36: api.wxproxy.v1.KFSession
+	(*GetKFSessionListRequest)(nil),                      // 37: api.wxproxy.v1.GetKFSessionListRequest
+	(*UpdateKFTypingRequest)(nil),                        // 38: api.wxproxy.v1.UpdateKFTypingRequest
+	(*UpdateKFAvatarRequest)(nil),                        // 39: api.wxproxy.v1.UpdateKFAvatarRequest
+	(*InviteKFWorkerRequest)(nil),                        // 40: api.wxproxy.v1.InviteKFWorkerRequest
+	(*DelKFAccountRequest)(nil),                          // 41: api.wxproxy.v1.DelKFAccountRequest
+	(*UpdateKFAccountRequest)(nil),                       // 42: api.wxproxy.v1.UpdateKFAccountRequest
+	(*AddKFAccountRequest)(nil),                          // 43: api.wxproxy.v1.AddKFAccountRequest
+	(*GetKFMsgHistoryReply)(nil),                         // 44: api.wxproxy.v1.GetKFMsgHistoryReply
+	(*KFMsgHistory)(nil),                                 // 45: api.wxproxy.v1.KFMsgHistory
+	(*GetKFMsgHistoryRequest)(nil),                       // 46: api.wxproxy.v1.GetKFMsgHistoryRequest
+	(*GetKFOnlineListReply)(nil),                         // 47: api.wxproxy.v1.GetKFOnlineListReply
+	(*KFOnlineInfo)(nil),                                 // 48: api.wxproxy.v1.KFOnlineInfo
+	(*GetKFListReply)(nil),                               // 49: api.wxproxy.v1.GetKFListReply
+	(*KeFuInfo)(nil),                                     // 50: api.wxproxy.v1.KeFuInfo
+	(*SendSubscribeMessageRequest)(nil),                  // 51: api.wxproxy.v1.SendSubscribeMessageRequest
+	(*GetSubscribePrivateTplReply)(nil),                  // 52: api.wxproxy.v1.GetSubscribePrivateTplReply
+	(*GetSubscribeTplTitlesReply)(nil),                   // 53: api.wxproxy.v1.GetSubscribeTplTitlesReply
+	(*GetSubscribeTplTitlesRequest)(nil),                 // 54: api.wxproxy.v1.GetSubscribeTplTitlesRequest
+	(*GetSubscribeTplKeywordsReply)(nil),                 // 55: api.wxproxy.v1.GetSubscribeTplKeywordsReply
+	(*GetSubscribeTplKeywordsRequest)(nil),               // 56: api.wxproxy.v1.GetSubscribeTplKeywordsRequest
+	(*GetSubscribeCategoryReply)(nil),                    // 57: api.wxproxy.v1.GetSubscribeCategoryReply
+	(*DelSubscribeTplRequest)(nil),                       // 58: api.wxproxy.v1.DelSubscribeTplRequest
+	(*AddSubscribeTplRequest)(nil),                       // 59: api.wxproxy.v1.AddSubscribeTplRequest
+	(*AddSubscribeTplReply)(nil),                         // 60: api.wxproxy.v1.AddSubscribeTplReply
+	(*GetBlockedTplRequest)(nil),                         // 61: api.wxproxy.v1.GetBlockedTplRequest
+	(*GetBlockedTplMsgReply)(nil),                        // 62: api.wxproxy.v1.GetBlockedTplMsgReply
+	(*SendSubscribeMsgRequest)(nil),                      // 63: api.wxproxy.v1.SendSubscribeMsgRequest
+	(*SendTplMsgReply)(nil),                              // 64: api.wxproxy.v1.SendTplMsgReply
+	(*SendTplMsgRequest)(nil),                            // 65: api.wxproxy.v1.SendTplMsgRequest
+	(*MiniProgram)(nil),                                  // 66: api.wxproxy.v1.MiniProgram
+	(*DeleteMessageTplRequest)(nil),                      // 67: api.wxproxy.v1.DeleteMessageTplRequest
+	(*AddTemplateRequest)(nil),                           // 68: api.wxproxy.v1.AddTemplateRequest
+	(*AddMessageTplReply)(nil),                           // 69: api.wxproxy.v1.AddMessageTplReply
+	(*GetAllPrivateTplReply)(nil),                        // 70: api.wxproxy.v1.GetAllPrivateTplReply
+	(*SetIndustryRequest)(nil),                           // 71: api.wxproxy.v1.SetIndustryRequest
+	(*GetIndustryReply)(nil),                             // 72: api.wxproxy.v1.GetIndustryReply
+	(*DeleteConditionalMenuRequest)(nil),                 // 73: api.wxproxy.v1.DeleteConditionalMenuRequest
+	(*CreateMenuRequest)(nil),                            // 74: api.wxproxy.v1.CreateMenuRequest
+	(*SelfMenuReply)(nil),                                // 75: api.wxproxy.v1.SelfMenuReply
+	(*SelfMenuButton)(nil),                               // 76: api.wxproxy.v1.SelfMenuButton
+	(*NewsButton)(nil),                                   // 77: api.wxproxy.v1.NewsButton
+	(*TryMatchMenuRequest)(nil),                          // 78: api.wxproxy.v1.TryMatchMenuRequest
+	(*TryMatchMenuReply)(nil),                            // 79: api.wxproxy.v1.TryMatchMenuReply
+	(*MenuInfoReply)(nil),                                // 80: api.wxproxy.v1.MenuInfoReply
+	(*MenuButton)(nil),                                   // 81: api.wxproxy.v1.MenuButton
+	(*ConditionalMenu)(nil),                              // 82: api.wxproxy.v1.ConditionalMenu
+	(*ConditionalMatchRule)(nil),                         // 83: api.wxproxy.v1.ConditionalMatchRule
+	(*FetchShortenRequest)(nil),                          // 84: api.wxproxy.v1.FetchShortenRequest
+	(*FetchShortenReply)(nil),                            // 85: api.wxproxy.v1.FetchShortenReply
+	(*GenShortenRequest)(nil),                            // 86: api.wxproxy.v1.GenShortenRequest
+	(*GenShortenReply)(nil),                              // 87: api.wxproxy.v1.GenShortenReply
+	(*CreateQRCodeReply)(nil),                            // 88: api.wxproxy.v1.CreateQRCodeReply
+	(*CreateQRCodeRequest)(nil),                          // 89: api.wxproxy.v1.CreateQRCodeRequest
+	(*BatchUnTaggingMembersRequest)(nil),                 // 90: api.wxproxy.v1.BatchUnTaggingMembersRequest
+	(*BatchTaggingMembersRequest)(nil),                   // 91: api.wxproxy.v1.BatchTaggingMembersRequest
+	(*GetTagMembersReply)(nil),                           // 92: api.wxproxy.v1.GetTagMembersReply
+	(*GetTagMembersRequest)(nil),                         // 93: api.wxproxy.v1.GetTagMembersRequest
+	(*DeleteTagRequest)(nil),                             // 94: api.wxproxy.v1.DeleteTagRequest
+	(*UpdateTagRequest)(nil),                             // 95: api.wxproxy.v1.UpdateTagRequest
+	(*CreateTagRequest)(nil),                             // 96: api.wxproxy.v1.CreateTagRequest
+	(*CreateTagReply)(nil),                               // 97: api.wxproxy.v1.CreateTagReply
+	(*GetTagListReply)(nil),                              // 98: api.wxproxy.v1.GetTagListReply
+	(*Tag)(nil),                                          // 99: api.wxproxy.v1.Tag
+	(*UpdateMemberRemarkRequest)(nil),                    // 100: api.wxproxy.v1.UpdateMemberRemarkRequest
+	(*WXErrorReply)(nil),                                 // 101: api.wxproxy.v1.WXErrorReply
+	(*GetMemberTagsRequest)(nil),                         // 102: api.wxproxy.v1.GetMemberTagsRequest
+	(*GetMemberTagsReply)(nil),                           // 103: api.wxproxy.v1.GetMemberTagsReply
+	(*BatchGetMemberInfoRequest)(nil),                    // 104: api.wxproxy.v1.BatchGetMemberInfoRequest
+	(*BatchGetMemberInfoReply)(nil),                      // 105: api.wxproxy.v1.BatchGetMemberInfoReply
+	(*GetMemberInfoRequest)(nil),                         // 106: api.wxproxy.v1.GetMemberInfoRequest
+	(*GetMemberInfoReply)(nil),                           // 107: api.wxproxy.v1.GetMemberInfoReply
+	(*GetMemberListRequest)(nil),                         // 108: api.wxproxy.v1.GetMemberListRequest
+	(*GetMemberListReply)(nil),                           // 109: api.wxproxy.v1.GetMemberListReply
+	(*OpenIdList)(nil),                                   // 110: api.wxproxy.v1.OpenIdList
+	(*AccessTokenParam)(nil),                             // 111: api.wxproxy.v1.AccessTokenParam
+	(*DeleteMaterialReq)(nil),                            // 112: api.wxproxy.v1.DeleteMaterialReq
+	(*GetMaterialCountReply)(nil),                        // 113: api.wxproxy.v1.GetMaterialCountReply
+	(*GetMaterialListRequest)(nil),                       // 114: api.wxproxy.v1.GetMaterialListRequest
+	(*GetMaterialListReply)(nil),                         // 115: api.wxproxy.v1.GetMaterialListReply
+	(*MaterialItem)(nil),                                 // 116: api.wxproxy.v1.MaterialItem
+	(*GetMaterialNewsListReply)(nil),                     // 117: api.wxproxy.v1.GetMaterialNewsListReply
+	(*MaterialNewsItem)(nil),                             // 118: api.wxproxy.v1.MaterialNewsItem
+	(*NewsArticle)(nil),                                  // 119: api.wxproxy.v1.NewsArticle
+	(*SendKFMiniProgramMsgRequest_KFMiniProgramMsg)(nil), // 120: api.wxproxy.v1.SendKFMiniProgramMsgRequest.KFMiniProgramMsg
+	(*SendKFCardMsgRequest_KFCardMsg)(nil),               // 121: api.wxproxy.v1.SendKFCardMsgRequest.KFCardMsg
+	(*SendKFMenuMsgRequest_Item)(nil),                    // 122: api.wxproxy.v1.SendKFMenuMsgRequest.Item
+	(*SendKFMenuMsgRequest_MenuMsg)(nil),                 // 123: api.wxproxy.v1.SendKFMenuMsgRequest.MenuMsg
+	(*SendKFToArticleMsgRequest_ToArticleMsg)(nil),       // 124: api.wxproxy.v1.SendKFToArticleMsgRequest.ToArticleMsg
+	(*SendKFNewsPageMsgRequest_KFNewsPageMsg)(nil),       // 125: api.wxproxy.v1.SendKFNewsPageMsgRequest.KFNewsPageMsg
+	(*SendKFNewsCardMsgRequest_KFNewsCardMsg)(nil),       // 126: api.wxproxy.v1.SendKFNewsCardMsgRequest.KFNewsCardMsg
+	(*SendKFMusicMsgRequest_KFMusicMsg)(nil),             // 127: api.wxproxy.v1.SendKFMusicMsgRequest.KFMusicMsg
+	(*SendKFVideoMsgRequest_KFVideoMsg)(nil),             // 128: api.wxproxy.v1.SendKFVideoMsgRequest.KFVideoMsg
+	(*SendKFVoiceMsgRequest_KFVoiceMsg)(nil),             // 129: api.wxproxy.v1.SendKFVoiceMsgRequest.KFVoiceMsg
+	(*SendKFImageMsgRequest_KFImageMsg)(nil),             // 130: api.wxproxy.v1.SendKFImageMsgRequest.KFImageMsg
+	(*KFMessageCommon_KFAccount)(nil),                    // 131: api.wxproxy.v1.KFMessageCommon.KFAccount
+	(*SendKFTextMsgRequest_KFTextMsg)(nil),               // 132: api.wxproxy.v1.SendKFTextMsgRequest.KFTextMsg
+	(*GetKFSessionUnacceptedReply_WaitCase)(nil),         // 133: api.wxproxy.v1.GetKFSessionUnacceptedReply.WaitCase
+	(*SendSubscribeMessageRequest_DataItem)(nil),         // 134: api.wxproxy.v1.SendSubscribeMessageRequest.DataItem
+	nil,                                      // 135: api.wxproxy.v1.SendSubscribeMessageRequest.DataEntry
+	(*GetSubscribePrivateTplReply_Item)(nil), // 136: api.wxproxy.v1.GetSubscribePrivateTplReply.Item
+	(*GetSubscribeTplTitlesReply_Item)(nil),  // 137: api.wxproxy.v1.GetSubscribeTplTitlesReply.Item
+	(*GetSubscribeTplKeywordsReply_Item)(nil),    // 138: api.wxproxy.v1.GetSubscribeTplKeywordsReply.Item
+	(*GetSubscribeCategoryReply_Category)(nil),   // 139: api.wxproxy.v1.GetSubscribeCategoryReply.Category
+	(*GetBlockedTplMsgReply_BlockedMsgInfo)(nil), // 140: api.wxproxy.v1.GetBlockedTplMsgReply.BlockedMsgInfo
+	(*SendSubscribeMsgRequest_DataItem)(nil),     // 141: api.wxproxy.v1.SendSubscribeMsgRequest.DataItem
+	nil,                                          // 142: api.wxproxy.v1.SendSubscribeMsgRequest.DataEntry
+	(*SendTplMsgRequest_DataItem)(nil),           // 143: api.wxproxy.v1.SendTplMsgRequest.DataItem
+	nil,                                          // 144: api.wxproxy.v1.SendTplMsgRequest.DataEntry
+	(*GetAllPrivateTplReply_TplInfo)(nil),        // 145: api.wxproxy.v1.GetAllPrivateTplReply.TplInfo
+	(*GetIndustryReply_Industry)(nil),            // 146: api.wxproxy.v1.GetIndustryReply.Industry
+	(*SelfMenuReply_MenuInfoType)(nil),           // 147: api.wxproxy.v1.SelfMenuReply.MenuInfoType
+	(*SelfMenuButton_SubButtonType)(nil),         // 148: api.wxproxy.v1.SelfMenuButton.SubButtonType
+	(*SelfMenuButton_NewsButtonType)(nil),        // 149: api.wxproxy.v1.SelfMenuButton.NewsButtonType
+	(*MenuInfoReply_MenuType)(nil),               // 150: api.wxproxy.v1.MenuInfoReply.MenuType
+	(*GetTagMembersReply_DataT)(nil),             // 151: api.wxproxy.v1.GetTagMembersReply.DataT
+	(*BatchGetMemberInfoRequest_OpenIdList)(nil), // 152: api.wxproxy.v1.BatchGetMemberInfoRequest.OpenIdList
+	(*GetMemberListReply_IdList)(nil),            // 153: api.wxproxy.v1.GetMemberListReply.IdList
 }
 var file_v1_wxproxy_proto_depIdxs = []int32{
-	13,  // 0: api.wxproxy.v1.SendKFMiniProgramMsgRequest.Common:type_name -> api.wxproxy.v1.KFMessageCommon
-	105, // 1: api.wxproxy.v1.SendKFMiniProgramMsgRequest.MiniProgramPage:type_name -> api.wxproxy.v1.SendKFMiniProgramMsgRequest.KFMiniProgramMsg
-	13,  // 2: api.wxproxy.v1.SendKFCardMsgRequest.Common:type_name -> api.wxproxy.v1.KFMessageCommon
-	106, // 3: api.wxproxy.v1.SendKFCardMsgRequest.WxCard:type_name -> api.wxproxy.v1.SendKFCardMsgRequest.KFCardMsg
-	13,  // 4: api.wxproxy.v1.SendKFMenuMsgRequest.Common:type_name -> api.wxproxy.v1.KFMessageCommon
-	108, // 5: api.wxproxy.v1.SendKFMenuMsgRequest.MsgMenu:type_name -> api.wxproxy.v1.SendKFMenuMsgRequest.MenuMsg
-	13,  // 6: api.wxproxy.v1.SendKFToArticleMsgRequest.Common:type_name -> api.wxproxy.v1.KFMessageCommon
-	109, // 7: api.wxproxy.v1.SendKFToArticleMsgRequest.MpNewsArticle:type_name -> api.wxproxy.v1.SendKFToArticleMsgRequest.ToArticleMsg
-	13,  // 8: api.wxproxy.v1.SendKFNewsPageMsgRequest.Common:type_name -> api.wxproxy.v1.KFMessageCommon
-	110, // 9: api.wxproxy.v1.SendKFNewsPageMsgRequest.MpNews:type_name -> api.wxproxy.v1.SendKFNewsPageMsgRequest.KFNewsPageMsg
-	13,  // 10: api.wxproxy.v1.SendKFNewsCardMsgRequest.Common:type_name -> api.wxproxy.v1.KFMessageCommon
-	111, // 11: api.wxproxy.v1.SendKFNewsCardMsgRequest.News:type_name -> api.wxproxy.v1.SendKFNewsCardMsgRequest.KFNewsCardMsg
-	13,  // 12: api.wxproxy.v1.SendKFMusicMsgRequest.Common:type_name -> api.wxproxy.v1.KFMessageCommon
-	112, // 13: api.wxproxy.v1.SendKFMusicMsgRequest.Music:type_name -> api.wxproxy.v1.SendKFMusicMsgRequest.KFMusicMsg
-	13,  // 14: api.wxproxy.v1.SendKFVideoMsgRequest.Common:type_name -> api.wxproxy.v1.KFMessageCommon
-	113, // 15: api.wxproxy.v1.SendKFVideoMsgRequest.Video:type_name -> api.wxproxy.v1.SendKFVideoMsgRequest.KFVideoMsg
-	13,  // 16: api.wxproxy.v1.SendKFVoiceMsgRequest.Common:type_name -> api.wxproxy.v1.KFMessageCommon
-	114, // 17: api.wxproxy.v1.SendKFVoiceMsgRequest.Voice:type_name -> api.wxproxy.v1.SendKFVoiceMsgRequest.KFVoiceMsg
-	13,  // 18: api.wxproxy.v1.SendKFImageMsgRequest.Common:type_name -> api.wxproxy.v1.KFMessageCommon
-	115, // 19: api.wxproxy.v1.SendKFImageMsgRequest.Image:type_name -> api.wxproxy.v1.SendKFImageMsgRequest.KFImageMsg
-	116, // 20: api.wxproxy.v1.KFMessageCommon.CustomerService:type_name -> api.wxproxy.v1.KFMessageCommon.KFAccount
-	13,  // 21: api.wxproxy.v1.SendKFTextMsgRequest.Common:type_name -> api.wxproxy.v1.KFMessageCommon
-	117, // 22: api.wxproxy.v1.SendKFTextMsgRequest.Text:type_name -> api.wxproxy.v1.SendKFTextMsgRequest.KFTextMsg
-	118, // 23: api.wxproxy.v1.GetKFSessionUnacceptedReply.WaitCaseList:type_name -> api.wxproxy.v1.GetKFSessionUnacceptedReply.WaitCase
-	21,  // 24: api.wxproxy.v1.GetKFSessionListReply.SessionList:type_name -> api.wxproxy.v1.KFSession
-	30,  // 25: api.wxproxy.v1.GetKFMsgHistoryReply.RecordList:type_name -> api.wxproxy.v1.KFMsgHistory
-	33,  // 26: api.wxproxy.v1.GetKFOnlineListReply.KfOnlineList:type_name -> api.wxproxy.v1.KFOnlineInfo
-	35,  // 27: api.wxproxy.v1.GetKFListReply.KfList:type_name -> api.wxproxy.v1.KeFuInfo
-	120, // 28: api.wxproxy.v1.SendSubscribeMessageRequest.Data:type_name -> api.wxproxy.v1.SendSubscribeMessageRequest.DataEntry
-	51,  // 29: api.wxproxy.v1.SendSubscribeMessageRequest.Miniprogram:type_name -> api.wxproxy.v1.MiniProgram
-	121, // 30: api.wxproxy.v1.GetSubscribePrivateTplReply.Data:type_name -> api.wxproxy.v1.GetSubscribePrivateTplReply.Item
-	122, // 31: api.wxproxy.v1.GetSubscribeTplTitlesReply.Data:type_name -> api.wxproxy.v1.GetSubscribeTplTitlesReply.Item
-	123, // 32: api.wxproxy.v1.GetSubscribeTplKeywordsReply.Data:type_name -> api.wxproxy.v1.GetSubscribeTplKeywordsReply.Item
-	124, // 33: api.wxproxy.v1.GetSubscribeCategoryReply.Data:type_name -> api.wxproxy.v1.GetSubscribeCategoryReply.Category
-	125, // 34: api.wxproxy.v1.GetBlockedTplMsgReply.Msginfo:type_name -> api.wxproxy.v1.GetBlockedTplMsgReply.BlockedMsgInfo
-	127, // 35: api.wxproxy.v1.SendSubscribeMsgRequest.Data:type_name -> api.wxproxy.v1.SendSubscribeMsgRequest.DataEntry
-	51,  // 36: api.wxproxy.v1.SendSubscribeMsgRequest.Miniprogram:type_name -> api.wxproxy.v1.MiniProgram
-	129, // 37: api.wxproxy.v1.SendTplMsgRequest.Data:type_name -> api.wxproxy.v1.SendTplMsgRequest.DataEntry
-	51,  // 38: api.wxproxy.v1.SendTplMsgRequest.Miniprogram:type_name -> api.wxproxy.v1.MiniProgram
-	130, // 39: api.wxproxy.v1.GetAllPrivateTplReply.TemplateList:type_name -> api.wxproxy.v1.GetAllPrivateTplReply.TplInfo
-	131, // 40: api.wxproxy.v1.GetIndustryReply.PrimaryIndustry:type_name -> api.wxproxy.v1.GetIndustryReply.Industry
-	131, // 41: api.wxproxy.v1.GetIndustryReply.SecondaryIndustry:type_name -> api.wxproxy.v1.GetIndustryReply.Industry
-	66,  // 42: api.wxproxy.v1.CreateMenuRequest.Button:type_name -> api.wxproxy.v1.MenuButton
-	68,  // 43: api.wxproxy.v1.CreateMenuRequest.Matchrule:type_name -> api.wxproxy.v1.ConditionalMatchRule
-	132, // 44: api.wxproxy.v1.SelfMenuReply.SelfmenuInfo:type_name -> api.wxproxy.v1.SelfMenuReply.MenuInfoType
-	133, // 45: api.wxproxy.v1.SelfMenuButton.SubButton:type_name -> api.wxproxy.v1.SelfMenuButton.SubButtonType
-	134, // 46: api.wxproxy.v1.SelfMenuButton.NewsInfo:type_name -> api.wxproxy.v1.SelfMenuButton.NewsButtonType
-	66,  // 47: api.wxproxy.v1.TryMatchMenuReply.Button:type_name -> api.wxproxy.v1.MenuButton
-	135, // 48: api.wxproxy.v1.MenuInfoReply.Menu:type_name -> api.wxproxy.v1.MenuInfoReply.MenuType
-	67,  // 49: api.wxproxy.v1.MenuInfoReply.Conditionalmenu:type_name -> api.wxproxy.v1.ConditionalMenu
-	66,  // 50: api.wxproxy.v1.MenuButton.SubButton:type_name -> api.wxproxy.v1.MenuButton
-	66,  // 51: api.wxproxy.v1.ConditionalMenu.Button:type_name -> api.wxproxy.v1.MenuButton
-	68,  // 52: api.wxproxy.v1.ConditionalMenu.Matchrule:type_name -> api.wxproxy.v1.ConditionalMatchRule
-	136, // 53: api.wxproxy.v1.GetTagMembersReply.Data:type_name -> api.wxproxy.v1.GetTagMembersReply.DataT
-	84,  // 54: api.wxproxy.v1.CreateTagReply.tag:type_name -> api.wxproxy.v1.Tag
-	84,  // 55: api.wxproxy.v1.GetTagListReply.Tags:type_name -> api.wxproxy.v1.Tag
-	137, // 56: api.wxproxy.v1.BatchGetMemberInfoRequest.UserList:type_name -> api.wxproxy.v1.BatchGetMemberInfoRequest.OpenIdList
-	92,  // 57: api.wxproxy.v1.BatchGetMemberInfoReply.UserListInfo:type_name -> api.wxproxy.v1.GetMemberInfoReply
-	138, // 58: api.wxproxy.v1.GetMemberListReply.Data:type_name -> api.wxproxy.v1.GetMemberListReply.IdList
-	101, // 59: api.wxproxy.v1.GetMaterialListReply.Item:type_name -> api.wxproxy.v1.MaterialItem
-	103, // 60: api.wxproxy.v1.GetMaterialNewsListReply.Item:type_name -> api.wxproxy.v1.MaterialNewsItem
-	104, // 61: api.wxproxy.v1.MaterialNewsItem.Articles:type_name -> api.wxproxy.v1.NewsArticle
-	107, // 62: api.wxproxy.v1.SendKFMenuMsgRequest.MenuMsg.List:type_name -> api.wxproxy.v1.SendKFMenuMsgRequest.Item
-	119, // 63: api.wxproxy.v1.SendSubscribeMessageRequest.DataEntry.value:type_name -> api.wxproxy.v1.SendSubscribeMessageRequest.DataItem
-	126, // 64: api.wxproxy.v1.SendSubscribeMsgRequest.DataEntry.value:type_name -> api.wxproxy.v1.SendSubscribeMsgRequest.DataItem
-	128, // 65: api.wxproxy.v1.SendTplMsgRequest.DataEntry.value:type_name -> api.wxproxy.v1.SendTplMsgRequest.DataItem
-	61,  // 66: api.wxproxy.v1.SelfMenuReply.MenuInfoType.Button:type_name -> api.wxproxy.v1.SelfMenuButton
-	61,  // 67: api.wxproxy.v1.SelfMenuButton.SubButtonType.List:type_name -> api.wxproxy.v1.SelfMenuButton
-	62,  // 68: api.wxproxy.v1.SelfMenuButton.NewsButtonType.List:type_name -> api.wxproxy.v1.NewsButton
-	66,  // 69: api.wxproxy.v1.MenuInfoReply.MenuType.Button:type_name -> api.wxproxy.v1.MenuButton
-	95,  // 70: api.wxproxy.v1.GetMemberListReply.IdList.openid:type_name -> api.wxproxy.v1.OpenIdList
-	97,  // 71: api.wxproxy.v1.Mpproxy.DeleteMaterial:input_type -> api.wxproxy.v1.DeleteMaterialReq
-	96,  // 72: api.wxproxy.v1.Mpproxy.GetMaterialCount:input_type -> api.wxproxy.v1.AccessTokenParam
-	99,  // 73: api.wxproxy.v1.Mpproxy.GetMaterialNewsList:input_type -> api.wxproxy.v1.GetMaterialListRequest
-	99,  // 74: api.wxproxy.v1.Mpproxy.GetMaterialList:input_type -> api.wxproxy.v1.GetMaterialListRequest
-	93,  // 75: api.wxproxy.v1.Mpproxy.GetMemberList:input_type -> api.wxproxy.v1.GetMemberListRequest
-	91,  // 76: api.wxproxy.v1.Mpproxy.GetMemberInfo:input_type -> api.wxproxy.v1.GetMemberInfoRequest
-	89,  // 77: api.wxproxy.v1.Mpproxy.BatchGetMemberInfo:input_type -> api.wxproxy.v1.BatchGetMemberInfoRequest
-	87,  // 78: api.wxproxy.v1.Mpproxy.GetMemberTags:input_type -> api.wxproxy.v1.GetMemberTagsRequest
-	85,  // 79: api.wxproxy.v1.Mpproxy.UpdateMemberRemark:input_type -> api.wxproxy.v1.UpdateMemberRemarkRequest
-	96,  // 80: api.wxproxy.v1.Mpproxy.GetTagList:input_type -> api.wxproxy.v1.AccessTokenParam
-	81,  // 81: api.wxproxy.v1.Mpproxy.CreateTag:input_type -> api.wxproxy.v1.CreateTagRequest
-	80,  // 82: api.wxproxy.v1.Mpproxy.UpdateTag:input_type -> api.wxproxy.v1.UpdateTagRequest
-	79,  // 83: api.wxproxy.v1.Mpproxy.DeleteTag:input_type -> api.wxproxy.v1.DeleteTagRequest
-	78,  // 84: api.wxproxy.v1.Mpproxy.GetTagMembers:input_type -> api.wxproxy.v1.GetTagMembersRequest
-	76,  // 85: api.wxproxy.v1.Mpproxy.BatchTaggingMembers:input_type -> api.wxproxy.v1.BatchTaggingMembersRequest
-	75,  // 86: api.wxproxy.v1.Mpproxy.BatchUnTaggingMembers:input_type -> api.wxproxy.v1.BatchUnTaggingMembersRequest
-	74,  // 87: api.wxproxy.v1.Mpproxy.CreateTemporaryQRCode:input_type -> api.wxproxy.v1.CreateQRCodeRequest
-	74,  // 88: api.wxproxy.v1.Mpproxy.CreateLimitQRCode:input_type -> api.wxproxy.v1.CreateQRCodeRequest
-	71,  // 89: api.wxproxy.v1.Mpproxy.GenShorten:input_type -> api.wxproxy.v1.GenShortenRequest
-	69,  // 90: api.wxproxy.v1.Mpproxy.FetchShorten:input_type -> api.wxproxy.v1.FetchShortenRequest
-	96,  // 91: api.wxproxy.v1.Mpproxy.GetMenuInfo:input_type -> api.wxproxy.v1.AccessTokenParam
-	63,  // 92: api.wxproxy.v1.Mpproxy.TryMatchMenu:input_type -> api.wxproxy.v1.TryMatchMenuRequest
-	96,  // 93: api.wxproxy.v1.Mpproxy.PullMenu:input_type -> api.wxproxy.v1.AccessTokenParam
-	59,  // 94: api.wxproxy.v1.Mpproxy.CreateMenu:input_type -> api.wxproxy.v1.CreateMenuRequest
-	59,  // 95: api.wxproxy.v1.Mpproxy.CreateConditionalMenu:input_type -> api.wxproxy.v1.CreateMenuRequest
-	58,  // 96: api.wxproxy.v1.Mpproxy.DeleteConditionalMenu:input_type -> api.wxproxy.v1.DeleteConditionalMenuRequest
-	96,  // 97: api.wxproxy.v1.Mpproxy.DeleteMenu:input_type -> api.wxproxy.v1.AccessTokenParam
-	96,  // 98: api.wxproxy.v1.Mpproxy.GetIndustry:input_type -> api.wxproxy.v1.AccessTokenParam
-	96,  // 99: api.wxproxy.v1.Mpproxy.GetAllPrivateTpl:input_type -> api.wxproxy.v1.AccessTokenParam
-	56,  // 100: api.wxproxy.v1.Mpproxy.SetIndustry:input_type -> api.wxproxy.v1.SetIndustryRequest
-	53,  // 101: api.wxproxy.v1.Mpproxy.GetMessageTplId:input_type -> api.wxproxy.v1.AddTemplateRequest
-	52,  // 102: api.wxproxy.v1.Mpproxy.DeleteMessageTpl:input_type -> api.wxproxy.v1.DeleteMessageTplRequest
-	50,  // 103: api.wxproxy.v1.Mpproxy.SendTplMsg:input_type -> api.wxproxy.v1.SendTplMsgRequest
-	48,  // 104: api.wxproxy.v1.Mpproxy.SendSubscribeMsg:input_type -> api.wxproxy.v1.SendSubscribeMsgRequest
-	46,  // 105: api.wxproxy.v1.Mpproxy.GetBlockedTplMsg:input_type -> api.wxproxy.v1.GetBlockedTplRequest
-	44,  // 106: api.wxproxy.v1.Mpproxy.AddSubscribeTpl:input_type -> api.wxproxy.v1.AddSubscribeTplRequest
-	43,  // 107: api.wxproxy.v1.Mpproxy.DelSubscribeTpl:input_type -> api.wxproxy.v1.DelSubscribeTplRequest
-	96,  // 108: api.wxproxy.v1.Mpproxy.GetSubscribeCategory:input_type -> api.wxproxy.v1.AccessTokenParam
-	41,  // 109: api.wxproxy.v1.Mpproxy.GetSubscribeTplKeywords:input_type -> api.wxproxy.v1.GetSubscribeTplKeywordsRequest
-	39,  // 110: api.wxproxy.v1.Mpproxy.GetSubscribeTplTitles:input_type -> api.wxproxy.v1.GetSubscribeTplTitlesRequest
-	96,  // 111: api.wxproxy.v1.Mpproxy.GetSubscribePrivateTpl:input_type -> api.wxproxy.v1.AccessTokenParam
-	36,  // 112: api.wxproxy.v1.Mpproxy.SendSubscribeMessage:input_type -> api.wxproxy.v1.SendSubscribeMessageRequest
-	96,  // 113: api.wxproxy.v1.Mpproxy.GetKFList:input_type -> api.wxproxy.v1.AccessTokenParam
-	96,  // 114: api.wxproxy.v1.Mpproxy.GetKFOnlineList:input_type -> api.wxproxy.v1.AccessTokenParam
-	31,  // 115: api.wxproxy.v1.Mpproxy.GetKFMsgHistory:input_type -> api.wxproxy.v1.GetKFMsgHistoryRequest
-	28,  // 116: api.wxproxy.v1.Mpproxy.AddKFAccount:input_type -> api.wxproxy.v1.AddKFAccountRequest
-	27,  // 117: api.wxproxy.v1.Mpproxy.UpdateKFAccount:input_type -> api.wxproxy.v1.UpdateKFAccountRequest
-	26,  // 118: api.wxproxy.v1.Mpproxy.DelKFAccount:input_type -> api.wxproxy.v1.DelKFAccountRequest
-	25,  // 119: api.wxproxy.v1.Mpproxy.InviteKFWorker:input_type -> api.wxproxy.v1.InviteKFWorkerRequest
-	24,  // 120: api.wxproxy.v1.Mpproxy.UpdateKFAvatar:input_type -> api.wxproxy.v1.UpdateKFAvatarRequest
-	23,  // 121: api.wxproxy.v1.Mpproxy.UpdateKFTyping:input_type -> api.wxproxy.v1.UpdateKFTypingRequest
-	22,  // 122: api.wxproxy.v1.Mpproxy.GetKFSessionList:input_type -> api.wxproxy.v1.GetKFSessionListRequest
-	19,  // 123: api.wxproxy.v1.Mpproxy.GetKFSessionStatus:input_type -> api.wxproxy.v1.GetKFSessionStatusRequest
-	96,  // 124: api.wxproxy.v1.Mpproxy.GetKFSessionUnaccepted:input_type -> api.wxproxy.v1.AccessTokenParam
-	16,  // 125: api.wxproxy.v1.Mpproxy.CloseKFSession:input_type -> api.wxproxy.v1.CloseKFSessionRequest
-	15,  // 126: api.wxproxy.v1.Mpproxy.NewKFSession:input_type -> api.wxproxy.v1.NewKFSessionRequest
-	14,  // 127: api.wxproxy.v1.Mpproxy.SendKFTextMsg:input_type -> api.wxproxy.v1.SendKFTextMsgRequest
-	12,  // 128: api.wxproxy.v1.Mpproxy.SendKFImageMsg:input_type -> api.wxproxy.v1.SendKFImageMsgRequest
-	11,  // 129: api.wxproxy.v1.Mpproxy.SendKFVoiceMsg:input_type -> api.wxproxy.v1.SendKFVoiceMsgRequest
-	10,  // 130: api.wxproxy.v1.Mpproxy.SendKFVideoMsg:input_type -> api.wxproxy.v1.SendKFVideoMsgRequest
-	9,   // 131: api.wxproxy.v1.Mpproxy.SendKFMusicMsg:input_type -> api.wxproxy.v1.SendKFMusicMsgRequest
-	8,   // 132: api.wxproxy.v1.Mpproxy.SendKFNewsCardMsg:input_type -> api.wxproxy.v1.SendKFNewsCardMsgRequest
-	7,   // 133: api.wxproxy.v1.Mpproxy.SendKFNewsPageMsg:input_type -> api.wxproxy.v1.SendKFNewsPageMsgRequest
-	6,   // 134: api.wxproxy.v1.Mpproxy.SendKFToArticleMsg:input_type -> api.wxproxy.v1.SendKFToArticleMsgRequest
-	5,   // 135: api.wxproxy.v1.Mpproxy.SendKFMenuMsg:input_type -> api.wxproxy.v1.SendKFMenuMsgRequest
-	4,   // 136: api.wxproxy.v1.Mpproxy.SendKFCardMsg:input_type -> api.wxproxy.v1.SendKFCardMsgRequest
-	3,   // 137: api.wxproxy.v1.Mpproxy.SendKFMiniProgramMsg:input_type -> api.wxproxy.v1.SendKFMiniProgramMsgRequest
-	2,   // 138: api.wxproxy.v1.Mpproxy.BlockMember:input_type -> api.wxproxy.v1.BlockMemberReq
-	2,   // 139: api.wxproxy.v1.Mpproxy.UnBlockMember:input_type -> api.wxproxy.v1.BlockMemberReq
-	0,   // 140: api.wxproxy.v1.Mpproxy.GetBlacklist:input_type -> api.wxproxy.v1.GetBlacklistReq
-	86,  // 141: api.wxproxy.v1.Mpproxy.DeleteMaterial:output_type -> api.wxproxy.v1.WXErrorReply
-	98,  // 142: api.wxproxy.v1.Mpproxy.GetMaterialCount:output_type -> api.wxproxy.v1.GetMaterialCountReply
-	102, // 143: api.wxproxy.v1.Mpproxy.GetMaterialNewsList:output_type -> api.wxproxy.v1.GetMaterialNewsListReply
-	100, // 144: api.wxproxy.v1.Mpproxy.GetMaterialList:output_type -> api.wxproxy.v1.GetMaterialListReply
-	94,  // 145: api.wxproxy.v1.Mpproxy.GetMemberList:output_type -> api.wxproxy.v1.GetMemberListReply
-	92,  // 146: api.wxproxy.v1.Mpproxy.GetMemberInfo:output_type -> api.wxproxy.v1.GetMemberInfoReply
-	90,  // 147: api.wxproxy.v1.Mpproxy.BatchGetMemberInfo:output_type -> api.wxproxy.v1.BatchGetMemberInfoReply
-	88,  // 148: api.wxproxy.v1.Mpproxy.GetMemberTags:output_type -> api.wxproxy.v1.GetMemberTagsReply
-	86,  // 149: api.wxproxy.v1.Mpproxy.UpdateMemberRemark:output_type -> api.wxproxy.v1.WXErrorReply
-	83,  // 150: api.wxproxy.v1.Mpproxy.GetTagList:output_type -> api.wxproxy.v1.GetTagListReply
-	82,  // 151: api.wxproxy.v1.Mpproxy.CreateTag:output_type -> api.wxproxy.v1.CreateTagReply
-	86,  // 152: api.wxproxy.v1.Mpproxy.UpdateTag:output_type -> api.wxproxy.v1.WXErrorReply
-	86,  // 153: api.wxproxy.v1.Mpproxy.DeleteTag:output_type -> api.wxproxy.v1.WXErrorReply
-	77,  // 154: api.wxproxy.v1.Mpproxy.GetTagMembers:output_type -> api.wxproxy.v1.GetTagMembersReply
-	86,  // 155: api.wxproxy.v1.Mpproxy.BatchTaggingMembers:output_type -> api.wxproxy.v1.WXErrorReply
-	86,  // 156: api.wxproxy.v1.Mpproxy.BatchUnTaggingMembers:output_type -> api.wxproxy.v1.WXErrorReply
-	73,  // 157: api.wxproxy.v1.Mpproxy.CreateTemporaryQRCode:output_type -> api.wxproxy.v1.CreateQRCodeReply
-	73,  // 158: api.wxproxy.v1.Mpproxy.CreateLimitQRCode:output_type -> api.wxproxy.v1.CreateQRCodeReply
-	72,  // 159: api.wxproxy.v1.Mpproxy.GenShorten:output_type -> api.wxproxy.v1.GenShortenReply
-	70,  // 160: api.wxproxy.v1.Mpproxy.FetchShorten:output_type -> api.wxproxy.v1.FetchShortenReply
-	65,  // 161: api.wxproxy.v1.Mpproxy.GetMenuInfo:output_type -> api.wxproxy.v1.MenuInfoReply
-	64,  // 162: api.wxproxy.v1.Mpproxy.TryMatchMenu:output_type -> api.wxproxy.v1.TryMatchMenuReply
-	60,  // 163: api.wxproxy.v1.Mpproxy.PullMenu:output_type -> api.wxproxy.v1.SelfMenuReply
-	86,  // 164: api.wxproxy.v1.Mpproxy.CreateMenu:output_type -> api.wxproxy.v1.WXErrorReply
-	86,  // 165: api.wxproxy.v1.Mpproxy.CreateConditionalMenu:output_type -> api.wxproxy.v1.WXErrorReply
-	86,  // 166: api.wxproxy.v1.Mpproxy.DeleteConditionalMenu:output_type -> api.wxproxy.v1.WXErrorReply
-	86,  // 167: api.wxproxy.v1.Mpproxy.DeleteMenu:output_type -> api.wxproxy.v1.WXErrorReply
-	57,  // 168: api.wxproxy.v1.Mpproxy.GetIndustry:output_type -> api.wxproxy.v1.GetIndustryReply
-	55,  // 169: api.wxproxy.v1.Mpproxy.GetAllPrivateTpl:output_type -> api.wxproxy.v1.GetAllPrivateTplReply
-	86,  // 170: api.wxproxy.v1.Mpproxy.SetIndustry:output_type -> api.wxproxy.v1.WXErrorReply
-	54,  // 171: api.wxproxy.v1.Mpproxy.GetMessageTplId:output_type -> api.wxproxy.v1.AddMessageTplReply
-	86,  // 172: api.wxproxy.v1.Mpproxy.DeleteMessageTpl:output_type -> api.wxproxy.v1.WXErrorReply
-	49,  // 173: api.wxproxy.v1.Mpproxy.SendTplMsg:output_type -> api.wxproxy.v1.SendTplMsgReply
-	86,  // 174: api.wxproxy.v1.Mpproxy.SendSubscribeMsg:output_type -> api.wxproxy.v1.WXErrorReply
-	47,  // 175: api.wxproxy.v1.Mpproxy.GetBlockedTplMsg:output_type -> api.wxproxy.v1.GetBlockedTplMsgReply
-	45,  // 176: api.wxproxy.v1.Mpproxy.AddSubscribeTpl:output_type -> api.wxproxy.v1.AddSubscribeTplReply
-	86,  // 177: api.wxproxy.v1.Mpproxy.DelSubscribeTpl:output_type -> api.wxproxy.v1.WXErrorReply
-	42,  // 178: api.wxproxy.v1.Mpproxy.GetSubscribeCategory:output_type -> api.wxproxy.v1.GetSubscribeCategoryReply
-	40,  // 179: api.wxproxy.v1.Mpproxy.GetSubscribeTplKeywords:output_type -> api.wxproxy.v1.GetSubscribeTplKeywordsReply
-	38,  // 180: api.wxproxy.v1.Mpproxy.GetSubscribeTplTitles:output_type -> api.wxproxy.v1.GetSubscribeTplTitlesReply
-	37,  // 181: api.wxproxy.v1.Mpproxy.GetSubscribePrivateTpl:output_type -> api.wxproxy.v1.GetSubscribePrivateTplReply
-	86,  // 182: api.wxproxy.v1.Mpproxy.SendSubscribeMessage:output_type -> api.wxproxy.v1.WXErrorReply
-	34,  // 183: api.wxproxy.v1.Mpproxy.GetKFList:output_type -> api.wxproxy.v1.GetKFListReply
-	32,  // 184: api.wxproxy.v1.Mpproxy.GetKFOnlineList:output_type -> api.wxproxy.v1.GetKFOnlineListReply
-	29,  // 185: api.wxproxy.v1.Mpproxy.GetKFMsgHistory:output_type -> api.wxproxy.v1.GetKFMsgHistoryReply
-	86,  // 186: api.wxproxy.v1.Mpproxy.AddKFAccount:output_type -> api.wxproxy.v1.WXErrorReply
-	86,  // 187: api.wxproxy.v1.Mpproxy.UpdateKFAccount:output_type -> api.wxproxy.v1.WXErrorReply
-	86,  // 188: api.wxproxy.v1.Mpproxy.DelKFAccount:output_type -> api.wxproxy.v1.WXErrorReply
-	86,  // 189: api.wxproxy.v1.Mpproxy.InviteKFWorker:output_type -> api.wxproxy.v1.WXErrorReply
-	86,  // 190: api.wxproxy.v1.Mpproxy.UpdateKFAvatar:output_type -> api.wxproxy.v1.WXErrorReply
-	86,  // 191: api.wxproxy.v1.Mpproxy.UpdateKFTyping:output_type -> api.wxproxy.v1.WXErrorReply
-	20,  // 192: api.wxproxy.v1.Mpproxy.GetKFSessionList:output_type -> api.wxproxy.v1.GetKFSessionListReply
-	18,  // 193: api.wxproxy.v1.Mpproxy.GetKFSessionStatus:output_type -> api.wxproxy.v1.GetKFSessionStatusReply
-	17,  // 194: api.wxproxy.v1.Mpproxy.GetKFSessionUnaccepted:output_type -> api.wxproxy.v1.GetKFSessionUnacceptedReply
-	86,  // 195: api.wxproxy.v1.Mpproxy.CloseKFSession:output_type -> api.wxproxy.v1.WXErrorReply
-	86,  // 196: api.wxproxy.v1.Mpproxy.NewKFSession:output_type -> api.wxproxy.v1.WXErrorReply
-	86,  // 197: api.wxproxy.v1.Mpproxy.SendKFTextMsg:output_type -> api.wxproxy.v1.WXErrorReply
-	86,  // 198: api.wxproxy.v1.Mpproxy.SendKFImageMsg:output_type -> api.wxproxy.v1.WXErrorReply
-	86,  // 199: api.wxproxy.v1.Mpproxy.SendKFVoiceMsg:output_type -> api.wxproxy.v1.WXErrorReply
-	86,  // 200: api.wxproxy.v1.Mpproxy.SendKFVideoMsg:output_type -> api.wxproxy.v1.WXErrorReply
-	86,  // 201: api.wxproxy.v1.Mpproxy.SendKFMusicMsg:output_type -> api.wxproxy.v1.WXErrorReply
-	86,  // 202: api.wxproxy.v1.Mpproxy.SendKFNewsCardMsg:output_type -> api.wxproxy.v1.WXErrorReply
-	86,  // 203: api.wxproxy.v1.Mpproxy.SendKFNewsPageMsg:output_type -> api.wxproxy.v1.WXErrorReply
-	86,  // 204: api.wxproxy.v1.Mpproxy.SendKFToArticleMsg:output_type -> api.wxproxy.v1.WXErrorReply
-	86,  // 205: api.wxproxy.v1.Mpproxy.SendKFMenuMsg:output_type -> api.wxproxy.v1.WXErrorReply
-	86,  // 206: api.wxproxy.v1.Mpproxy.SendKFCardMsg:output_type -> api.wxproxy.v1.WXErrorReply
-	86,  // 207: api.wxproxy.v1.Mpproxy.SendKFMiniProgramMsg:output_type -> api.wxproxy.v1.WXErrorReply
-	86,  // 208: api.wxproxy.v1.Mpproxy.BlockMember:output_type -> api.wxproxy.v1.WXErrorReply
-	86,  // 209: api.wxproxy.v1.Mpproxy.UnBlockMember:output_type -> api.wxproxy.v1.WXErrorReply
-	1,   // 210: api.wxproxy.v1.Mpproxy.GetBlacklist:output_type -> api.wxproxy.v1.GetBlacklistReply
-	141, // [141:211] is the sub-list for method output_type
-	71,  // [71:141] is the sub-list for method input_type
-	71,  // [71:71] is the sub-list for extension type_name
-	71,  // [71:71] is the sub-list for extension extendee
-	0,   // [0:71] is the sub-list for field type_name
+	13,  // 0: api.wxproxy.v1.GetQuotaUsageReply.Items:type_name -> api.wxproxy.v1.QuotaUsage
+	28,  // 1: api.wxproxy.v1.SendKFMiniProgramMsgRequest.Common:type_name -> api.wxproxy.v1.KFMessageCommon
+	120, // 2: api.wxproxy.v1.SendKFMiniProgramMsgRequest.MiniProgramPage:type_name -> api.wxproxy.v1.SendKFMiniProgramMsgRequest.KFMiniProgramMsg
+	28,  // 3: api.wxproxy.v1.SendKFCardMsgRequest.Common:type_name -> api.wxproxy.v1.KFMessageCommon
+	121, // 4: api.wxproxy.v1.SendKFCardMsgRequest.WxCard:type_name -> api.wxproxy.v1.SendKFCardMsgRequest.KFCardMsg
+	28,  // 5: api.wxproxy.v1.SendKFMenuMsgRequest.Common:type_name -> api.wxproxy.v1.KFMessageCommon
+	123, // 6: api.wxproxy.v1.SendKFMenuMsgRequest.MsgMenu:type_name -> api.wxproxy.v1.SendKFMenuMsgRequest.MenuMsg
+	28,  // 7: api.wxproxy.v1.SendKFToArticleMsgRequest.Common:type_name -> api.wxproxy.v1.KFMessageCommon
+	124, // 8: api.wxproxy.v1.SendKFToArticleMsgRequest.MpNewsArticle:type_name -> api.wxproxy.v1.SendKFToArticleMsgRequest.ToArticleMsg
+	28,  // 9: api.wxproxy.v1.SendKFNewsPageMsgRequest.Common:type_name -> api.wxproxy.v1.KFMessageCommon
+	125, // 10: api.wxproxy.v1.SendKFNewsPageMsgRequest.MpNews:type_name -> api.wxproxy.v1.SendKFNewsPageMsgRequest.KFNewsPageMsg
+	28,  // 11: api.wxproxy.v1.SendKFNewsCardMsgRequest.Common:type_name -> api.wxproxy.v1.KFMessageCommon
+	126, // 12: api.wxproxy.v1.SendKFNewsCardMsgRequest.News:type_name -> api.wxproxy.v1.SendKFNewsCardMsgRequest.KFNewsCardMsg
+	28,  // 13: api.wxproxy.v1.SendKFMusicMsgRequest.Common:type_name -> api.wxproxy.v1.KFMessageCommon
+	127, // 14: api.wxproxy.v1.SendKFMusicMsgRequest.Music:type_name -> api.wxproxy.v1.SendKFMusicMsgRequest.KFMusicMsg
+	28,  // 15: api.wxproxy.v1.SendKFVideoMsgRequest.Common:type_name -> api.wxproxy.v1.KFMessageCommon
+	128, // 16: api.wxproxy.v1.SendKFVideoMsgRequest.Video:type_name -> api.wxproxy.v1.SendKFVideoMsgRequest.KFVideoMsg
+	28,  // 17: api.wxproxy.v1.SendKFVoiceMsgRequest.Common:type_name -> api.wxproxy.v1.KFMessageCommon
+	129, // 18: api.wxproxy.v1.SendKFVoiceMsgRequest.Voice:type_name -> api.wxproxy.v1.SendKFVoiceMsgRequest.KFVoiceMsg
+	28,  // 19: api.wxproxy.v1.SendKFImageMsgRequest.Common:type_name -> api.wxproxy.v1.KFMessageCommon
+	130, // 20: api.wxproxy.v1.SendKFImageMsgRequest.Image:type_name -> api.wxproxy.v1.SendKFImageMsgRequest.KFImageMsg
+	131, // 21: api.wxproxy.v1.KFMessageCommon.CustomerService:type_name -> api.wxproxy.v1.KFMessageCommon.KFAccount
+	28,  // 22: api.wxproxy.v1.SendKFTextMsgRequest.Common:type_name -> api.wxproxy.v1.KFMessageCommon
+	132, // 23: api.wxproxy.v1.SendKFTextMsgRequest.Text:type_name -> api.wxproxy.v1.SendKFTextMsgRequest.KFTextMsg
+	133, // 24: api.wxproxy.v1.GetKFSessionUnacceptedReply.WaitCaseList:type_name -> api.wxproxy.v1.GetKFSessionUnacceptedReply.WaitCase
+	36,  // 25: api.wxproxy.v1.GetKFSessionListReply.SessionList:type_name -> api.wxproxy.v1.KFSession
+	45,  // 26: api.wxproxy.v1.GetKFMsgHistoryReply.RecordList:type_name -> api.wxproxy.v1.KFMsgHistory
+	48,  // 27: api.wxproxy.v1.GetKFOnlineListReply.KfOnlineList:type_name -> api.wxproxy.v1.KFOnlineInfo
+	50,  // 28: api.wxproxy.v1.GetKFListReply.KfList:type_name -> api.wxproxy.v1.KeFuInfo
+	135, // 29: api.wxproxy.v1.SendSubscribeMessageRequest.Data:type_name -> api.wxproxy.v1.SendSubscribeMessageRequest.DataEntry
+	66,  // 30: api.wxproxy.v1.SendSubscribeMessageRequest.Miniprogram:type_name -> api.wxproxy.v1.MiniProgram
+	136, // 31: api.wxproxy.v1.GetSubscribePrivateTplReply.Data:type_name -> api.wxproxy.v1.GetSubscribePrivateTplReply.Item
+	137, // 32: api.wxproxy.v1.GetSubscribeTplTitlesReply.Data:type_name -> api.wxproxy.v1.GetSubscribeTplTitlesReply.Item
+	138, // 33: api.wxproxy.v1.GetSubscribeTplKeywordsReply.Data:type_name -> api.wxproxy.v1.GetSubscribeTplKeywordsReply.Item
+	139, // 34: api.wxproxy.v1.GetSubscribeCategoryReply.Data:type_name -> api.wxproxy.v1.GetSubscribeCategoryReply.Category
+	140, // 35: api.wxproxy.v1.GetBlockedTplMsgReply.Msginfo:type_name -> api.wxproxy.v1.GetBlockedTplMsgReply.BlockedMsgInfo
+	142, // 36: api.wxproxy.v1.SendSubscribeMsgRequest.Data:type_name -> api.wxproxy.v1.SendSubscribeMsgRequest.DataEntry
+	66,  // 37: api.wxproxy.v1.SendSubscribeMsgRequest.Miniprogram:type_name -> api.wxproxy.v1.MiniProgram
+	144, // 38: api.wxproxy.v1.SendTplMsgRequest.Data:type_name -> api.wxproxy.v1.SendTplMsgRequest.DataEntry
+	66,  // 39: api.wxproxy.v1.SendTplMsgRequest.Miniprogram:type_name -> api.wxproxy.v1.MiniProgram
+	145, // 40: api.wxproxy.v1.GetAllPrivateTplReply.TemplateList:type_name -> api.wxproxy.v1.GetAllPrivateTplReply.TplInfo
+	146, // 41: api.wxproxy.v1.GetIndustryReply.PrimaryIndustry:type_name -> api.wxproxy.v1.GetIndustryReply.Industry
+	146, // 42: api.wxproxy.v1.GetIndustryReply.SecondaryIndustry:type_name -> api.wxproxy.v1.GetIndustryReply.Industry
+	81,  // 43: api.wxproxy.v1.CreateMenuRequest.Button:type_name -> api.wxproxy.v1.MenuButton
+	83,  // 44: api.wxproxy.v1.CreateMenuRequest.Matchrule:type_name -> api.wxproxy.v1.ConditionalMatchRule
+	147, // 45: api.wxproxy.v1.SelfMenuReply.SelfmenuInfo:type_name -> api.wxproxy.v1.SelfMenuReply.MenuInfoType
+	148, // 46: api.wxproxy.v1.SelfMenuButton.SubButton:type_name -> api.wxproxy.v1.SelfMenuButton.SubButtonType
+	149, // 47: api.wxproxy.v1.SelfMenuButton.NewsInfo:type_name -> api.wxproxy.v1.SelfMenuButton.NewsButtonType
+	81,  // 48: api.wxproxy.v1.TryMatchMenuReply.Button:type_name -> api.wxproxy.v1.MenuButton
+	150, // 49: api.wxproxy.v1.MenuInfoReply.Menu:type_name -> api.wxproxy.v1.MenuInfoReply.MenuType
+	82,  // 50: api.wxproxy.v1.MenuInfoReply.Conditionalmenu:type_name -> api.wxproxy.v1.ConditionalMenu
+	81,  // 51: api.wxproxy.v1.MenuButton.SubButton:type_name -> api.wxproxy.v1.MenuButton
+	81,  // 52: api.wxproxy.v1.ConditionalMenu.Button:type_name -> api.wxproxy.v1.MenuButton
+	83,  // 53: api.wxproxy.v1.ConditionalMenu.Matchrule:type_name -> api.wxproxy.v1.ConditionalMatchRule
+	151, // 54: api.wxproxy.v1.GetTagMembersReply.Data:type_name -> api.wxproxy.v1.GetTagMembersReply.DataT
+	99,  // 55: api.wxproxy.v1.CreateTagReply.tag:type_name -> api.wxproxy.v1.Tag
+	99,  // 56: api.wxproxy.v1.GetTagListReply.Tags:type_name -> api.wxproxy.v1.Tag
+	152, // 57: api.wxproxy.v1.BatchGetMemberInfoRequest.UserList:type_name -> api.wxproxy.v1.BatchGetMemberInfoRequest.OpenIdList
+	107, // 58: api.wxproxy.v1.BatchGetMemberInfoReply.UserListInfo:type_name -> api.wxproxy.v1.GetMemberInfoReply
+	153, // 59: api.wxproxy.v1.GetMemberListReply.Data:type_name -> api.wxproxy.v1.GetMemberListReply.IdList
+	116, // 60: api.wxproxy.v1.GetMaterialListReply.Item:type_name -> api.wxproxy.v1.MaterialItem
+	118, // 61: api.wxproxy.v1.GetMaterialNewsListReply.Item:type_name -> api.wxproxy.v1.MaterialNewsItem
+	119, // 62: api.wxproxy.v1.MaterialNewsItem.Articles:type_name -> api.wxproxy.v1.NewsArticle
+	122, // 63: api.wxproxy.v1.SendKFMenuMsgRequest.MenuMsg.List:type_name -> api.wxproxy.v1.SendKFMenuMsgRequest.Item
+	134, // 64: api.wxproxy.v1.SendSubscribeMessageRequest.DataEntry.value:type_name -> api.wxproxy.v1.SendSubscribeMessageRequest.DataItem
+	141, // 65: api.wxproxy.v1.SendSubscribeMsgRequest.DataEntry.value:type_name -> api.wxproxy.v1.SendSubscribeMsgRequest.DataItem
+	143, // 66: api.wxproxy.v1.SendTplMsgRequest.DataEntry.value:type_name -> api.wxproxy.v1.SendTplMsgRequest.DataItem
+	76,  // 67: api.wxproxy.v1.SelfMenuReply.MenuInfoType.Button:type_name -> api.wxproxy.v1.SelfMenuButton
+	76,  // 68: api.wxproxy.v1.SelfMenuButton.SubButtonType.List:type_name -> api.wxproxy.v1.SelfMenuButton
+	77,  // 69: api.wxproxy.v1.SelfMenuButton.NewsButtonType.List:type_name -> api.wxproxy.v1.NewsButton
+	81,  // 70: api.wxproxy.v1.MenuInfoReply.MenuType.Button:type_name -> api.wxproxy.v1.MenuButton
+	110, // 71: api.wxproxy.v1.GetMemberListReply.IdList.openid:type_name -> api.wxproxy.v1.OpenIdList
+	112, // 72: api.wxproxy.v1.Mpproxy.DeleteMaterial:input_type -> api.wxproxy.v1.DeleteMaterialReq
+	111, // 73: api.wxproxy.v1.Mpproxy.GetMaterialCount:input_type -> api.wxproxy.v1.AccessTokenParam
+	114, // 74: api.wxproxy.v1.Mpproxy.GetMaterialNewsList:input_type -> api.wxproxy.v1.GetMaterialListRequest
+	114, // 75: api.wxproxy.v1.Mpproxy.GetMaterialList:input_type -> api.wxproxy.v1.GetMaterialListRequest
+	108, // 76: api.wxproxy.v1.Mpproxy.GetMemberList:input_type -> api.wxproxy.v1.GetMemberListRequest
+	106, // 77: api.wxproxy.v1.Mpproxy.GetMemberInfo:input_type -> api.wxproxy.v1.GetMemberInfoRequest
+	104, // 78: api.wxproxy.v1.Mpproxy.BatchGetMemberInfo:input_type -> api.wxproxy.v1.BatchGetMemberInfoRequest
+	102, // 79: api.wxproxy.v1.Mpproxy.GetMemberTags:input_type -> api.wxproxy.v1.GetMemberTagsRequest
+	100, // 80: api.wxproxy.v1.Mpproxy.UpdateMemberRemark:input_type -> api.wxproxy.v1.UpdateMemberRemarkRequest
+	111, // 81: api.wxproxy.v1.Mpproxy.GetTagList:input_type -> api.wxproxy.v1.AccessTokenParam
+	96,  // 82: api.wxproxy.v1.Mpproxy.CreateTag:input_type -> api.wxproxy.v1.CreateTagRequest
+	95,  // 83: api.wxproxy.v1.Mpproxy.UpdateTag:input_type -> api.wxproxy.v1.UpdateTagRequest
+	94,  // 84: api.wxproxy.v1.Mpproxy.DeleteTag:input_type -> api.wxproxy.v1.DeleteTagRequest
+	93,  // 85: api.wxproxy.v1.Mpproxy.GetTagMembers:input_type -> api.wxproxy.v1.GetTagMembersRequest
+	91,  // 86: api.wxproxy.v1.Mpproxy.BatchTaggingMembers:input_type -> api.wxproxy.v1.BatchTaggingMembersRequest
+	90,  // 87: api.wxproxy.v1.Mpproxy.BatchUnTaggingMembers:input_type -> api.wxproxy.v1.BatchUnTaggingMembersRequest
+	89,  // 88: api.wxproxy.v1.Mpproxy.CreateTemporaryQRCode:input_type -> api.wxproxy.v1.CreateQRCodeRequest
+	89,  // 89: api.wxproxy.v1.Mpproxy.CreateLimitQRCode:input_type -> api.wxproxy.v1.CreateQRCodeRequest
+	86,  // 90: api.wxproxy.v1.Mpproxy.GenShorten:input_type -> api.wxproxy.v1.GenShortenRequest
+	84,  // 91: api.wxproxy.v1.Mpproxy.FetchShorten:input_type -> api.wxproxy.v1.FetchShortenRequest
+	111, // 92: api.wxproxy.v1.Mpproxy.GetMenuInfo:input_type -> api.wxproxy.v1.AccessTokenParam
+	78,  // 93: api.wxproxy.v1.Mpproxy.TryMatchMenu:input_type -> api.wxproxy.v1.TryMatchMenuRequest
+	111, // 94: api.wxproxy.v1.Mpproxy.PullMenu:input_type -> api.wxproxy.v1.AccessTokenParam
+	74,  // 95: api.wxproxy.v1.Mpproxy.CreateMenu:input_type -> api.wxproxy.v1.CreateMenuRequest
+	74,  // 96: api.wxproxy.v1.Mpproxy.CreateConditionalMenu:input_type -> api.wxproxy.v1.CreateMenuRequest
+	73,  // 97: api.wxproxy.v1.Mpproxy.DeleteConditionalMenu:input_type -> api.wxproxy.v1.DeleteConditionalMenuRequest
+	111, // 98: api.wxproxy.v1.Mpproxy.DeleteMenu:input_type -> api.wxproxy.v1.AccessTokenParam
+	111, // 99: api.wxproxy.v1.Mpproxy.GetIndustry:input_type -> api.wxproxy.v1.AccessTokenParam
+	111, // 100: api.wxproxy.v1.Mpproxy.GetAllPrivateTpl:input_type -> api.wxproxy.v1.AccessTokenParam
+	71,  // 101: api.wxproxy.v1.Mpproxy.SetIndustry:input_type -> api.wxproxy.v1.SetIndustryRequest
+	68,  // 102: api.wxproxy.v1.Mpproxy.GetMessageTplId:input_type -> api.wxproxy.v1.AddTemplateRequest
+	67,  // 103: api.wxproxy.v1.Mpproxy.DeleteMessageTpl:input_type -> api.wxproxy.v1.DeleteMessageTplRequest
+	65,  // 104: api.wxproxy.v1.Mpproxy.SendTplMsg:input_type -> api.wxproxy.v1.SendTplMsgRequest
+	63,  // 105: api.wxproxy.v1.Mpproxy.SendSubscribeMsg:input_type -> api.wxproxy.v1.SendSubscribeMsgRequest
+	61,  // 106: api.wxproxy.v1.Mpproxy.GetBlockedTplMsg:input_type -> api.wxproxy.v1.GetBlockedTplRequest
+	59,  // 107: api.wxproxy.v1.Mpproxy.AddSubscribeTpl:input_type -> api.wxproxy.v1.AddSubscribeTplRequest
+	58,  // 108: api.wxproxy.v1.Mpproxy.DelSubscribeTpl:input_type -> api.wxproxy.v1.DelSubscribeTplRequest
+	111, // 109: api.wxproxy.v1.Mpproxy.GetSubscribeCategory:input_type -> api.wxproxy.v1.AccessTokenParam
+	56,  // 110: api.wxproxy.v1.Mpproxy.GetSubscribeTplKeywords:input_type -> api.wxproxy.v1.GetSubscribeTplKeywordsRequest
+	54,  // 111: api.wxproxy.v1.Mpproxy.GetSubscribeTplTitles:input_type -> api.wxproxy.v1.GetSubscribeTplTitlesRequest
+	111, // 112: api.wxproxy.v1.Mpproxy.GetSubscribePrivateTpl:input_type -> api.wxproxy.v1.AccessTokenParam
+	51,  // 113: api.wxproxy.v1.Mpproxy.SendSubscribeMessage:input_type -> api.wxproxy.v1.SendSubscribeMessageRequest
+	111, // 114: api.wxproxy.v1.Mpproxy.GetKFList:input_type -> api.wxproxy.v1.AccessTokenParam
+	111, // 115: api.wxproxy.v1.Mpproxy.GetKFOnlineList:input_type -> api.wxproxy.v1.AccessTokenParam
+	46,  // 116: api.wxproxy.v1.Mpproxy.GetKFMsgHistory:input_type -> api.wxproxy.v1.GetKFMsgHistoryRequest
+	43,  // 117: api.wxproxy.v1.Mpproxy.AddKFAccount:input_type -> api.wxproxy.v1.AddKFAccountRequest
+	42,  // 118: api.wxproxy.v1.Mpproxy.UpdateKFAccount:input_type -> api.wxproxy.v1.UpdateKFAccountRequest
+	41,  // 119: api.wxproxy.v1.Mpproxy.DelKFAccount:input_type -> api.wxproxy.v1.DelKFAccountRequest
+	40,  // 120: api.wxproxy.v1.Mpproxy.InviteKFWorker:input_type -> api.wxproxy.v1.InviteKFWorkerRequest
+	39,  // 121: api.wxproxy.v1.Mpproxy.UpdateKFAvatar:input_type -> api.wxproxy.v1.UpdateKFAvatarRequest
+	38,  // 122: api.wxproxy.v1.Mpproxy.UpdateKFTyping:input_type -> api.wxproxy.v1.UpdateKFTypingRequest
+	37,  // 123: api.wxproxy.v1.Mpproxy.GetKFSessionList:input_type -> api.wxproxy.v1.GetKFSessionListRequest
+	34,  // 124: api.wxproxy.v1.Mpproxy.GetKFSessionStatus:input_type -> api.wxproxy.v1.GetKFSessionStatusRequest
+	111, // 125: api.wxproxy.v1.Mpproxy.GetKFSessionUnaccepted:input_type -> api.wxproxy.v1.AccessTokenParam
+	31,  // 126: api.wxproxy.v1.Mpproxy.CloseKFSession:input_type -> api.wxproxy.v1.CloseKFSessionRequest
+	30,  // 127: api.wxproxy.v1.Mpproxy.NewKFSession:input_type -> api.wxproxy.v1.NewKFSessionRequest
+	29,  // 128: api.wxproxy.v1.Mpproxy.SendKFTextMsg:input_type -> api.wxproxy.v1.SendKFTextMsgRequest
+	27,  // 129: api.wxproxy.v1.Mpproxy.SendKFImageMsg:input_type -> api.wxproxy.v1.SendKFImageMsgRequest
+	26,  // 130: api.wxproxy.v1.Mpproxy.SendKFVoiceMsg:input_type -> api.wxproxy.v1.SendKFVoiceMsgRequest
+	25,  // 131: api.wxproxy.v1.Mpproxy.SendKFVideoMsg:input_type -> api.wxproxy.v1.SendKFVideoMsgRequest
+	24,  // 132: api.wxproxy.v1.Mpproxy.SendKFMusicMsg:input_type -> api.wxproxy.v1.SendKFMusicMsgRequest
+	23,  // 133: api.wxproxy.v1.Mpproxy.SendKFNewsCardMsg:input_type -> api.wxproxy.v1.SendKFNewsCardMsgRequest
+	22,  // 134: api.wxproxy.v1.Mpproxy.SendKFNewsPageMsg:input_type -> api.wxproxy.v1.SendKFNewsPageMsgRequest
+	21,  // 135: api.wxproxy.v1.Mpproxy.SendKFToArticleMsg:input_type -> api.wxproxy.v1.SendKFToArticleMsgRequest
+	20,  // 136: api.wxproxy.v1.Mpproxy.SendKFMenuMsg:input_type -> api.wxproxy.v1.SendKFMenuMsgRequest
+	19,  // 137: api.wxproxy.v1.Mpproxy.SendKFCardMsg:input_type -> api.wxproxy.v1.SendKFCardMsgRequest
+	18,  // 138: api.wxproxy.v1.Mpproxy.SendKFMiniProgramMsg:input_type -> api.wxproxy.v1.SendKFMiniProgramMsgRequest
+	17,  // 139: api.wxproxy.v1.Mpproxy.BlockMember:input_type -> api.wxproxy.v1.BlockMemberReq
+	17,  // 140: api.wxproxy.v1.Mpproxy.UnBlockMember:input_type -> api.wxproxy.v1.BlockMemberReq
+	15,  // 141: api.wxproxy.v1.Mpproxy.GetBlacklist:input_type -> api.wxproxy.v1.GetBlacklistReq
+	0,   // 142: api.wxproxy.v1.Mpproxy.GetAccessToken:input_type -> api.wxproxy.v1.GetAccessTokenRequest
+	2,   // 143: api.wxproxy.v1.Mpproxy.RefreshAccessToken:input_type -> api.wxproxy.v1.RefreshAccessTokenRequest
+	4,   // 144: api.wxproxy.v1.Mpproxy.DescribeErrorCode:input_type -> api.wxproxy.v1.DescribeErrorCodeRequest
+	6,   // 145: api.wxproxy.v1.Mpproxy.GetRidInfo:input_type -> api.wxproxy.v1.GetRidInfoRequest
+	8,   // 146: api.wxproxy.v1.Mpproxy.GetApiQuota:input_type -> api.wxproxy.v1.GetApiQuotaRequest
+	10,  // 147: api.wxproxy.v1.Mpproxy.ClearQuota:input_type -> api.wxproxy.v1.ClearQuotaRequest
+	11,  // 148: api.wxproxy.v1.Mpproxy.ClearQuotaV2:input_type -> api.wxproxy.v1.ClearQuotaV2Request
+	12,  // 149: api.wxproxy.v1.Mpproxy.GetQuotaUsage:input_type -> api.wxproxy.v1.GetQuotaUsageRequest
+	101, // 150: api.wxproxy.v1.Mpproxy.DeleteMaterial:output_type -> api.wxproxy.v1.WXErrorReply
+	113, // 151: api.wxproxy.v1.Mpproxy.GetMaterialCount:output_type -> api.wxproxy.v1.GetMaterialCountReply
+	117, // 152: api.wxproxy.v1.Mpproxy.GetMaterialNewsList:output_type -> api.wxproxy.v1.GetMaterialNewsListReply
+	115, // 153: api.wxproxy.v1.Mpproxy.GetMaterialList:output_type -> api.wxproxy.v1.GetMaterialListReply
+	109, // 154: api.wxproxy.v1.Mpproxy.GetMemberList:output_type -> api.wxproxy.v1.GetMemberListReply
+	107, // 155: api.wxproxy.v1.Mpproxy.GetMemberInfo:output_type -> api.wxproxy.v1.GetMemberInfoReply
+	105, // 156: api.wxproxy.v1.Mpproxy.BatchGetMemberInfo:output_type -> api.wxproxy.v1.BatchGetMemberInfoReply
+	103, // 157: api.wxproxy.v1.Mpproxy.GetMemberTags:output_type -> api.wxproxy.v1.GetMemberTagsReply
+	101, // 158: api.wxproxy.v1.Mpproxy.UpdateMemberRemark:output_type -> api.wxproxy.v1.WXErrorReply
+	98,  // 159: api.wxproxy.v1.Mpproxy.GetTagList:output_type -> api.wxproxy.v1.GetTagListReply
+	97,  // 160: api.wxproxy.v1.Mpproxy.CreateTag:output_type -> api.wxproxy.v1.CreateTagReply
+	101, // 161: api.wxproxy.v1.Mpproxy.UpdateTag:output_type -> api.wxproxy.v1.WXErrorReply
+	101, // 162: api.wxproxy.v1.Mpproxy.DeleteTag:output_type -> api.wxproxy.v1.WXErrorReply
+	92,  // 163: api.wxproxy.v1.Mpproxy.GetTagMembers:output_type -> api.wxproxy.v1.GetTagMembersReply
+	101, // 164: api.wxproxy.v1.Mpproxy.BatchTaggingMembers:output_type -> api.wxproxy.v1.WXErrorReply
+	101, // 165: api.wxproxy.v1.Mpproxy.BatchUnTaggingMembers:output_type -> api.wxproxy.v1.WXErrorReply
+	88,  // 166: api.wxproxy.v1.Mpproxy.CreateTemporaryQRCode:output_type -> api.wxproxy.v1.CreateQRCodeReply
+	88,  // 167: api.wxproxy.v1.Mpproxy.CreateLimitQRCode:output_type -> api.wxproxy.v1.CreateQRCodeReply
+	87,  // 168: api.wxproxy.v1.Mpproxy.GenShorten:output_type -> api.wxproxy.v1.GenShortenReply
+	85,  // 169: api.wxproxy.v1.Mpproxy.FetchShorten:output_type -> api.wxproxy.v1.FetchShortenReply
+	80,  // 170: api.wxproxy.v1.Mpproxy.GetMenuInfo:output_type -> api.wxproxy.v1.MenuInfoReply
+	79,  // 171: api.wxproxy.v1.Mpproxy.TryMatchMenu:output_type -> api.wxproxy.v1.TryMatchMenuReply
+	75,  // 172: api.wxproxy.v1.Mpproxy.PullMenu:output_type -> api.wxproxy.v1.SelfMenuReply
+	101, // 173: api.wxproxy.v1.Mpproxy.CreateMenu:output_type -> api.wxproxy.v1.WXErrorReply
+	101, // 174: api.wxproxy.v1.Mpproxy.CreateConditionalMenu:output_type -> api.wxproxy.v1.WXErrorReply
+	101, // 175: api.wxproxy.v1.Mpproxy.DeleteConditionalMenu:output_type -> api.wxproxy.v1.WXErrorReply
+	101, // 176: api.wxproxy.v1.Mpproxy.DeleteMenu:output_type -> api.wxproxy.v1.WXErrorReply
+	72,  // 177: api.wxproxy.v1.Mpproxy.GetIndustry:output_type -> api.wxproxy.v1.GetIndustryReply
+	70,  // 178: api.wxproxy.v1.Mpproxy.GetAllPrivateTpl:output_type -> api.wxproxy.v1.GetAllPrivateTplReply
+	101, // 179: api.wxproxy.v1.Mpproxy.SetIndustry:output_type -> api.wxproxy.v1.WXErrorReply
+	69,  // 180: api.wxproxy.v1.Mpproxy.GetMessageTplId:output_type -> api.wxproxy.v1.AddMessageTplReply
+	101, // 181: api.wxproxy.v1.Mpproxy.DeleteMessageTpl:output_type -> api.wxproxy.v1.WXErrorReply
+	64,  // 182: api.wxproxy.v1.Mpproxy.SendTplMsg:output_type -> api.wxproxy.v1.SendTplMsgReply
+	101, // 183: api.wxproxy.v1.Mpproxy.SendSubscribeMsg:output_type -> api.wxproxy.v1.WXErrorReply
+	62,  // 184: api.wxproxy.v1.Mpproxy.GetBlockedTplMsg:output_type -> api.wxproxy.v1.GetBlockedTplMsgReply
+	60,  // 185: api.wxproxy.v1.Mpproxy.AddSubscribeTpl:output_type -> api.wxproxy.v1.AddSubscribeTplReply
+	101, // 186: api.wxproxy.v1.Mpproxy.DelSubscribeTpl:output_type -> api.wxproxy.v1.WXErrorReply
+	57,  // 187: api.wxproxy.v1.Mpproxy.GetSubscribeCategory:output_type -> api.wxproxy.v1.GetSubscribeCategoryReply
+	55,  // 188: api.wxproxy.v1.Mpproxy.GetSubscribeTplKeywords:output_type -> api.wxproxy.v1.GetSubscribeTplKeywordsReply
+	53,  // 189: api.wxproxy.v1.Mpproxy.GetSubscribeTplTitles:output_type -> api.wxproxy.v1.GetSubscribeTplTitlesReply
+	52,  // 190: api.wxproxy.v1.Mpproxy.GetSubscribePrivateTpl:output_type -> api.wxproxy.v1.GetSubscribePrivateTplReply
+	101, // 191: api.wxproxy.v1.Mpproxy.SendSubscribeMessage:output_type -> api.wxproxy.v1.WXErrorReply
+	49,  // 192: api.wxproxy.v1.Mpproxy.GetKFList:output_type -> api.wxproxy.v1.GetKFListReply
+	47,  // 193: api.wxproxy.v1.Mpproxy.GetKFOnlineList:output_type -> api.wxproxy.v1.GetKFOnlineListReply
+	44,  // 194: api.wxproxy.v1.Mpproxy.GetKFMsgHistory:output_type -> api.wxproxy.v1.GetKFMsgHistoryReply
+	101, // 195: api.wxproxy.v1.Mpproxy.AddKFAccount:output_type -> api.wxproxy.v1.WXErrorReply
+	101, // 196: api.wxproxy.v1.Mpproxy.UpdateKFAccount:output_type -> api.wxproxy.v1.WXErrorReply
+	101, // 197: api.wxproxy.v1.Mpproxy.DelKFAccount:output_type -> api.wxproxy.v1.WXErrorReply
+	101, // 198: api.wxproxy.v1.Mpproxy.InviteKFWorker:output_type -> api.wxproxy.v1.WXErrorReply
+	101, // 199: api.wxproxy.v1.Mpproxy.UpdateKFAvatar:output_type -> api.wxproxy.v1.WXErrorReply
+	101, // 200: api.wxproxy.v1.Mpproxy.UpdateKFTyping:output_type -> api.wxproxy.v1.WXErrorReply
+	35,  // 201: api.wxproxy.v1.Mpproxy.GetKFSessionList:output_type -> api.wxproxy.v1.GetKFSessionListReply
+	33,  // 202: api.wxproxy.v1.Mpproxy.GetKFSessionStatus:output_type -> api.wxproxy.v1.GetKFSessionStatusReply
+	32,  // 203: api.wxproxy.v1.Mpproxy.GetKFSessionUnaccepted:output_type -> api.wxproxy.v1.GetKFSessionUnacceptedReply
+	101, // 204: api.wxproxy.v1.Mpproxy.CloseKFSession:output_type -> api.wxproxy.v1.WXErrorReply
+	101, // 205: api.wxproxy.v1.Mpproxy.NewKFSession:output_type -> api.wxproxy.v1.WXErrorReply
+	101, // 206: api.wxproxy.v1.Mpproxy.SendKFTextMsg:output_type -> api.wxproxy.v1.WXErrorReply
+	101, // 207: api.wxproxy.v1.Mpproxy.SendKFImageMsg:output_type -> api.wxproxy.v1.WXErrorReply
+	101, // 208: api.wxproxy.v1.Mpproxy.SendKFVoiceMsg:output_type -> api.wxproxy.v1.WXErrorReply
+	101, // 209: api.wxproxy.v1.Mpproxy.SendKFVideoMsg:output_type -> api.wxproxy.v1.WXErrorReply
+	101, // 210: api.wxproxy.v1.Mpproxy.SendKFMusicMsg:output_type -> api.wxproxy.v1.WXErrorReply
+	101, // 211: api.wxproxy.v1.Mpproxy.SendKFNewsCardMsg:output_type -> api.wxproxy.v1.WXErrorReply
+	101, // 212: api.wxproxy.v1.Mpproxy.SendKFNewsPageMsg:output_type -> api.wxproxy.v1.WXErrorReply
+	101, // 213: api.wxproxy.v1.Mpproxy.SendKFToArticleMsg:output_type -> api.wxproxy.v1.WXErrorReply
+	101, // 214: api.wxproxy.v1.Mpproxy.SendKFMenuMsg:output_type -> api.wxproxy.v1.WXErrorReply
+	101, // 215: api.wxproxy.v1.Mpproxy.SendKFCardMsg:output_type -> api.wxproxy.v1.WXErrorReply
+	101, // 216: api.wxproxy.v1.Mpproxy.SendKFMiniProgramMsg:output_type -> api.wxproxy.v1.WXErrorReply
+	101, // 217: api.wxproxy.v1.Mpproxy.BlockMember:output_type -> api.wxproxy.v1.WXErrorReply
+	101, // 218: api.wxproxy.v1.Mpproxy.UnBlockMember:output_type -> api.wxproxy.v1.WXErrorReply
+	16,  // 219: api.wxproxy.v1.Mpproxy.GetBlacklist:output_type -> api.wxproxy.v1.GetBlacklistReply
+	1,   // 220: api.wxproxy.v1.Mpproxy.GetAccessToken:output_type -> api.wxproxy.v1.GetAccessTokenReply
+	3,   // 221: api.wxproxy.v1.Mpproxy.RefreshAccessToken:output_type -> api.wxproxy.v1.RefreshAccessTokenReply
+	5,   // 222: api.wxproxy.v1.Mpproxy.DescribeErrorCode:output_type -> api.wxproxy.v1.DescribeErrorCodeReply
+	7,   // 223: api.wxproxy.v1.Mpproxy.GetRidInfo:output_type -> api.wxproxy.v1.GetRidInfoReply
+	9,   // 224: api.wxproxy.v1.Mpproxy.GetApiQuota:output_type -> api.wxproxy.v1.GetApiQuotaReply
+	101, // 225: api.wxproxy.v1.Mpproxy.ClearQuota:output_type -> api.wxproxy.v1.WXErrorReply
+	101, // 226: api.wxproxy.v1.Mpproxy.ClearQuotaV2:output_type -> api.wxproxy.v1.WXErrorReply
+	14,  // 227: api.wxproxy.v1.Mpproxy.GetQuotaUsage:output_type -> api.wxproxy.v1.GetQuotaUsageReply
+	150, // [150:228] is the sub-list for method output_type
+	72,  // [72:150] is the sub-list for method input_type
+	72,  // [72:72] is the sub-list for extension type_name
+	72,  // [72:72] is the sub-list for extension extendee
+	0,   // [0:72] is the sub-list for field type_name
 }
 
 func init() { file_v1_wxproxy_proto_init() }
@@ -9161,7 +10729,7 @@ func file_v1_wxproxy_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_wxproxy_proto_rawDesc), len(file_v1_wxproxy_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   139,
+			NumMessages:   154,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,19707 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: v1/wxproxy.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on GetAccessTokenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAccessTokenRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAccessTokenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAccessTokenRequestMultiError, or nil if none found.
+func (m *GetAccessTokenRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAccessTokenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAppId()) < 1 {
+		err := GetAccessTokenRequestValidationError{
+			field:  "AppId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetAccessTokenRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAccessTokenRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAccessTokenRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAccessTokenRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAccessTokenRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAccessTokenRequestMultiError) AllErrors() []error { return m }
+
+// GetAccessTokenRequestValidationError is the validation error returned by
+// GetAccessTokenRequest.Validate if the designated constraints aren't met.
+type GetAccessTokenRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAccessTokenRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAccessTokenRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAccessTokenRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAccessTokenRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAccessTokenRequestValidationError) ErrorName() string {
+	return "GetAccessTokenRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAccessTokenRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAccessTokenRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAccessTokenRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAccessTokenRequestValidationError{}
+
+// Validate checks the field values on GetAccessTokenReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAccessTokenReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAccessTokenReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAccessTokenReplyMultiError, or nil if none found.
+func (m *GetAccessTokenReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAccessTokenReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccessToken
+
+	// no validation rules for ExpiresAt
+
+	// no validation rules for ExpiresIn
+
+	if len(errors) > 0 {
+		return GetAccessTokenReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAccessTokenReplyMultiError is an error wrapping multiple validation
+// errors returned by GetAccessTokenReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetAccessTokenReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAccessTokenReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAccessTokenReplyMultiError) AllErrors() []error { return m }
+
+// GetAccessTokenReplyValidationError is the validation error returned by
+// GetAccessTokenReply.Validate if the designated constraints aren't met.
+type GetAccessTokenReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAccessTokenReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAccessTokenReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAccessTokenReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAccessTokenReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAccessTokenReplyValidationError) ErrorName() string {
+	return "GetAccessTokenReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAccessTokenReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAccessTokenReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAccessTokenReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAccessTokenReplyValidationError{}
+
+// Validate checks the field values on RefreshAccessTokenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefreshAccessTokenRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefreshAccessTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefreshAccessTokenRequestMultiError, or nil if none found.
+func (m *RefreshAccessTokenRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefreshAccessTokenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAppId()) < 1 {
+		err := RefreshAccessTokenRequestValidationError{
+			field:  "AppId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ForceRefresh
+
+	if len(errors) > 0 {
+		return RefreshAccessTokenRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefreshAccessTokenRequestMultiError is an error wrapping multiple validation
+// errors returned by RefreshAccessTokenRequest.ValidateAll() if the
+// designated constraints aren't met.
+type RefreshAccessTokenRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefreshAccessTokenRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefreshAccessTokenRequestMultiError) AllErrors() []error { return m }
+
+// RefreshAccessTokenRequestValidationError is the validation error returned by
+// RefreshAccessTokenRequest.Validate if the designated constraints aren't met.
+type RefreshAccessTokenRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefreshAccessTokenRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefreshAccessTokenRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefreshAccessTokenRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefreshAccessTokenRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefreshAccessTokenRequestValidationError) ErrorName() string {
+	return "RefreshAccessTokenRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefreshAccessTokenRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefreshAccessTokenRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefreshAccessTokenRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefreshAccessTokenRequestValidationError{}
+
+// Validate checks the field values on RefreshAccessTokenReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefreshAccessTokenReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefreshAccessTokenReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefreshAccessTokenReplyMultiError, or nil if none found.
+func (m *RefreshAccessTokenReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefreshAccessTokenReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ExpiresAt
+
+	// no validation rules for ForceRefreshCount
+
+	// no validation rules for ForceRefreshLimit
+
+	if len(errors) > 0 {
+		return RefreshAccessTokenReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefreshAccessTokenReplyMultiError is an error wrapping multiple validation
+// errors returned by RefreshAccessTokenReply.ValidateAll() if the designated
+// constraints aren't met.
+type RefreshAccessTokenReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefreshAccessTokenReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefreshAccessTokenReplyMultiError) AllErrors() []error { return m }
+
+// RefreshAccessTokenReplyValidationError is the validation error returned by
+// RefreshAccessTokenReply.Validate if the designated constraints aren't met.
+type RefreshAccessTokenReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefreshAccessTokenReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefreshAccessTokenReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefreshAccessTokenReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefreshAccessTokenReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefreshAccessTokenReplyValidationError) ErrorName() string {
+	return "RefreshAccessTokenReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefreshAccessTokenReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefreshAccessTokenReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefreshAccessTokenReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefreshAccessTokenReplyValidationError{}
+
+// Validate checks the field values on DescribeErrorCodeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DescribeErrorCodeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeErrorCodeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeErrorCodeRequestMultiError, or nil if none found.
+func (m *DescribeErrorCodeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeErrorCodeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Errcode
+
+	if len(errors) > 0 {
+		return DescribeErrorCodeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeErrorCodeRequestMultiError is an error wrapping multiple validation
+// errors returned by DescribeErrorCodeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DescribeErrorCodeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeErrorCodeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeErrorCodeRequestMultiError) AllErrors() []error { return m }
+
+// DescribeErrorCodeRequestValidationError is the validation error returned by
+// DescribeErrorCodeRequest.Validate if the designated constraints aren't met.
+type DescribeErrorCodeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeErrorCodeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeErrorCodeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeErrorCodeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeErrorCodeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeErrorCodeRequestValidationError) ErrorName() string {
+	return "DescribeErrorCodeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeErrorCodeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeErrorCodeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeErrorCodeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeErrorCodeRequestValidationError{}
+
+// Validate checks the field values on DescribeErrorCodeReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DescribeErrorCodeReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeErrorCodeReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeErrorCodeReplyMultiError, or nil if none found.
+func (m *DescribeErrorCodeReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeErrorCodeReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Errcode
+
+	// no validation rules for Reason
+
+	// no validation rules for Category
+
+	// no validation rules for Retryable
+
+	// no validation rules for DescriptionZh
+
+	// no validation rules for DescriptionEn
+
+	// no validation rules for SuggestedAction
+
+	if len(errors) > 0 {
+		return DescribeErrorCodeReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeErrorCodeReplyMultiError is an error wrapping multiple validation
+// errors returned by DescribeErrorCodeReply.ValidateAll() if the designated
+// constraints aren't met.
+type DescribeErrorCodeReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeErrorCodeReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeErrorCodeReplyMultiError) AllErrors() []error { return m }
+
+// DescribeErrorCodeReplyValidationError is the validation error returned by
+// DescribeErrorCodeReply.Validate if the designated constraints aren't met.
+type DescribeErrorCodeReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeErrorCodeReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeErrorCodeReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeErrorCodeReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeErrorCodeReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeErrorCodeReplyValidationError) ErrorName() string {
+	return "DescribeErrorCodeReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeErrorCodeReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeErrorCodeReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeErrorCodeReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeErrorCodeReplyValidationError{}
+
+// Validate checks the field values on GetRidInfoRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetRidInfoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRidInfoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRidInfoRequestMultiError, or nil if none found.
+func (m *GetRidInfoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRidInfoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := GetRidInfoRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRid()) < 1 {
+		err := GetRidInfoRequestValidationError{
+			field:  "Rid",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	if len(errors) > 0 {
+		return GetRidInfoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRidInfoRequestMultiError is an error wrapping multiple validation errors
+// returned by GetRidInfoRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetRidInfoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRidInfoRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRidInfoRequestMultiError) AllErrors() []error { return m }
+
+// GetRidInfoRequestValidationError is the validation error returned by
+// GetRidInfoRequest.Validate if the designated constraints aren't met.
+type GetRidInfoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRidInfoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRidInfoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRidInfoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRidInfoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRidInfoRequestValidationError) ErrorName() string {
+	return "GetRidInfoRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRidInfoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRidInfoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRidInfoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRidInfoRequestValidationError{}
+
+// Validate checks the field values on GetRidInfoReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetRidInfoReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRidInfoReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRidInfoReplyMultiError, or nil if none found.
+func (m *GetRidInfoReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRidInfoReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InvokeTime
+
+	// no validation rules for CostInMs
+
+	// no validation rules for RequestUrl
+
+	// no validation rules for RequestBody
+
+	// no validation rules for ResponseBody
+
+	// no validation rules for ClientIp
+
+	if len(errors) > 0 {
+		return GetRidInfoReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRidInfoReplyMultiError is an error wrapping multiple validation errors
+// returned by GetRidInfoReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetRidInfoReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRidInfoReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRidInfoReplyMultiError) AllErrors() []error { return m }
+
+// GetRidInfoReplyValidationError is the validation error returned by
+// GetRidInfoReply.Validate if the designated constraints aren't met.
+type GetRidInfoReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRidInfoReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRidInfoReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRidInfoReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRidInfoReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRidInfoReplyValidationError) ErrorName() string { return "GetRidInfoReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetRidInfoReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRidInfoReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRidInfoReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRidInfoReplyValidationError{}
+
+// Validate checks the field values on GetApiQuotaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetApiQuotaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetApiQuotaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetApiQuotaRequestMultiError, or nil if none found.
+func (m *GetApiQuotaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetApiQuotaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := GetApiQuotaRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !strings.HasPrefix(m.GetCgiPath(), "/") {
+		err := GetApiQuotaRequestValidationError{
+			field:  "CgiPath",
+			reason: "value does not have prefix \"/\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	if len(errors) > 0 {
+		return GetApiQuotaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetApiQuotaRequestMultiError is an error wrapping multiple validation errors
+// returned by GetApiQuotaRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetApiQuotaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetApiQuotaRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetApiQuotaRequestMultiError) AllErrors() []error { return m }
+
+// GetApiQuotaRequestValidationError is the validation error returned by
+// GetApiQuotaRequest.Validate if the designated constraints aren't met.
+type GetApiQuotaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetApiQuotaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetApiQuotaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetApiQuotaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetApiQuotaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetApiQuotaRequestValidationError) ErrorName() string {
+	return "GetApiQuotaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetApiQuotaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetApiQuotaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetApiQuotaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetApiQuotaRequestValidationError{}
+
+// Validate checks the field values on GetApiQuotaReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetApiQuotaReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetApiQuotaReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetApiQuotaReplyMultiError, or nil if none found.
+func (m *GetApiQuotaReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetApiQuotaReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DailyLimit
+
+	// no validation rules for Used
+
+	// no validation rules for Remain
+
+	// no validation rules for RateLimitCallCount
+
+	// no validation rules for RateLimitRefreshSecond
+
+	if len(errors) > 0 {
+		return GetApiQuotaReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetApiQuotaReplyMultiError is an error wrapping multiple validation errors
+// returned by GetApiQuotaReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetApiQuotaReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetApiQuotaReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetApiQuotaReplyMultiError) AllErrors() []error { return m }
+
+// GetApiQuotaReplyValidationError is the validation error returned by
+// GetApiQuotaReply.Validate if the designated constraints aren't met.
+type GetApiQuotaReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetApiQuotaReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetApiQuotaReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetApiQuotaReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetApiQuotaReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetApiQuotaReplyValidationError) ErrorName() string { return "GetApiQuotaReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetApiQuotaReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetApiQuotaReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetApiQuotaReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetApiQuotaReplyValidationError{}
+
+// Validate checks the field values on ClearQuotaRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ClearQuotaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClearQuotaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClearQuotaRequestMultiError, or nil if none found.
+func (m *ClearQuotaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClearQuotaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := ClearQuotaRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAppId()) < 1 {
+		err := ClearQuotaRequestValidationError{
+			field:  "AppId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ClearQuotaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClearQuotaRequestMultiError is an error wrapping multiple validation errors
+// returned by ClearQuotaRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ClearQuotaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClearQuotaRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClearQuotaRequestMultiError) AllErrors() []error { return m }
+
+// ClearQuotaRequestValidationError is the validation error returned by
+// ClearQuotaRequest.Validate if the designated constraints aren't met.
+type ClearQuotaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClearQuotaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClearQuotaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClearQuotaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClearQuotaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClearQuotaRequestValidationError) ErrorName() string {
+	return "ClearQuotaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClearQuotaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClearQuotaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClearQuotaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClearQuotaRequestValidationError{}
+
+// Validate checks the field values on ClearQuotaV2Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClearQuotaV2Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClearQuotaV2Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClearQuotaV2RequestMultiError, or nil if none found.
+func (m *ClearQuotaV2Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClearQuotaV2Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAppId()) < 1 {
+		err := ClearQuotaV2RequestValidationError{
+			field:  "AppId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ClearQuotaV2RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClearQuotaV2RequestMultiError is an error wrapping multiple validation
+// errors returned by ClearQuotaV2Request.ValidateAll() if the designated
+// constraints aren't met.
+type ClearQuotaV2RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClearQuotaV2RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClearQuotaV2RequestMultiError) AllErrors() []error { return m }
+
+// ClearQuotaV2RequestValidationError is the validation error returned by
+// ClearQuotaV2Request.Validate if the designated constraints aren't met.
+type ClearQuotaV2RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClearQuotaV2RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClearQuotaV2RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClearQuotaV2RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClearQuotaV2RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClearQuotaV2RequestValidationError) ErrorName() string {
+	return "ClearQuotaV2RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClearQuotaV2RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClearQuotaV2Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClearQuotaV2RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClearQuotaV2RequestValidationError{}
+
+// Validate checks the field values on GetQuotaUsageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetQuotaUsageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetQuotaUsageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetQuotaUsageRequestMultiError, or nil if none found.
+func (m *GetQuotaUsageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetQuotaUsageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccessToken
+
+	if utf8.RuneCountInString(m.GetAppId()) < 1 {
+		err := GetQuotaUsageRequestValidationError{
+			field:  "AppId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_GetQuotaUsageRequest_Date_Pattern.MatchString(m.GetDate()) {
+		err := GetQuotaUsageRequestValidationError{
+			field:  "Date",
+			reason: "value does not match regex pattern \"^([0-9]{8})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for WithLimit
+
+	if len(errors) > 0 {
+		return GetQuotaUsageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetQuotaUsageRequestMultiError is an error wrapping multiple validation
+// errors returned by GetQuotaUsageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetQuotaUsageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetQuotaUsageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetQuotaUsageRequestMultiError) AllErrors() []error { return m }
+
+// GetQuotaUsageRequestValidationError is the validation error returned by
+// GetQuotaUsageRequest.Validate if the designated constraints aren't met.
+type GetQuotaUsageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetQuotaUsageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetQuotaUsageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetQuotaUsageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetQuotaUsageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetQuotaUsageRequestValidationError) ErrorName() string {
+	return "GetQuotaUsageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetQuotaUsageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetQuotaUsageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetQuotaUsageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetQuotaUsageRequestValidationError{}
+
+var _GetQuotaUsageRequest_Date_Pattern = regexp.MustCompile("^([0-9]{8})?$")
+
+// Validate checks the field values on QuotaUsage with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *QuotaUsage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QuotaUsage with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QuotaUsageMultiError, or
+// nil if none found.
+func (m *QuotaUsage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QuotaUsage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CgiPath
+
+	// no validation rules for Count
+
+	// no validation rules for DailyLimit
+
+	// no validation rules for Used
+
+	// no validation rules for Remain
+
+	if len(errors) > 0 {
+		return QuotaUsageMultiError(errors)
+	}
+
+	return nil
+}
+
+// QuotaUsageMultiError is an error wrapping multiple validation errors
+// returned by QuotaUsage.ValidateAll() if the designated constraints aren't met.
+type QuotaUsageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QuotaUsageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QuotaUsageMultiError) AllErrors() []error { return m }
+
+// QuotaUsageValidationError is the validation error returned by
+// QuotaUsage.Validate if the designated constraints aren't met.
+type QuotaUsageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QuotaUsageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QuotaUsageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QuotaUsageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QuotaUsageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QuotaUsageValidationError) ErrorName() string { return "QuotaUsageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QuotaUsageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQuotaUsage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QuotaUsageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QuotaUsageValidationError{}
+
+// Validate checks the field values on GetQuotaUsageReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetQuotaUsageReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetQuotaUsageReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetQuotaUsageReplyMultiError, or nil if none found.
+func (m *GetQuotaUsageReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetQuotaUsageReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Date
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetQuotaUsageReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetQuotaUsageReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetQuotaUsageReplyValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetQuotaUsageReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetQuotaUsageReplyMultiError is an error wrapping multiple validation errors
+// returned by GetQuotaUsageReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetQuotaUsageReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetQuotaUsageReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetQuotaUsageReplyMultiError) AllErrors() []error { return m }
+
+// GetQuotaUsageReplyValidationError is the validation error returned by
+// GetQuotaUsageReply.Validate if the designated constraints aren't met.
+type GetQuotaUsageReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetQuotaUsageReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetQuotaUsageReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetQuotaUsageReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetQuotaUsageReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetQuotaUsageReplyValidationError) ErrorName() string {
+	return "GetQuotaUsageReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetQuotaUsageReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetQuotaUsageReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetQuotaUsageReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetQuotaUsageReplyValidationError{}
+
+// Validate checks the field values on GetBlacklistReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetBlacklistReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBlacklistReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBlacklistReqMultiError, or nil if none found.
+func (m *GetBlacklistReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBlacklistReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := GetBlacklistReqValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	// no validation rules for NextOpenid
+
+	if len(errors) > 0 {
+		return GetBlacklistReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBlacklistReqMultiError is an error wrapping multiple validation errors
+// returned by GetBlacklistReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetBlacklistReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBlacklistReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBlacklistReqMultiError) AllErrors() []error { return m }
+
+// GetBlacklistReqValidationError is the validation error returned by
+// GetBlacklistReq.Validate if the designated constraints aren't met.
+type GetBlacklistReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBlacklistReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBlacklistReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBlacklistReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBlacklistReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBlacklistReqValidationError) ErrorName() string { return "GetBlacklistReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetBlacklistReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBlacklistReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBlacklistReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBlacklistReqValidationError{}
+
+// Validate checks the field values on GetBlacklistReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetBlacklistReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBlacklistReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBlacklistReplyMultiError, or nil if none found.
+func (m *GetBlacklistReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBlacklistReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	// no validation rules for Count
+
+	// no validation rules for NextOpenid
+
+	if len(errors) > 0 {
+		return GetBlacklistReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBlacklistReplyMultiError is an error wrapping multiple validation errors
+// returned by GetBlacklistReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetBlacklistReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBlacklistReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBlacklistReplyMultiError) AllErrors() []error { return m }
+
+// GetBlacklistReplyValidationError is the validation error returned by
+// GetBlacklistReply.Validate if the designated constraints aren't met.
+type GetBlacklistReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBlacklistReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBlacklistReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBlacklistReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBlacklistReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBlacklistReplyValidationError) ErrorName() string {
+	return "GetBlacklistReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBlacklistReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBlacklistReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBlacklistReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBlacklistReplyValidationError{}
+
+// Validate checks the field values on BlockMemberReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BlockMemberReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlockMemberReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BlockMemberReqMultiError,
+// or nil if none found.
+func (m *BlockMemberReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlockMemberReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := BlockMemberReqValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	if len(m.GetOpenIds()) < 1 {
+		err := BlockMemberReqValidationError{
+			field:  "OpenIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetOpenIds() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := BlockMemberReqValidationError{
+				field:  fmt.Sprintf("OpenIds[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BlockMemberReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlockMemberReqMultiError is an error wrapping multiple validation errors
+// returned by BlockMemberReq.ValidateAll() if the designated constraints
+// aren't met.
+type BlockMemberReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlockMemberReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlockMemberReqMultiError) AllErrors() []error { return m }
+
+// BlockMemberReqValidationError is the validation error returned by
+// BlockMemberReq.Validate if the designated constraints aren't met.
+type BlockMemberReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlockMemberReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlockMemberReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlockMemberReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlockMemberReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlockMemberReqValidationError) ErrorName() string { return "BlockMemberReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BlockMemberReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlockMemberReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlockMemberReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlockMemberReqValidationError{}
+
+// Validate checks the field values on SendKFMiniProgramMsgRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendKFMiniProgramMsgRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendKFMiniProgramMsgRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendKFMiniProgramMsgRequestMultiError, or nil if none found.
+func (m *SendKFMiniProgramMsgRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendKFMiniProgramMsgRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := SendKFMiniProgramMsgRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	// no validation rules for Type
+
+	if m.GetCommon() == nil {
+		err := SendKFMiniProgramMsgRequestValidationError{
+			field:  "Common",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCommon()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendKFMiniProgramMsgRequestValidationError{
+					field:  "Common",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendKFMiniProgramMsgRequestValidationError{
+					field:  "Common",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCommon()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendKFMiniProgramMsgRequestValidationError{
+				field:  "Common",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetMiniProgramPage() == nil {
+		err := SendKFMiniProgramMsgRequestValidationError{
+			field:  "MiniProgramPage",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMiniProgramPage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendKFMiniProgramMsgRequestValidationError{
+					field:  "MiniProgramPage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendKFMiniProgramMsgRequestValidationError{
+					field:  "MiniProgramPage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMiniProgramPage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendKFMiniProgramMsgRequestValidationError{
+				field:  "MiniProgramPage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SendKFMiniProgramMsgRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendKFMiniProgramMsgRequestMultiError is an error wrapping multiple
+// validation errors returned by SendKFMiniProgramMsgRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SendKFMiniProgramMsgRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendKFMiniProgramMsgRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendKFMiniProgramMsgRequestMultiError) AllErrors() []error { return m }
+
+// SendKFMiniProgramMsgRequestValidationError is the validation error returned
+// by SendKFMiniProgramMsgRequest.Validate if the designated constraints
+// aren't met.
+type SendKFMiniProgramMsgRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendKFMiniProgramMsgRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendKFMiniProgramMsgRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendKFMiniProgramMsgRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendKFMiniProgramMsgRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendKFMiniProgramMsgRequestValidationError) ErrorName() string {
+	return "SendKFMiniProgramMsgRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendKFMiniProgramMsgRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendKFMiniProgramMsgRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendKFMiniProgramMsgRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendKFMiniProgramMsgRequestValidationError{}
+
+// Validate checks the field values on SendKFCardMsgRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendKFCardMsgRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendKFCardMsgRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendKFCardMsgRequestMultiError, or nil if none found.
+func (m *SendKFCardMsgRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendKFCardMsgRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := SendKFCardMsgRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	// no validation rules for Type
+
+	if m.GetCommon() == nil {
+		err := SendKFCardMsgRequestValidationError{
+			field:  "Common",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCommon()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendKFCardMsgRequestValidationError{
+					field:  "Common",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendKFCardMsgRequestValidationError{
+					field:  "Common",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCommon()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendKFCardMsgRequestValidationError{
+				field:  "Common",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetWxCard() == nil {
+		err := SendKFCardMsgRequestValidationError{
+			field:  "WxCard",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetWxCard()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendKFCardMsgRequestValidationError{
+					field:  "WxCard",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendKFCardMsgRequestValidationError{
+					field:  "WxCard",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWxCard()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendKFCardMsgRequestValidationError{
+				field:  "WxCard",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SendKFCardMsgRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendKFCardMsgRequestMultiError is an error wrapping multiple validation
+// errors returned by SendKFCardMsgRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SendKFCardMsgRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendKFCardMsgRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendKFCardMsgRequestMultiError) AllErrors() []error { return m }
+
+// SendKFCardMsgRequestValidationError is the validation error returned by
+// SendKFCardMsgRequest.Validate if the designated constraints aren't met.
+type SendKFCardMsgRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendKFCardMsgRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendKFCardMsgRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendKFCardMsgRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendKFCardMsgRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendKFCardMsgRequestValidationError) ErrorName() string {
+	return "SendKFCardMsgRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendKFCardMsgRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendKFCardMsgRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendKFCardMsgRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendKFCardMsgRequestValidationError{}
+
+// Validate checks the field values on SendKFMenuMsgRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendKFMenuMsgRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendKFMenuMsgRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendKFMenuMsgRequestMultiError, or nil if none found.
+func (m *SendKFMenuMsgRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendKFMenuMsgRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCommon() == nil {
+		err := SendKFMenuMsgRequestValidationError{
+			field:  "Common",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCommon()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendKFMenuMsgRequestValidationError{
+					field:  "Common",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendKFMenuMsgRequestValidationError{
+					field:  "Common",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCommon()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendKFMenuMsgRequestValidationError{
+				field:  "Common",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetMsgMenu() == nil {
+		err := SendKFMenuMsgRequestValidationError{
+			field:  "MsgMenu",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMsgMenu()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendKFMenuMsgRequestValidationError{
+					field:  "MsgMenu",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendKFMenuMsgRequestValidationError{
+					field:  "MsgMenu",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMsgMenu()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendKFMenuMsgRequestValidationError{
+				field:  "MsgMenu",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := SendKFMenuMsgRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	// no validation rules for Type
+
+	if len(errors) > 0 {
+		return SendKFMenuMsgRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendKFMenuMsgRequestMultiError is an error wrapping multiple validation
+// errors returned by SendKFMenuMsgRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SendKFMenuMsgRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendKFMenuMsgRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendKFMenuMsgRequestMultiError) AllErrors() []error { return m }
+
+// SendKFMenuMsgRequestValidationError is the validation error returned by
+// SendKFMenuMsgRequest.Validate if the designated constraints aren't met.
+type SendKFMenuMsgRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendKFMenuMsgRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendKFMenuMsgRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendKFMenuMsgRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendKFMenuMsgRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendKFMenuMsgRequestValidationError) ErrorName() string {
+	return "SendKFMenuMsgRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendKFMenuMsgRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendKFMenuMsgRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendKFMenuMsgRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendKFMenuMsgRequestValidationError{}
+
+// Validate checks the field values on SendKFToArticleMsgRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendKFToArticleMsgRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendKFToArticleMsgRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendKFToArticleMsgRequestMultiError, or nil if none found.
+func (m *SendKFToArticleMsgRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendKFToArticleMsgRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := SendKFToArticleMsgRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	// no validation rules for Type
+
+	if m.GetCommon() == nil {
+		err := SendKFToArticleMsgRequestValidationError{
+			field:  "Common",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCommon()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendKFToArticleMsgRequestValidationError{
+					field:  "Common",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendKFToArticleMsgRequestValidationError{
+					field:  "Common",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCommon()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendKFToArticleMsgRequestValidationError{
+				field:  "Common",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetMpNewsArticle() == nil {
+		err := SendKFToArticleMsgRequestValidationError{
+			field:  "MpNewsArticle",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMpNewsArticle()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendKFToArticleMsgRequestValidationError{
+					field:  "MpNewsArticle",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendKFToArticleMsgRequestValidationError{
+					field:  "MpNewsArticle",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMpNewsArticle()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendKFToArticleMsgRequestValidationError{
+				field:  "MpNewsArticle",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SendKFToArticleMsgRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendKFToArticleMsgRequestMultiError is an error wrapping multiple validation
+// errors returned by SendKFToArticleMsgRequest.ValidateAll() if the
+// designated constraints aren't met.
+type SendKFToArticleMsgRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendKFToArticleMsgRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendKFToArticleMsgRequestMultiError) AllErrors() []error { return m }
+
+// SendKFToArticleMsgRequestValidationError is the validation error returned by
+// SendKFToArticleMsgRequest.Validate if the designated constraints aren't met.
+type SendKFToArticleMsgRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendKFToArticleMsgRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendKFToArticleMsgRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendKFToArticleMsgRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendKFToArticleMsgRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendKFToArticleMsgRequestValidationError) ErrorName() string {
+	return "SendKFToArticleMsgRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendKFToArticleMsgRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendKFToArticleMsgRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendKFToArticleMsgRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendKFToArticleMsgRequestValidationError{}
+
+// Validate checks the field values on SendKFNewsPageMsgRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendKFNewsPageMsgRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendKFNewsPageMsgRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendKFNewsPageMsgRequestMultiError, or nil if none found.
+func (m *SendKFNewsPageMsgRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendKFNewsPageMsgRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := SendKFNewsPageMsgRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	// no validation rules for Type
+
+	if m.GetCommon() == nil {
+		err := SendKFNewsPageMsgRequestValidationError{
+			field:  "Common",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCommon()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendKFNewsPageMsgRequestValidationError{
+					field:  "Common",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendKFNewsPageMsgRequestValidationError{
+					field:  "Common",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCommon()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendKFNewsPageMsgRequestValidationError{
+				field:  "Common",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetMpNews() == nil {
+		err := SendKFNewsPageMsgRequestValidationError{
+			field:  "MpNews",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMpNews()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendKFNewsPageMsgRequestValidationError{
+					field:  "MpNews",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendKFNewsPageMsgRequestValidationError{
+					field:  "MpNews",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMpNews()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendKFNewsPageMsgRequestValidationError{
+				field:  "MpNews",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SendKFNewsPageMsgRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendKFNewsPageMsgRequestMultiError is an error wrapping multiple validation
+// errors returned by SendKFNewsPageMsgRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SendKFNewsPageMsgRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendKFNewsPageMsgRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendKFNewsPageMsgRequestMultiError) AllErrors() []error { return m }
+
+// SendKFNewsPageMsgRequestValidationError is the validation error returned by
+// SendKFNewsPageMsgRequest.Validate if the designated constraints aren't met.
+type SendKFNewsPageMsgRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendKFNewsPageMsgRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendKFNewsPageMsgRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendKFNewsPageMsgRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendKFNewsPageMsgRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendKFNewsPageMsgRequestValidationError) ErrorName() string {
+	return "SendKFNewsPageMsgRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendKFNewsPageMsgRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendKFNewsPageMsgRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendKFNewsPageMsgRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendKFNewsPageMsgRequestValidationError{}
+
+// Validate checks the field values on SendKFNewsCardMsgRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendKFNewsCardMsgRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendKFNewsCardMsgRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendKFNewsCardMsgRequestMultiError, or nil if none found.
+func (m *SendKFNewsCardMsgRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendKFNewsCardMsgRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := SendKFNewsCardMsgRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	// no validation rules for Type
+
+	if m.GetCommon() == nil {
+		err := SendKFNewsCardMsgRequestValidationError{
+			field:  "Common",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCommon()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendKFNewsCardMsgRequestValidationError{
+					field:  "Common",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendKFNewsCardMsgRequestValidationError{
+					field:  "Common",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCommon()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendKFNewsCardMsgRequestValidationError{
+				field:  "Common",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetNews() == nil {
+		err := SendKFNewsCardMsgRequestValidationError{
+			field:  "News",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetNews()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendKFNewsCardMsgRequestValidationError{
+					field:  "News",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendKFNewsCardMsgRequestValidationError{
+					field:  "News",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNews()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendKFNewsCardMsgRequestValidationError{
+				field:  "News",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SendKFNewsCardMsgRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendKFNewsCardMsgRequestMultiError is an error wrapping multiple validation
+// errors returned by SendKFNewsCardMsgRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SendKFNewsCardMsgRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendKFNewsCardMsgRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendKFNewsCardMsgRequestMultiError) AllErrors() []error { return m }
+
+// SendKFNewsCardMsgRequestValidationError is the validation error returned by
+// SendKFNewsCardMsgRequest.Validate if the designated constraints aren't met.
+type SendKFNewsCardMsgRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendKFNewsCardMsgRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendKFNewsCardMsgRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendKFNewsCardMsgRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendKFNewsCardMsgRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendKFNewsCardMsgRequestValidationError) ErrorName() string {
+	return "SendKFNewsCardMsgRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendKFNewsCardMsgRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendKFNewsCardMsgRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendKFNewsCardMsgRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendKFNewsCardMsgRequestValidationError{}
+
+// Validate checks the field values on SendKFMusicMsgRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendKFMusicMsgRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendKFMusicMsgRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendKFMusicMsgRequestMultiError, or nil if none found.
+func (m *SendKFMusicMsgRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendKFMusicMsgRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := SendKFMusicMsgRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	// no validation rules for Type
+
+	if m.GetCommon() == nil {
+		err := SendKFMusicMsgRequestValidationError{
+			field:  "Common",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCommon()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendKFMusicMsgRequestValidationError{
+					field:  "Common",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendKFMusicMsgRequestValidationError{
+					field:  "Common",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCommon()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendKFMusicMsgRequestValidationError{
+				field:  "Common",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetMusic() == nil {
+		err := SendKFMusicMsgRequestValidationError{
+			field:  "Music",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMusic()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendKFMusicMsgRequestValidationError{
+					field:  "Music",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendKFMusicMsgRequestValidationError{
+					field:  "Music",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMusic()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendKFMusicMsgRequestValidationError{
+				field:  "Music",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SendKFMusicMsgRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendKFMusicMsgRequestMultiError is an error wrapping multiple validation
+// errors returned by SendKFMusicMsgRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SendKFMusicMsgRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendKFMusicMsgRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendKFMusicMsgRequestMultiError) AllErrors() []error { return m }
+
+// SendKFMusicMsgRequestValidationError is the validation error returned by
+// SendKFMusicMsgRequest.Validate if the designated constraints aren't met.
+type SendKFMusicMsgRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendKFMusicMsgRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendKFMusicMsgRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendKFMusicMsgRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendKFMusicMsgRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendKFMusicMsgRequestValidationError) ErrorName() string {
+	return "SendKFMusicMsgRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendKFMusicMsgRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendKFMusicMsgRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendKFMusicMsgRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendKFMusicMsgRequestValidationError{}
+
+// Validate checks the field values on SendKFVideoMsgRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendKFVideoMsgRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendKFVideoMsgRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendKFVideoMsgRequestMultiError, or nil if none found.
+func (m *SendKFVideoMsgRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendKFVideoMsgRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := SendKFVideoMsgRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	// no validation rules for Type
+
+	if m.GetCommon() == nil {
+		err := SendKFVideoMsgRequestValidationError{
+			field:  "Common",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCommon()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendKFVideoMsgRequestValidationError{
+					field:  "Common",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendKFVideoMsgRequestValidationError{
+					field:  "Common",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCommon()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendKFVideoMsgRequestValidationError{
+				field:  "Common",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetVideo() == nil {
+		err := SendKFVideoMsgRequestValidationError{
+			field:  "Video",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetVideo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendKFVideoMsgRequestValidationError{
+					field:  "Video",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendKFVideoMsgRequestValidationError{
+					field:  "Video",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVideo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendKFVideoMsgRequestValidationError{
+				field:  "Video",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SendKFVideoMsgRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendKFVideoMsgRequestMultiError is an error wrapping multiple validation
+// errors returned by SendKFVideoMsgRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SendKFVideoMsgRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendKFVideoMsgRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendKFVideoMsgRequestMultiError) AllErrors() []error { return m }
+
+// SendKFVideoMsgRequestValidationError is the validation error returned by
+// SendKFVideoMsgRequest.Validate if the designated constraints aren't met.
+type SendKFVideoMsgRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendKFVideoMsgRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendKFVideoMsgRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendKFVideoMsgRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendKFVideoMsgRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendKFVideoMsgRequestValidationError) ErrorName() string {
+	return "SendKFVideoMsgRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendKFVideoMsgRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendKFVideoMsgRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendKFVideoMsgRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendKFVideoMsgRequestValidationError{}
+
+// Validate checks the field values on SendKFVoiceMsgRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendKFVoiceMsgRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendKFVoiceMsgRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendKFVoiceMsgRequestMultiError, or nil if none found.
+func (m *SendKFVoiceMsgRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendKFVoiceMsgRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := SendKFVoiceMsgRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	// no validation rules for Type
+
+	if m.GetCommon() == nil {
+		err := SendKFVoiceMsgRequestValidationError{
+			field:  "Common",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCommon()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendKFVoiceMsgRequestValidationError{
+					field:  "Common",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendKFVoiceMsgRequestValidationError{
+					field:  "Common",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCommon()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendKFVoiceMsgRequestValidationError{
+				field:  "Common",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetVoice() == nil {
+		err := SendKFVoiceMsgRequestValidationError{
+			field:  "Voice",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetVoice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendKFVoiceMsgRequestValidationError{
+					field:  "Voice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendKFVoiceMsgRequestValidationError{
+					field:  "Voice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVoice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendKFVoiceMsgRequestValidationError{
+				field:  "Voice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SendKFVoiceMsgRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendKFVoiceMsgRequestMultiError is an error wrapping multiple validation
+// errors returned by SendKFVoiceMsgRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SendKFVoiceMsgRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendKFVoiceMsgRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendKFVoiceMsgRequestMultiError) AllErrors() []error { return m }
+
+// SendKFVoiceMsgRequestValidationError is the validation error returned by
+// SendKFVoiceMsgRequest.Validate if the designated constraints aren't met.
+type SendKFVoiceMsgRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendKFVoiceMsgRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendKFVoiceMsgRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendKFVoiceMsgRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendKFVoiceMsgRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendKFVoiceMsgRequestValidationError) ErrorName() string {
+	return "SendKFVoiceMsgRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendKFVoiceMsgRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendKFVoiceMsgRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendKFVoiceMsgRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendKFVoiceMsgRequestValidationError{}
+
+// Validate checks the field values on SendKFImageMsgRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendKFImageMsgRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendKFImageMsgRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendKFImageMsgRequestMultiError, or nil if none found.
+func (m *SendKFImageMsgRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendKFImageMsgRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := SendKFImageMsgRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	// no validation rules for Type
+
+	if m.GetCommon() == nil {
+		err := SendKFImageMsgRequestValidationError{
+			field:  "Common",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCommon()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendKFImageMsgRequestValidationError{
+					field:  "Common",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendKFImageMsgRequestValidationError{
+					field:  "Common",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCommon()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendKFImageMsgRequestValidationError{
+				field:  "Common",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetImage() == nil {
+		err := SendKFImageMsgRequestValidationError{
+			field:  "Image",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetImage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendKFImageMsgRequestValidationError{
+					field:  "Image",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendKFImageMsgRequestValidationError{
+					field:  "Image",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetImage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendKFImageMsgRequestValidationError{
+				field:  "Image",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SendKFImageMsgRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendKFImageMsgRequestMultiError is an error wrapping multiple validation
+// errors returned by SendKFImageMsgRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SendKFImageMsgRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendKFImageMsgRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendKFImageMsgRequestMultiError) AllErrors() []error { return m }
+
+// SendKFImageMsgRequestValidationError is the validation error returned by
+// SendKFImageMsgRequest.Validate if the designated constraints aren't met.
+type SendKFImageMsgRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendKFImageMsgRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendKFImageMsgRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendKFImageMsgRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendKFImageMsgRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendKFImageMsgRequestValidationError) ErrorName() string {
+	return "SendKFImageMsgRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendKFImageMsgRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendKFImageMsgRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendKFImageMsgRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendKFImageMsgRequestValidationError{}
+
+// Validate checks the field values on KFMessageCommon with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *KFMessageCommon) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KFMessageCommon with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// KFMessageCommonMultiError, or nil if none found.
+func (m *KFMessageCommon) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KFMessageCommon) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToUser()) < 1 {
+		err := KFMessageCommonValidationError{
+			field:  "ToUser",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for MsgType
+
+	if all {
+		switch v := interface{}(m.GetCustomerService()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, KFMessageCommonValidationError{
+					field:  "CustomerService",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, KFMessageCommonValidationError{
+					field:  "CustomerService",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCustomerService()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return KFMessageCommonValidationError{
+				field:  "CustomerService",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return KFMessageCommonMultiError(errors)
+	}
+
+	return nil
+}
+
+// KFMessageCommonMultiError is an error wrapping multiple validation errors
+// returned by KFMessageCommon.ValidateAll() if the designated constraints
+// aren't met.
+type KFMessageCommonMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KFMessageCommonMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KFMessageCommonMultiError) AllErrors() []error { return m }
+
+// KFMessageCommonValidationError is the validation error returned by
+// KFMessageCommon.Validate if the designated constraints aren't met.
+type KFMessageCommonValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KFMessageCommonValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KFMessageCommonValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KFMessageCommonValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KFMessageCommonValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KFMessageCommonValidationError) ErrorName() string { return "KFMessageCommonValidationError" }
+
+// Error satisfies the builtin error interface
+func (e KFMessageCommonValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKFMessageCommon.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KFMessageCommonValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KFMessageCommonValidationError{}
+
+// Validate checks the field values on SendKFTextMsgRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendKFTextMsgRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendKFTextMsgRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendKFTextMsgRequestMultiError, or nil if none found.
+func (m *SendKFTextMsgRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendKFTextMsgRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := SendKFTextMsgRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	// no validation rules for Type
+
+	if m.GetCommon() == nil {
+		err := SendKFTextMsgRequestValidationError{
+			field:  "Common",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCommon()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendKFTextMsgRequestValidationError{
+					field:  "Common",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendKFTextMsgRequestValidationError{
+					field:  "Common",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCommon()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendKFTextMsgRequestValidationError{
+				field:  "Common",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetText() == nil {
+		err := SendKFTextMsgRequestValidationError{
+			field:  "Text",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetText()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendKFTextMsgRequestValidationError{
+					field:  "Text",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendKFTextMsgRequestValidationError{
+					field:  "Text",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetText()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendKFTextMsgRequestValidationError{
+				field:  "Text",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SendKFTextMsgRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendKFTextMsgRequestMultiError is an error wrapping multiple validation
+// errors returned by SendKFTextMsgRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SendKFTextMsgRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendKFTextMsgRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendKFTextMsgRequestMultiError) AllErrors() []error { return m }
+
+// SendKFTextMsgRequestValidationError is the validation error returned by
+// SendKFTextMsgRequest.Validate if the designated constraints aren't met.
+type SendKFTextMsgRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendKFTextMsgRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendKFTextMsgRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendKFTextMsgRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendKFTextMsgRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendKFTextMsgRequestValidationError) ErrorName() string {
+	return "SendKFTextMsgRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendKFTextMsgRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendKFTextMsgRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendKFTextMsgRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendKFTextMsgRequestValidationError{}
+
+// Validate checks the field values on NewKFSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NewKFSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NewKFSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NewKFSessionRequestMultiError, or nil if none found.
+func (m *NewKFSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NewKFSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := NewKFSessionRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	if utf8.RuneCountInString(m.GetOpenId()) < 1 {
+		err := NewKFSessionRequestValidationError{
+			field:  "OpenId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetKfAccount()) < 1 {
+		err := NewKFSessionRequestValidationError{
+			field:  "KfAccount",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return NewKFSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// NewKFSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by NewKFSessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type NewKFSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NewKFSessionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NewKFSessionRequestMultiError) AllErrors() []error { return m }
+
+// NewKFSessionRequestValidationError is the validation error returned by
+// NewKFSessionRequest.Validate if the designated constraints aren't met.
+type NewKFSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NewKFSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NewKFSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NewKFSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NewKFSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NewKFSessionRequestValidationError) ErrorName() string {
+	return "NewKFSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NewKFSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNewKFSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NewKFSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NewKFSessionRequestValidationError{}
+
+// Validate checks the field values on CloseKFSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CloseKFSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CloseKFSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CloseKFSessionRequestMultiError, or nil if none found.
+func (m *CloseKFSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CloseKFSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := CloseKFSessionRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	if utf8.RuneCountInString(m.GetOpenId()) < 1 {
+		err := CloseKFSessionRequestValidationError{
+			field:  "OpenId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetKfAccount()) < 1 {
+		err := CloseKFSessionRequestValidationError{
+			field:  "KfAccount",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CloseKFSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CloseKFSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by CloseKFSessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CloseKFSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CloseKFSessionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CloseKFSessionRequestMultiError) AllErrors() []error { return m }
+
+// CloseKFSessionRequestValidationError is the validation error returned by
+// CloseKFSessionRequest.Validate if the designated constraints aren't met.
+type CloseKFSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CloseKFSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CloseKFSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CloseKFSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CloseKFSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CloseKFSessionRequestValidationError) ErrorName() string {
+	return "CloseKFSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CloseKFSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCloseKFSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CloseKFSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CloseKFSessionRequestValidationError{}
+
+// Validate checks the field values on GetKFSessionUnacceptedReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetKFSessionUnacceptedReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetKFSessionUnacceptedReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetKFSessionUnacceptedReplyMultiError, or nil if none found.
+func (m *GetKFSessionUnacceptedReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetKFSessionUnacceptedReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	for idx, item := range m.GetWaitCaseList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetKFSessionUnacceptedReplyValidationError{
+						field:  fmt.Sprintf("WaitCaseList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetKFSessionUnacceptedReplyValidationError{
+						field:  fmt.Sprintf("WaitCaseList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetKFSessionUnacceptedReplyValidationError{
+					field:  fmt.Sprintf("WaitCaseList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetKFSessionUnacceptedReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetKFSessionUnacceptedReplyMultiError is an error wrapping multiple
+// validation errors returned by GetKFSessionUnacceptedReply.ValidateAll() if
+// the designated constraints aren't met.
+type GetKFSessionUnacceptedReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetKFSessionUnacceptedReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetKFSessionUnacceptedReplyMultiError) AllErrors() []error { return m }
+
+// GetKFSessionUnacceptedReplyValidationError is the validation error returned
+// by GetKFSessionUnacceptedReply.Validate if the designated constraints
+// aren't met.
+type GetKFSessionUnacceptedReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetKFSessionUnacceptedReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetKFSessionUnacceptedReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetKFSessionUnacceptedReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetKFSessionUnacceptedReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetKFSessionUnacceptedReplyValidationError) ErrorName() string {
+	return "GetKFSessionUnacceptedReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetKFSessionUnacceptedReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetKFSessionUnacceptedReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetKFSessionUnacceptedReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetKFSessionUnacceptedReplyValidationError{}
+
+// Validate checks the field values on GetKFSessionStatusReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetKFSessionStatusReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetKFSessionStatusReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetKFSessionStatusReplyMultiError, or nil if none found.
+func (m *GetKFSessionStatusReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetKFSessionStatusReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for KfAccount
+
+	// no validation rules for CreateTime
+
+	if len(errors) > 0 {
+		return GetKFSessionStatusReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetKFSessionStatusReplyMultiError is an error wrapping multiple validation
+// errors returned by GetKFSessionStatusReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetKFSessionStatusReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetKFSessionStatusReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetKFSessionStatusReplyMultiError) AllErrors() []error { return m }
+
+// GetKFSessionStatusReplyValidationError is the validation error returned by
+// GetKFSessionStatusReply.Validate if the designated constraints aren't met.
+type GetKFSessionStatusReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetKFSessionStatusReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetKFSessionStatusReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetKFSessionStatusReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetKFSessionStatusReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetKFSessionStatusReplyValidationError) ErrorName() string {
+	return "GetKFSessionStatusReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetKFSessionStatusReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetKFSessionStatusReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetKFSessionStatusReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetKFSessionStatusReplyValidationError{}
+
+// Validate checks the field values on GetKFSessionStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetKFSessionStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetKFSessionStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetKFSessionStatusRequestMultiError, or nil if none found.
+func (m *GetKFSessionStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetKFSessionStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := GetKFSessionStatusRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	if utf8.RuneCountInString(m.GetOpenId()) < 1 {
+		err := GetKFSessionStatusRequestValidationError{
+			field:  "OpenId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetKFSessionStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetKFSessionStatusRequestMultiError is an error wrapping multiple validation
+// errors returned by GetKFSessionStatusRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetKFSessionStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetKFSessionStatusRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetKFSessionStatusRequestMultiError) AllErrors() []error { return m }
+
+// GetKFSessionStatusRequestValidationError is the validation error returned by
+// GetKFSessionStatusRequest.Validate if the designated constraints aren't met.
+type GetKFSessionStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetKFSessionStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetKFSessionStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetKFSessionStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetKFSessionStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetKFSessionStatusRequestValidationError) ErrorName() string {
+	return "GetKFSessionStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetKFSessionStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetKFSessionStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetKFSessionStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetKFSessionStatusRequestValidationError{}
+
+// Validate checks the field values on GetKFSessionListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetKFSessionListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetKFSessionListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetKFSessionListReplyMultiError, or nil if none found.
+func (m *GetKFSessionListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetKFSessionListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSessionList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetKFSessionListReplyValidationError{
+						field:  fmt.Sprintf("SessionList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetKFSessionListReplyValidationError{
+						field:  fmt.Sprintf("SessionList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetKFSessionListReplyValidationError{
+					field:  fmt.Sprintf("SessionList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetKFSessionListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetKFSessionListReplyMultiError is an error wrapping multiple validation
+// errors returned by GetKFSessionListReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetKFSessionListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetKFSessionListReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetKFSessionListReplyMultiError) AllErrors() []error { return m }
+
+// GetKFSessionListReplyValidationError is the validation error returned by
+// GetKFSessionListReply.Validate if the designated constraints aren't met.
+type GetKFSessionListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetKFSessionListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetKFSessionListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetKFSessionListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetKFSessionListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetKFSessionListReplyValidationError) ErrorName() string {
+	return "GetKFSessionListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetKFSessionListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetKFSessionListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetKFSessionListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetKFSessionListReplyValidationError{}
+
+// Validate checks the field values on KFSession with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *KFSession) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KFSession with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in KFSessionMultiError, or nil
+// if none found.
+func (m *KFSession) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KFSession) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OpenId
+
+	// no validation rules for CreateTime
+
+	if len(errors) > 0 {
+		return KFSessionMultiError(errors)
+	}
+
+	return nil
+}
+
+// KFSessionMultiError is an error wrapping multiple validation errors returned
+// by KFSession.ValidateAll() if the designated constraints aren't met.
+type KFSessionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KFSessionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KFSessionMultiError) AllErrors() []error { return m }
+
+// KFSessionValidationError is the validation error returned by
+// KFSession.Validate if the designated constraints aren't met.
+type KFSessionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KFSessionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KFSessionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KFSessionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KFSessionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KFSessionValidationError) ErrorName() string { return "KFSessionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e KFSessionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKFSession.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KFSessionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KFSessionValidationError{}
+
+// Validate checks the field values on GetKFSessionListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetKFSessionListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetKFSessionListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetKFSessionListRequestMultiError, or nil if none found.
+func (m *GetKFSessionListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetKFSessionListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := GetKFSessionListRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	if utf8.RuneCountInString(m.GetKfAccount()) < 1 {
+		err := GetKFSessionListRequestValidationError{
+			field:  "KfAccount",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetKFSessionListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetKFSessionListRequestMultiError is an error wrapping multiple validation
+// errors returned by GetKFSessionListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetKFSessionListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetKFSessionListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetKFSessionListRequestMultiError) AllErrors() []error { return m }
+
+// GetKFSessionListRequestValidationError is the validation error returned by
+// GetKFSessionListRequest.Validate if the designated constraints aren't met.
+type GetKFSessionListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetKFSessionListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetKFSessionListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetKFSessionListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetKFSessionListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetKFSessionListRequestValidationError) ErrorName() string {
+	return "GetKFSessionListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetKFSessionListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetKFSessionListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetKFSessionListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetKFSessionListRequestValidationError{}
+
+// Validate checks the field values on UpdateKFTypingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateKFTypingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateKFTypingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateKFTypingRequestMultiError, or nil if none found.
+func (m *UpdateKFTypingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateKFTypingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := UpdateKFTypingRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	if utf8.RuneCountInString(m.GetTouser()) < 1 {
+		err := UpdateKFTypingRequestValidationError{
+			field:  "Touser",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _UpdateKFTypingRequest_Command_InLookup[m.GetCommand()]; !ok {
+		err := UpdateKFTypingRequestValidationError{
+			field:  "Command",
+			reason: "value must be in list [Typing CancelTyping]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateKFTypingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateKFTypingRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateKFTypingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateKFTypingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateKFTypingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateKFTypingRequestMultiError) AllErrors() []error { return m }
+
+// UpdateKFTypingRequestValidationError is the validation error returned by
+// UpdateKFTypingRequest.Validate if the designated constraints aren't met.
+type UpdateKFTypingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateKFTypingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateKFTypingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateKFTypingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateKFTypingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateKFTypingRequestValidationError) ErrorName() string {
+	return "UpdateKFTypingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateKFTypingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateKFTypingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateKFTypingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateKFTypingRequestValidationError{}
+
+var _UpdateKFTypingRequest_Command_InLookup = map[string]struct{}{
+	"Typing":       {},
+	"CancelTyping": {},
+}
+
+// Validate checks the field values on UpdateKFAvatarRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateKFAvatarRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateKFAvatarRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateKFAvatarRequestMultiError, or nil if none found.
+func (m *UpdateKFAvatarRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateKFAvatarRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := UpdateKFAvatarRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	if utf8.RuneCountInString(m.GetKfAccount()) < 1 {
+		err := UpdateKFAvatarRequestValidationError{
+			field:  "KfAccount",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAvatarMediaId()) < 1 {
+		err := UpdateKFAvatarRequestValidationError{
+			field:  "AvatarMediaId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateKFAvatarRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateKFAvatarRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateKFAvatarRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateKFAvatarRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateKFAvatarRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateKFAvatarRequestMultiError) AllErrors() []error { return m }
+
+// UpdateKFAvatarRequestValidationError is the validation error returned by
+// UpdateKFAvatarRequest.Validate if the designated constraints aren't met.
+type UpdateKFAvatarRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateKFAvatarRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateKFAvatarRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateKFAvatarRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateKFAvatarRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateKFAvatarRequestValidationError) ErrorName() string {
+	return "UpdateKFAvatarRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateKFAvatarRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateKFAvatarRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateKFAvatarRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateKFAvatarRequestValidationError{}
+
+// Validate checks the field values on InviteKFWorkerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InviteKFWorkerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InviteKFWorkerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InviteKFWorkerRequestMultiError, or nil if none found.
+func (m *InviteKFWorkerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InviteKFWorkerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := InviteKFWorkerRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	if utf8.RuneCountInString(m.GetKfAccount()) < 1 {
+		err := InviteKFWorkerRequestValidationError{
+			field:  "KfAccount",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetInviteWx()) < 1 {
+		err := InviteKFWorkerRequestValidationError{
+			field:  "InviteWx",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return InviteKFWorkerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InviteKFWorkerRequestMultiError is an error wrapping multiple validation
+// errors returned by InviteKFWorkerRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InviteKFWorkerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InviteKFWorkerRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InviteKFWorkerRequestMultiError) AllErrors() []error { return m }
+
+// InviteKFWorkerRequestValidationError is the validation error returned by
+// InviteKFWorkerRequest.Validate if the designated constraints aren't met.
+type InviteKFWorkerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InviteKFWorkerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InviteKFWorkerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InviteKFWorkerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InviteKFWorkerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InviteKFWorkerRequestValidationError) ErrorName() string {
+	return "InviteKFWorkerRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InviteKFWorkerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInviteKFWorkerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InviteKFWorkerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InviteKFWorkerRequestValidationError{}
+
+// Validate checks the field values on DelKFAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DelKFAccountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DelKFAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DelKFAccountRequestMultiError, or nil if none found.
+func (m *DelKFAccountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DelKFAccountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := DelKFAccountRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	if utf8.RuneCountInString(m.GetKfAccount()) < 1 {
+		err := DelKFAccountRequestValidationError{
+			field:  "KfAccount",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DelKFAccountRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DelKFAccountRequestMultiError is an error wrapping multiple validation
+// errors returned by DelKFAccountRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DelKFAccountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DelKFAccountRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DelKFAccountRequestMultiError) AllErrors() []error { return m }
+
+// DelKFAccountRequestValidationError is the validation error returned by
+// DelKFAccountRequest.Validate if the designated constraints aren't met.
+type DelKFAccountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DelKFAccountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DelKFAccountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DelKFAccountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DelKFAccountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DelKFAccountRequestValidationError) ErrorName() string {
+	return "DelKFAccountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DelKFAccountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDelKFAccountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DelKFAccountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DelKFAccountRequestValidationError{}
+
+// Validate checks the field values on UpdateKFAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateKFAccountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateKFAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateKFAccountRequestMultiError, or nil if none found.
+func (m *UpdateKFAccountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateKFAccountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := UpdateKFAccountRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	if utf8.RuneCountInString(m.GetKfAccount()) < 1 {
+		err := UpdateKFAccountRequestValidationError{
+			field:  "KfAccount",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Nickname
+
+	// no validation rules for Password
+
+	if len(errors) > 0 {
+		return UpdateKFAccountRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateKFAccountRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateKFAccountRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateKFAccountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateKFAccountRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateKFAccountRequestMultiError) AllErrors() []error { return m }
+
+// UpdateKFAccountRequestValidationError is the validation error returned by
+// UpdateKFAccountRequest.Validate if the designated constraints aren't met.
+type UpdateKFAccountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateKFAccountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateKFAccountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateKFAccountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateKFAccountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateKFAccountRequestValidationError) ErrorName() string {
+	return "UpdateKFAccountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateKFAccountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateKFAccountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateKFAccountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateKFAccountRequestValidationError{}
+
+// Validate checks the field values on AddKFAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddKFAccountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddKFAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddKFAccountRequestMultiError, or nil if none found.
+func (m *AddKFAccountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddKFAccountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := AddKFAccountRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	if utf8.RuneCountInString(m.GetKfAccount()) < 1 {
+		err := AddKFAccountRequestValidationError{
+			field:  "KfAccount",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Nickname
+
+	// no validation rules for Password
+
+	if len(errors) > 0 {
+		return AddKFAccountRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddKFAccountRequestMultiError is an error wrapping multiple validation
+// errors returned by AddKFAccountRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddKFAccountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddKFAccountRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddKFAccountRequestMultiError) AllErrors() []error { return m }
+
+// AddKFAccountRequestValidationError is the validation error returned by
+// AddKFAccountRequest.Validate if the designated constraints aren't met.
+type AddKFAccountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddKFAccountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddKFAccountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddKFAccountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddKFAccountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddKFAccountRequestValidationError) ErrorName() string {
+	return "AddKFAccountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddKFAccountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddKFAccountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddKFAccountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddKFAccountRequestValidationError{}
+
+// Validate checks the field values on GetKFMsgHistoryReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetKFMsgHistoryReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetKFMsgHistoryReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetKFMsgHistoryReplyMultiError, or nil if none found.
+func (m *GetKFMsgHistoryReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetKFMsgHistoryReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MsgId
+
+	// no validation rules for Number
+
+	for idx, item := range m.GetRecordList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetKFMsgHistoryReplyValidationError{
+						field:  fmt.Sprintf("RecordList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetKFMsgHistoryReplyValidationError{
+						field:  fmt.Sprintf("RecordList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetKFMsgHistoryReplyValidationError{
+					field:  fmt.Sprintf("RecordList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetKFMsgHistoryReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetKFMsgHistoryReplyMultiError is an error wrapping multiple validation
+// errors returned by GetKFMsgHistoryReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetKFMsgHistoryReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetKFMsgHistoryReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetKFMsgHistoryReplyMultiError) AllErrors() []error { return m }
+
+// GetKFMsgHistoryReplyValidationError is the validation error returned by
+// GetKFMsgHistoryReply.Validate if the designated constraints aren't met.
+type GetKFMsgHistoryReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetKFMsgHistoryReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetKFMsgHistoryReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetKFMsgHistoryReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetKFMsgHistoryReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetKFMsgHistoryReplyValidationError) ErrorName() string {
+	return "GetKFMsgHistoryReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetKFMsgHistoryReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetKFMsgHistoryReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetKFMsgHistoryReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetKFMsgHistoryReplyValidationError{}
+
+// Validate checks the field values on KFMsgHistory with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *KFMsgHistory) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KFMsgHistory with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in KFMsgHistoryMultiError, or
+// nil if none found.
+func (m *KFMsgHistory) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KFMsgHistory) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Worker
+
+	// no validation rules for OpenId
+
+	// no validation rules for Text
+
+	// no validation rules for Time
+
+	// no validation rules for OpCode
+
+	if len(errors) > 0 {
+		return KFMsgHistoryMultiError(errors)
+	}
+
+	return nil
+}
+
+// KFMsgHistoryMultiError is an error wrapping multiple validation errors
+// returned by KFMsgHistory.ValidateAll() if the designated constraints aren't met.
+type KFMsgHistoryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KFMsgHistoryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KFMsgHistoryMultiError) AllErrors() []error { return m }
+
+// KFMsgHistoryValidationError is the validation error returned by
+// KFMsgHistory.Validate if the designated constraints aren't met.
+type KFMsgHistoryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KFMsgHistoryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KFMsgHistoryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KFMsgHistoryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KFMsgHistoryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KFMsgHistoryValidationError) ErrorName() string { return "KFMsgHistoryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e KFMsgHistoryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKFMsgHistory.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KFMsgHistoryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KFMsgHistoryValidationError{}
+
+// Validate checks the field values on GetKFMsgHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetKFMsgHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetKFMsgHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetKFMsgHistoryRequestMultiError, or nil if none found.
+func (m *GetKFMsgHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetKFMsgHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := GetKFMsgHistoryRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	// no validation rules for MsgId
+
+	// no validation rules for Number
+
+	if len(errors) > 0 {
+		return GetKFMsgHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetKFMsgHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by GetKFMsgHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetKFMsgHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetKFMsgHistoryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetKFMsgHistoryRequestMultiError) AllErrors() []error { return m }
+
+// GetKFMsgHistoryRequestValidationError is the validation error returned by
+// GetKFMsgHistoryRequest.Validate if the designated constraints aren't met.
+type GetKFMsgHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetKFMsgHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetKFMsgHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetKFMsgHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetKFMsgHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetKFMsgHistoryRequestValidationError) ErrorName() string {
+	return "GetKFMsgHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetKFMsgHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetKFMsgHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetKFMsgHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetKFMsgHistoryRequestValidationError{}
+
+// Validate checks the field values on GetKFOnlineListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetKFOnlineListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetKFOnlineListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetKFOnlineListReplyMultiError, or nil if none found.
+func (m *GetKFOnlineListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetKFOnlineListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetKfOnlineList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetKFOnlineListReplyValidationError{
+						field:  fmt.Sprintf("KfOnlineList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetKFOnlineListReplyValidationError{
+						field:  fmt.Sprintf("KfOnlineList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetKFOnlineListReplyValidationError{
+					field:  fmt.Sprintf("KfOnlineList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetKFOnlineListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetKFOnlineListReplyMultiError is an error wrapping multiple validation
+// errors returned by GetKFOnlineListReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetKFOnlineListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetKFOnlineListReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetKFOnlineListReplyMultiError) AllErrors() []error { return m }
+
+// GetKFOnlineListReplyValidationError is the validation error returned by
+// GetKFOnlineListReply.Validate if the designated constraints aren't met.
+type GetKFOnlineListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetKFOnlineListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetKFOnlineListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetKFOnlineListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetKFOnlineListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetKFOnlineListReplyValidationError) ErrorName() string {
+	return "GetKFOnlineListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetKFOnlineListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetKFOnlineListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetKFOnlineListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetKFOnlineListReplyValidationError{}
+
+// Validate checks the field values on KFOnlineInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *KFOnlineInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KFOnlineInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in KFOnlineInfoMultiError, or
+// nil if none found.
+func (m *KFOnlineInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KFOnlineInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for KfAccount
+
+	// no validation rules for Status
+
+	// no validation rules for KfId
+
+	// no validation rules for AcceptedCase
+
+	if len(errors) > 0 {
+		return KFOnlineInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// KFOnlineInfoMultiError is an error wrapping multiple validation errors
+// returned by KFOnlineInfo.ValidateAll() if the designated constraints aren't met.
+type KFOnlineInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KFOnlineInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KFOnlineInfoMultiError) AllErrors() []error { return m }
+
+// KFOnlineInfoValidationError is the validation error returned by
+// KFOnlineInfo.Validate if the designated constraints aren't met.
+type KFOnlineInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KFOnlineInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KFOnlineInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KFOnlineInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KFOnlineInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KFOnlineInfoValidationError) ErrorName() string { return "KFOnlineInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e KFOnlineInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKFOnlineInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KFOnlineInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KFOnlineInfoValidationError{}
+
+// Validate checks the field values on GetKFListReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetKFListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetKFListReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetKFListReplyMultiError,
+// or nil if none found.
+func (m *GetKFListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetKFListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetKfList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetKFListReplyValidationError{
+						field:  fmt.Sprintf("KfList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetKFListReplyValidationError{
+						field:  fmt.Sprintf("KfList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetKFListReplyValidationError{
+					field:  fmt.Sprintf("KfList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetKFListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetKFListReplyMultiError is an error wrapping multiple validation errors
+// returned by GetKFListReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetKFListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetKFListReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetKFListReplyMultiError) AllErrors() []error { return m }
+
+// GetKFListReplyValidationError is the validation error returned by
+// GetKFListReply.Validate if the designated constraints aren't met.
+type GetKFListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetKFListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetKFListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetKFListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetKFListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetKFListReplyValidationError) ErrorName() string { return "GetKFListReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetKFListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetKFListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetKFListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetKFListReplyValidationError{}
+
+// Validate checks the field values on KeFuInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *KeFuInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KeFuInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in KeFuInfoMultiError, or nil
+// if none found.
+func (m *KeFuInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KeFuInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for KfAccount
+
+	// no validation rules for KfNick
+
+	// no validation rules for KfId
+
+	// no validation rules for KfWx
+
+	// no validation rules for KfHeadImgUrl
+
+	// no validation rules for InviteWx
+
+	// no validation rules for InviteStatus
+
+	// no validation rules for InviteExpireTime
+
+	if len(errors) > 0 {
+		return KeFuInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// KeFuInfoMultiError is an error wrapping multiple validation errors returned
+// by KeFuInfo.ValidateAll() if the designated constraints aren't met.
+type KeFuInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KeFuInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KeFuInfoMultiError) AllErrors() []error { return m }
+
+// KeFuInfoValidationError is the validation error returned by
+// KeFuInfo.Validate if the designated constraints aren't met.
+type KeFuInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KeFuInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KeFuInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KeFuInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KeFuInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KeFuInfoValidationError) ErrorName() string { return "KeFuInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e KeFuInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKeFuInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KeFuInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KeFuInfoValidationError{}
+
+// Validate checks the field values on SendSubscribeMessageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendSubscribeMessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendSubscribeMessageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendSubscribeMessageRequestMultiError, or nil if none found.
+func (m *SendSubscribeMessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendSubscribeMessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := SendSubscribeMessageRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	if utf8.RuneCountInString(m.GetTouser()) < 1 {
+		err := SendSubscribeMessageRequestValidationError{
+			field:  "Touser",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTemplateId()) < 1 {
+		err := SendSubscribeMessageRequestValidationError{
+			field:  "TemplateId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Page
+
+	{
+		sorted_keys := make([]string, len(m.GetData()))
+		i := 0
+		for key := range m.GetData() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetData()[key]
+			_ = val
+
+			// no validation rules for Data[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, SendSubscribeMessageRequestValidationError{
+							field:  fmt.Sprintf("Data[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, SendSubscribeMessageRequestValidationError{
+							field:  fmt.Sprintf("Data[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return SendSubscribeMessageRequestValidationError{
+						field:  fmt.Sprintf("Data[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMiniprogram()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendSubscribeMessageRequestValidationError{
+					field:  "Miniprogram",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendSubscribeMessageRequestValidationError{
+					field:  "Miniprogram",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMiniprogram()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendSubscribeMessageRequestValidationError{
+				field:  "Miniprogram",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SendSubscribeMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendSubscribeMessageRequestMultiError is an error wrapping multiple
+// validation errors returned by SendSubscribeMessageRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SendSubscribeMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendSubscribeMessageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendSubscribeMessageRequestMultiError) AllErrors() []error { return m }
+
+// SendSubscribeMessageRequestValidationError is the validation error returned
+// by SendSubscribeMessageRequest.Validate if the designated constraints
+// aren't met.
+type SendSubscribeMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendSubscribeMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendSubscribeMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendSubscribeMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendSubscribeMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendSubscribeMessageRequestValidationError) ErrorName() string {
+	return "SendSubscribeMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendSubscribeMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendSubscribeMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendSubscribeMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendSubscribeMessageRequestValidationError{}
+
+// Validate checks the field values on GetSubscribePrivateTplReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSubscribePrivateTplReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSubscribePrivateTplReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSubscribePrivateTplReplyMultiError, or nil if none found.
+func (m *GetSubscribePrivateTplReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSubscribePrivateTplReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSubscribePrivateTplReplyValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSubscribePrivateTplReplyValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSubscribePrivateTplReplyValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetSubscribePrivateTplReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSubscribePrivateTplReplyMultiError is an error wrapping multiple
+// validation errors returned by GetSubscribePrivateTplReply.ValidateAll() if
+// the designated constraints aren't met.
+type GetSubscribePrivateTplReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSubscribePrivateTplReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSubscribePrivateTplReplyMultiError) AllErrors() []error { return m }
+
+// GetSubscribePrivateTplReplyValidationError is the validation error returned
+// by GetSubscribePrivateTplReply.Validate if the designated constraints
+// aren't met.
+type GetSubscribePrivateTplReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSubscribePrivateTplReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSubscribePrivateTplReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSubscribePrivateTplReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSubscribePrivateTplReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSubscribePrivateTplReplyValidationError) ErrorName() string {
+	return "GetSubscribePrivateTplReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSubscribePrivateTplReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSubscribePrivateTplReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSubscribePrivateTplReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSubscribePrivateTplReplyValidationError{}
+
+// Validate checks the field values on GetSubscribeTplTitlesReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSubscribeTplTitlesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSubscribeTplTitlesReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSubscribeTplTitlesReplyMultiError, or nil if none found.
+func (m *GetSubscribeTplTitlesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSubscribeTplTitlesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSubscribeTplTitlesReplyValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSubscribeTplTitlesReplyValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSubscribeTplTitlesReplyValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetSubscribeTplTitlesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSubscribeTplTitlesReplyMultiError is an error wrapping multiple
+// validation errors returned by GetSubscribeTplTitlesReply.ValidateAll() if
+// the designated constraints aren't met.
+type GetSubscribeTplTitlesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSubscribeTplTitlesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSubscribeTplTitlesReplyMultiError) AllErrors() []error { return m }
+
+// GetSubscribeTplTitlesReplyValidationError is the validation error returned
+// by GetSubscribeTplTitlesReply.Validate if the designated constraints aren't met.
+type GetSubscribeTplTitlesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSubscribeTplTitlesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSubscribeTplTitlesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSubscribeTplTitlesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSubscribeTplTitlesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSubscribeTplTitlesReplyValidationError) ErrorName() string {
+	return "GetSubscribeTplTitlesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSubscribeTplTitlesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSubscribeTplTitlesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSubscribeTplTitlesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSubscribeTplTitlesReplyValidationError{}
+
+// Validate checks the field values on GetSubscribeTplTitlesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSubscribeTplTitlesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSubscribeTplTitlesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSubscribeTplTitlesRequestMultiError, or nil if none found.
+func (m *GetSubscribeTplTitlesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSubscribeTplTitlesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := GetSubscribeTplTitlesRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	// no validation rules for Ids
+
+	// no validation rules for Limit
+
+	// no validation rules for Start
+
+	if len(errors) > 0 {
+		return GetSubscribeTplTitlesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSubscribeTplTitlesRequestMultiError is an error wrapping multiple
+// validation errors returned by GetSubscribeTplTitlesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetSubscribeTplTitlesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSubscribeTplTitlesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSubscribeTplTitlesRequestMultiError) AllErrors() []error { return m }
+
+// GetSubscribeTplTitlesRequestValidationError is the validation error returned
+// by GetSubscribeTplTitlesRequest.Validate if the designated constraints
+// aren't met.
+type GetSubscribeTplTitlesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSubscribeTplTitlesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSubscribeTplTitlesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSubscribeTplTitlesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSubscribeTplTitlesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSubscribeTplTitlesRequestValidationError) ErrorName() string {
+	return "GetSubscribeTplTitlesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSubscribeTplTitlesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSubscribeTplTitlesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSubscribeTplTitlesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSubscribeTplTitlesRequestValidationError{}
+
+// Validate checks the field values on GetSubscribeTplKeywordsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSubscribeTplKeywordsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSubscribeTplKeywordsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSubscribeTplKeywordsReplyMultiError, or nil if none found.
+func (m *GetSubscribeTplKeywordsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSubscribeTplKeywordsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSubscribeTplKeywordsReplyValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSubscribeTplKeywordsReplyValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSubscribeTplKeywordsReplyValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetSubscribeTplKeywordsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSubscribeTplKeywordsReplyMultiError is an error wrapping multiple
+// validation errors returned by GetSubscribeTplKeywordsReply.ValidateAll() if
+// the designated constraints aren't met.
+type GetSubscribeTplKeywordsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSubscribeTplKeywordsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSubscribeTplKeywordsReplyMultiError) AllErrors() []error { return m }
+
+// GetSubscribeTplKeywordsReplyValidationError is the validation error returned
+// by GetSubscribeTplKeywordsReply.Validate if the designated constraints
+// aren't met.
+type GetSubscribeTplKeywordsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSubscribeTplKeywordsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSubscribeTplKeywordsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSubscribeTplKeywordsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSubscribeTplKeywordsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSubscribeTplKeywordsReplyValidationError) ErrorName() string {
+	return "GetSubscribeTplKeywordsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSubscribeTplKeywordsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSubscribeTplKeywordsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSubscribeTplKeywordsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSubscribeTplKeywordsReplyValidationError{}
+
+// Validate checks the field values on GetSubscribeTplKeywordsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSubscribeTplKeywordsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSubscribeTplKeywordsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetSubscribeTplKeywordsRequestMultiError, or nil if none found.
+func (m *GetSubscribeTplKeywordsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSubscribeTplKeywordsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := GetSubscribeTplKeywordsRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	if utf8.RuneCountInString(m.GetTemplateId()) < 1 {
+		err := GetSubscribeTplKeywordsRequestValidationError{
+			field:  "TemplateId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetSubscribeTplKeywordsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSubscribeTplKeywordsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetSubscribeTplKeywordsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetSubscribeTplKeywordsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSubscribeTplKeywordsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSubscribeTplKeywordsRequestMultiError) AllErrors() []error { return m }
+
+// GetSubscribeTplKeywordsRequestValidationError is the validation error
+// returned by GetSubscribeTplKeywordsRequest.Validate if the designated
+// constraints aren't met.
+type GetSubscribeTplKeywordsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSubscribeTplKeywordsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSubscribeTplKeywordsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSubscribeTplKeywordsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSubscribeTplKeywordsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSubscribeTplKeywordsRequestValidationError) ErrorName() string {
+	return "GetSubscribeTplKeywordsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSubscribeTplKeywordsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSubscribeTplKeywordsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSubscribeTplKeywordsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSubscribeTplKeywordsRequestValidationError{}
+
+// Validate checks the field values on GetSubscribeCategoryReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSubscribeCategoryReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSubscribeCategoryReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSubscribeCategoryReplyMultiError, or nil if none found.
+func (m *GetSubscribeCategoryReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSubscribeCategoryReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSubscribeCategoryReplyValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSubscribeCategoryReplyValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSubscribeCategoryReplyValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetSubscribeCategoryReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSubscribeCategoryReplyMultiError is an error wrapping multiple validation
+// errors returned by GetSubscribeCategoryReply.ValidateAll() if the
+// designated constraints aren't met.
+type GetSubscribeCategoryReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSubscribeCategoryReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSubscribeCategoryReplyMultiError) AllErrors() []error { return m }
+
+// GetSubscribeCategoryReplyValidationError is the validation error returned by
+// GetSubscribeCategoryReply.Validate if the designated constraints aren't met.
+type GetSubscribeCategoryReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSubscribeCategoryReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSubscribeCategoryReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSubscribeCategoryReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSubscribeCategoryReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSubscribeCategoryReplyValidationError) ErrorName() string {
+	return "GetSubscribeCategoryReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSubscribeCategoryReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSubscribeCategoryReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSubscribeCategoryReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSubscribeCategoryReplyValidationError{}
+
+// Validate checks the field values on DelSubscribeTplRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DelSubscribeTplRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DelSubscribeTplRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DelSubscribeTplRequestMultiError, or nil if none found.
+func (m *DelSubscribeTplRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DelSubscribeTplRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := DelSubscribeTplRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	if utf8.RuneCountInString(m.GetTemplateId()) < 1 {
+		err := DelSubscribeTplRequestValidationError{
+			field:  "TemplateId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DelSubscribeTplRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DelSubscribeTplRequestMultiError is an error wrapping multiple validation
+// errors returned by DelSubscribeTplRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DelSubscribeTplRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DelSubscribeTplRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DelSubscribeTplRequestMultiError) AllErrors() []error { return m }
+
+// DelSubscribeTplRequestValidationError is the validation error returned by
+// DelSubscribeTplRequest.Validate if the designated constraints aren't met.
+type DelSubscribeTplRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DelSubscribeTplRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DelSubscribeTplRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DelSubscribeTplRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DelSubscribeTplRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DelSubscribeTplRequestValidationError) ErrorName() string {
+	return "DelSubscribeTplRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DelSubscribeTplRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDelSubscribeTplRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DelSubscribeTplRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DelSubscribeTplRequestValidationError{}
+
+// Validate checks the field values on AddSubscribeTplRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddSubscribeTplRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddSubscribeTplRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddSubscribeTplRequestMultiError, or nil if none found.
+func (m *AddSubscribeTplRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddSubscribeTplRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := AddSubscribeTplRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	// no validation rules for Tid
+
+	// no validation rules for SceneDesc
+
+	if len(errors) > 0 {
+		return AddSubscribeTplRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddSubscribeTplRequestMultiError is an error wrapping multiple validation
+// errors returned by AddSubscribeTplRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddSubscribeTplRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddSubscribeTplRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddSubscribeTplRequestMultiError) AllErrors() []error { return m }
+
+// AddSubscribeTplRequestValidationError is the validation error returned by
+// AddSubscribeTplRequest.Validate if the designated constraints aren't met.
+type AddSubscribeTplRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddSubscribeTplRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddSubscribeTplRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddSubscribeTplRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddSubscribeTplRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddSubscribeTplRequestValidationError) ErrorName() string {
+	return "AddSubscribeTplRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddSubscribeTplRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddSubscribeTplRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddSubscribeTplRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddSubscribeTplRequestValidationError{}
+
+// Validate checks the field values on AddSubscribeTplReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddSubscribeTplReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddSubscribeTplReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddSubscribeTplReplyMultiError, or nil if none found.
+func (m *AddSubscribeTplReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddSubscribeTplReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TemplateId
+
+	if len(errors) > 0 {
+		return AddSubscribeTplReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddSubscribeTplReplyMultiError is an error wrapping multiple validation
+// errors returned by AddSubscribeTplReply.ValidateAll() if the designated
+// constraints aren't met.
+type AddSubscribeTplReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddSubscribeTplReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddSubscribeTplReplyMultiError) AllErrors() []error { return m }
+
+// AddSubscribeTplReplyValidationError is the validation error returned by
+// AddSubscribeTplReply.Validate if the designated constraints aren't met.
+type AddSubscribeTplReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddSubscribeTplReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddSubscribeTplReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddSubscribeTplReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddSubscribeTplReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddSubscribeTplReplyValidationError) ErrorName() string {
+	return "AddSubscribeTplReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddSubscribeTplReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddSubscribeTplReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddSubscribeTplReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddSubscribeTplReplyValidationError{}
+
+// Validate checks the field values on GetBlockedTplRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBlockedTplRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBlockedTplRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBlockedTplRequestMultiError, or nil if none found.
+func (m *GetBlockedTplRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBlockedTplRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := GetBlockedTplRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	// no validation rules for TmplMsgId
+
+	// no validation rules for LargestId
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return GetBlockedTplRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBlockedTplRequestMultiError is an error wrapping multiple validation
+// errors returned by GetBlockedTplRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetBlockedTplRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBlockedTplRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBlockedTplRequestMultiError) AllErrors() []error { return m }
+
+// GetBlockedTplRequestValidationError is the validation error returned by
+// GetBlockedTplRequest.Validate if the designated constraints aren't met.
+type GetBlockedTplRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBlockedTplRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBlockedTplRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBlockedTplRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBlockedTplRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBlockedTplRequestValidationError) ErrorName() string {
+	return "GetBlockedTplRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBlockedTplRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBlockedTplRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBlockedTplRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBlockedTplRequestValidationError{}
+
+// Validate checks the field values on GetBlockedTplMsgReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBlockedTplMsgReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBlockedTplMsgReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBlockedTplMsgReplyMultiError, or nil if none found.
+func (m *GetBlockedTplMsgReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBlockedTplMsgReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMsginfo() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetBlockedTplMsgReplyValidationError{
+						field:  fmt.Sprintf("Msginfo[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetBlockedTplMsgReplyValidationError{
+						field:  fmt.Sprintf("Msginfo[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetBlockedTplMsgReplyValidationError{
+					field:  fmt.Sprintf("Msginfo[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetBlockedTplMsgReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBlockedTplMsgReplyMultiError is an error wrapping multiple validation
+// errors returned by GetBlockedTplMsgReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetBlockedTplMsgReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBlockedTplMsgReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBlockedTplMsgReplyMultiError) AllErrors() []error { return m }
+
+// GetBlockedTplMsgReplyValidationError is the validation error returned by
+// GetBlockedTplMsgReply.Validate if the designated constraints aren't met.
+type GetBlockedTplMsgReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBlockedTplMsgReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBlockedTplMsgReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBlockedTplMsgReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBlockedTplMsgReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBlockedTplMsgReplyValidationError) ErrorName() string {
+	return "GetBlockedTplMsgReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBlockedTplMsgReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBlockedTplMsgReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBlockedTplMsgReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBlockedTplMsgReplyValidationError{}
+
+// Validate checks the field values on SendSubscribeMsgRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendSubscribeMsgRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendSubscribeMsgRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendSubscribeMsgRequestMultiError, or nil if none found.
+func (m *SendSubscribeMsgRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendSubscribeMsgRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := SendSubscribeMsgRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	if utf8.RuneCountInString(m.GetTouser()) < 1 {
+		err := SendSubscribeMsgRequestValidationError{
+			field:  "Touser",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTemplateId()) < 1 {
+		err := SendSubscribeMsgRequestValidationError{
+			field:  "TemplateId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Url
+
+	// no validation rules for ClientMsgId
+
+	{
+		sorted_keys := make([]string, len(m.GetData()))
+		i := 0
+		for key := range m.GetData() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetData()[key]
+			_ = val
+
+			// no validation rules for Data[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, SendSubscribeMsgRequestValidationError{
+							field:  fmt.Sprintf("Data[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, SendSubscribeMsgRequestValidationError{
+							field:  fmt.Sprintf("Data[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return SendSubscribeMsgRequestValidationError{
+						field:  fmt.Sprintf("Data[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMiniprogram()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendSubscribeMsgRequestValidationError{
+					field:  "Miniprogram",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendSubscribeMsgRequestValidationError{
+					field:  "Miniprogram",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMiniprogram()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendSubscribeMsgRequestValidationError{
+				field:  "Miniprogram",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Scene
+
+	// no validation rules for Title
+
+	if len(errors) > 0 {
+		return SendSubscribeMsgRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendSubscribeMsgRequestMultiError is an error wrapping multiple validation
+// errors returned by SendSubscribeMsgRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SendSubscribeMsgRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendSubscribeMsgRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendSubscribeMsgRequestMultiError) AllErrors() []error { return m }
+
+// SendSubscribeMsgRequestValidationError is the validation error returned by
+// SendSubscribeMsgRequest.Validate if the designated constraints aren't met.
+type SendSubscribeMsgRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendSubscribeMsgRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendSubscribeMsgRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendSubscribeMsgRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendSubscribeMsgRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendSubscribeMsgRequestValidationError) ErrorName() string {
+	return "SendSubscribeMsgRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendSubscribeMsgRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendSubscribeMsgRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendSubscribeMsgRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendSubscribeMsgRequestValidationError{}
+
+// Validate checks the field values on SendTplMsgReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SendTplMsgReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendTplMsgReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendTplMsgReplyMultiError, or nil if none found.
+func (m *SendTplMsgReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendTplMsgReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Msgid
+
+	if len(errors) > 0 {
+		return SendTplMsgReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendTplMsgReplyMultiError is an error wrapping multiple validation errors
+// returned by SendTplMsgReply.ValidateAll() if the designated constraints
+// aren't met.
+type SendTplMsgReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendTplMsgReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendTplMsgReplyMultiError) AllErrors() []error { return m }
+
+// SendTplMsgReplyValidationError is the validation error returned by
+// SendTplMsgReply.Validate if the designated constraints aren't met.
+type SendTplMsgReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendTplMsgReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendTplMsgReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendTplMsgReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendTplMsgReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendTplMsgReplyValidationError) ErrorName() string { return "SendTplMsgReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SendTplMsgReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendTplMsgReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendTplMsgReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendTplMsgReplyValidationError{}
+
+// Validate checks the field values on SendTplMsgRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SendTplMsgRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendTplMsgRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendTplMsgRequestMultiError, or nil if none found.
+func (m *SendTplMsgRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendTplMsgRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := SendTplMsgRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	if utf8.RuneCountInString(m.GetTouser()) < 1 {
+		err := SendTplMsgRequestValidationError{
+			field:  "Touser",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTemplateId()) < 1 {
+		err := SendTplMsgRequestValidationError{
+			field:  "TemplateId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Url
+
+	// no validation rules for ClientMsgId
+
+	{
+		sorted_keys := make([]string, len(m.GetData()))
+		i := 0
+		for key := range m.GetData() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetData()[key]
+			_ = val
+
+			// no validation rules for Data[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, SendTplMsgRequestValidationError{
+							field:  fmt.Sprintf("Data[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, SendTplMsgRequestValidationError{
+							field:  fmt.Sprintf("Data[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return SendTplMsgRequestValidationError{
+						field:  fmt.Sprintf("Data[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMiniprogram()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendTplMsgRequestValidationError{
+					field:  "Miniprogram",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendTplMsgRequestValidationError{
+					field:  "Miniprogram",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMiniprogram()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendTplMsgRequestValidationError{
+				field:  "Miniprogram",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SendTplMsgRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendTplMsgRequestMultiError is an error wrapping multiple validation errors
+// returned by SendTplMsgRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SendTplMsgRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendTplMsgRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendTplMsgRequestMultiError) AllErrors() []error { return m }
+
+// SendTplMsgRequestValidationError is the validation error returned by
+// SendTplMsgRequest.Validate if the designated constraints aren't met.
+type SendTplMsgRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendTplMsgRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendTplMsgRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendTplMsgRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendTplMsgRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendTplMsgRequestValidationError) ErrorName() string {
+	return "SendTplMsgRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendTplMsgRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendTplMsgRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendTplMsgRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendTplMsgRequestValidationError{}
+
+// Validate checks the field values on MiniProgram with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MiniProgram) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MiniProgram with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MiniProgramMultiError, or
+// nil if none found.
+func (m *MiniProgram) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MiniProgram) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Appid
+
+	// no validation rules for PagePath
+
+	if len(errors) > 0 {
+		return MiniProgramMultiError(errors)
+	}
+
+	return nil
+}
+
+// MiniProgramMultiError is an error wrapping multiple validation errors
+// returned by MiniProgram.ValidateAll() if the designated constraints aren't met.
+type MiniProgramMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MiniProgramMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MiniProgramMultiError) AllErrors() []error { return m }
+
+// MiniProgramValidationError is the validation error returned by
+// MiniProgram.Validate if the designated constraints aren't met.
+type MiniProgramValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MiniProgramValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MiniProgramValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MiniProgramValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MiniProgramValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MiniProgramValidationError) ErrorName() string { return "MiniProgramValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MiniProgramValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMiniProgram.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MiniProgramValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MiniProgramValidationError{}
+
+// Validate checks the field values on DeleteMessageTplRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteMessageTplRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteMessageTplRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteMessageTplRequestMultiError, or nil if none found.
+func (m *DeleteMessageTplRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteMessageTplRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := DeleteMessageTplRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	if utf8.RuneCountInString(m.GetTemplateId()) < 1 {
+		err := DeleteMessageTplRequestValidationError{
+			field:  "TemplateId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteMessageTplRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteMessageTplRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteMessageTplRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteMessageTplRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteMessageTplRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteMessageTplRequestMultiError) AllErrors() []error { return m }
+
+// DeleteMessageTplRequestValidationError is the validation error returned by
+// DeleteMessageTplRequest.Validate if the designated constraints aren't met.
+type DeleteMessageTplRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteMessageTplRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteMessageTplRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteMessageTplRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteMessageTplRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteMessageTplRequestValidationError) ErrorName() string {
+	return "DeleteMessageTplRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteMessageTplRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteMessageTplRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteMessageTplRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteMessageTplRequestValidationError{}
+
+// Validate checks the field values on AddTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddTemplateRequestMultiError, or nil if none found.
+func (m *AddTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := AddTemplateRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	// no validation rules for TemplateIdShort
+
+	if len(errors) > 0 {
+		return AddTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddTemplateRequestMultiError is an error wrapping multiple validation errors
+// returned by AddTemplateRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddTemplateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddTemplateRequestMultiError) AllErrors() []error { return m }
+
+// AddTemplateRequestValidationError is the validation error returned by
+// AddTemplateRequest.Validate if the designated constraints aren't met.
+type AddTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddTemplateRequestValidationError) ErrorName() string {
+	return "AddTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddTemplateRequestValidationError{}
+
+// Validate checks the field values on AddMessageTplReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddMessageTplReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddMessageTplReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddMessageTplReplyMultiError, or nil if none found.
+func (m *AddMessageTplReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddMessageTplReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TemplateId
+
+	if len(errors) > 0 {
+		return AddMessageTplReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddMessageTplReplyMultiError is an error wrapping multiple validation errors
+// returned by AddMessageTplReply.ValidateAll() if the designated constraints
+// aren't met.
+type AddMessageTplReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddMessageTplReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddMessageTplReplyMultiError) AllErrors() []error { return m }
+
+// AddMessageTplReplyValidationError is the validation error returned by
+// AddMessageTplReply.Validate if the designated constraints aren't met.
+type AddMessageTplReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddMessageTplReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddMessageTplReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddMessageTplReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddMessageTplReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddMessageTplReplyValidationError) ErrorName() string {
+	return "AddMessageTplReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddMessageTplReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddMessageTplReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddMessageTplReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddMessageTplReplyValidationError{}
+
+// Validate checks the field values on GetAllPrivateTplReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAllPrivateTplReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAllPrivateTplReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAllPrivateTplReplyMultiError, or nil if none found.
+func (m *GetAllPrivateTplReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAllPrivateTplReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTemplateList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAllPrivateTplReplyValidationError{
+						field:  fmt.Sprintf("TemplateList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAllPrivateTplReplyValidationError{
+						field:  fmt.Sprintf("TemplateList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAllPrivateTplReplyValidationError{
+					field:  fmt.Sprintf("TemplateList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetAllPrivateTplReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAllPrivateTplReplyMultiError is an error wrapping multiple validation
+// errors returned by GetAllPrivateTplReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetAllPrivateTplReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAllPrivateTplReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAllPrivateTplReplyMultiError) AllErrors() []error { return m }
+
+// GetAllPrivateTplReplyValidationError is the validation error returned by
+// GetAllPrivateTplReply.Validate if the designated constraints aren't met.
+type GetAllPrivateTplReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAllPrivateTplReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAllPrivateTplReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAllPrivateTplReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAllPrivateTplReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAllPrivateTplReplyValidationError) ErrorName() string {
+	return "GetAllPrivateTplReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAllPrivateTplReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAllPrivateTplReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAllPrivateTplReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAllPrivateTplReplyValidationError{}
+
+// Validate checks the field values on SetIndustryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetIndustryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetIndustryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetIndustryRequestMultiError, or nil if none found.
+func (m *SetIndustryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetIndustryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := SetIndustryRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	// no validation rules for IndustryId1
+
+	// no validation rules for IndustryId2
+
+	if len(errors) > 0 {
+		return SetIndustryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetIndustryRequestMultiError is an error wrapping multiple validation errors
+// returned by SetIndustryRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SetIndustryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetIndustryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetIndustryRequestMultiError) AllErrors() []error { return m }
+
+// SetIndustryRequestValidationError is the validation error returned by
+// SetIndustryRequest.Validate if the designated constraints aren't met.
+type SetIndustryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetIndustryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetIndustryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetIndustryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetIndustryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetIndustryRequestValidationError) ErrorName() string {
+	return "SetIndustryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetIndustryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetIndustryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetIndustryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetIndustryRequestValidationError{}
+
+// Validate checks the field values on GetIndustryReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetIndustryReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetIndustryReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetIndustryReplyMultiError, or nil if none found.
+func (m *GetIndustryReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetIndustryReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPrimaryIndustry()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetIndustryReplyValidationError{
+					field:  "PrimaryIndustry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetIndustryReplyValidationError{
+					field:  "PrimaryIndustry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrimaryIndustry()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetIndustryReplyValidationError{
+				field:  "PrimaryIndustry",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSecondaryIndustry()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetIndustryReplyValidationError{
+					field:  "SecondaryIndustry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetIndustryReplyValidationError{
+					field:  "SecondaryIndustry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecondaryIndustry()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetIndustryReplyValidationError{
+				field:  "SecondaryIndustry",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetIndustryReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetIndustryReplyMultiError is an error wrapping multiple validation errors
+// returned by GetIndustryReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetIndustryReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetIndustryReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetIndustryReplyMultiError) AllErrors() []error { return m }
+
+// GetIndustryReplyValidationError is the validation error returned by
+// GetIndustryReply.Validate if the designated constraints aren't met.
+type GetIndustryReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetIndustryReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetIndustryReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetIndustryReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetIndustryReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetIndustryReplyValidationError) ErrorName() string { return "GetIndustryReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetIndustryReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetIndustryReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetIndustryReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetIndustryReplyValidationError{}
+
+// Validate checks the field values on DeleteConditionalMenuRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteConditionalMenuRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteConditionalMenuRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteConditionalMenuRequestMultiError, or nil if none found.
+func (m *DeleteConditionalMenuRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteConditionalMenuRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := DeleteConditionalMenuRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	// no validation rules for Menuid
+
+	if len(errors) > 0 {
+		return DeleteConditionalMenuRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteConditionalMenuRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteConditionalMenuRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteConditionalMenuRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteConditionalMenuRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteConditionalMenuRequestMultiError) AllErrors() []error { return m }
+
+// DeleteConditionalMenuRequestValidationError is the validation error returned
+// by DeleteConditionalMenuRequest.Validate if the designated constraints
+// aren't met.
+type DeleteConditionalMenuRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteConditionalMenuRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteConditionalMenuRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteConditionalMenuRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteConditionalMenuRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteConditionalMenuRequestValidationError) ErrorName() string {
+	return "DeleteConditionalMenuRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteConditionalMenuRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteConditionalMenuRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteConditionalMenuRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteConditionalMenuRequestValidationError{}
+
+// Validate checks the field values on CreateMenuRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateMenuRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateMenuRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateMenuRequestMultiError, or nil if none found.
+func (m *CreateMenuRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateMenuRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := CreateMenuRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	if len(m.GetButton()) < 1 {
+		err := CreateMenuRequestValidationError{
+			field:  "Button",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetButton() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateMenuRequestValidationError{
+						field:  fmt.Sprintf("Button[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateMenuRequestValidationError{
+						field:  fmt.Sprintf("Button[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateMenuRequestValidationError{
+					field:  fmt.Sprintf("Button[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetMatchrule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateMenuRequestValidationError{
+					field:  "Matchrule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateMenuRequestValidationError{
+					field:  "Matchrule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMatchrule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateMenuRequestValidationError{
+				field:  "Matchrule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateMenuRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateMenuRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateMenuRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateMenuRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateMenuRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateMenuRequestMultiError) AllErrors() []error { return m }
+
+// CreateMenuRequestValidationError is the validation error returned by
+// CreateMenuRequest.Validate if the designated constraints aren't met.
+type CreateMenuRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateMenuRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateMenuRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateMenuRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateMenuRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateMenuRequestValidationError) ErrorName() string {
+	return "CreateMenuRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateMenuRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateMenuRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateMenuRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateMenuRequestValidationError{}
+
+// Validate checks the field values on SelfMenuReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SelfMenuReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SelfMenuReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SelfMenuReplyMultiError, or
+// nil if none found.
+func (m *SelfMenuReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SelfMenuReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IsMenuOpen
+
+	if all {
+		switch v := interface{}(m.GetSelfmenuInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SelfMenuReplyValidationError{
+					field:  "SelfmenuInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SelfMenuReplyValidationError{
+					field:  "SelfmenuInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSelfmenuInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SelfMenuReplyValidationError{
+				field:  "SelfmenuInfo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SelfMenuReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SelfMenuReplyMultiError is an error wrapping multiple validation errors
+// returned by SelfMenuReply.ValidateAll() if the designated constraints
+// aren't met.
+type SelfMenuReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SelfMenuReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SelfMenuReplyMultiError) AllErrors() []error { return m }
+
+// SelfMenuReplyValidationError is the validation error returned by
+// SelfMenuReply.Validate if the designated constraints aren't met.
+type SelfMenuReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SelfMenuReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SelfMenuReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SelfMenuReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SelfMenuReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SelfMenuReplyValidationError) ErrorName() string { return "SelfMenuReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SelfMenuReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSelfMenuReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SelfMenuReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SelfMenuReplyValidationError{}
+
+// Validate checks the field values on SelfMenuButton with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SelfMenuButton) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SelfMenuButton with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SelfMenuButtonMultiError,
+// or nil if none found.
+func (m *SelfMenuButton) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SelfMenuButton) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Name
+
+	// no validation rules for Key
+
+	// no validation rules for Url
+
+	// no validation rules for Value
+
+	if all {
+		switch v := interface{}(m.GetSubButton()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SelfMenuButtonValidationError{
+					field:  "SubButton",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SelfMenuButtonValidationError{
+					field:  "SubButton",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubButton()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SelfMenuButtonValidationError{
+				field:  "SubButton",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetNewsInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SelfMenuButtonValidationError{
+					field:  "NewsInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SelfMenuButtonValidationError{
+					field:  "NewsInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNewsInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SelfMenuButtonValidationError{
+				field:  "NewsInfo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SelfMenuButtonMultiError(errors)
+	}
+
+	return nil
+}
+
+// SelfMenuButtonMultiError is an error wrapping multiple validation errors
+// returned by SelfMenuButton.ValidateAll() if the designated constraints
+// aren't met.
+type SelfMenuButtonMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SelfMenuButtonMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SelfMenuButtonMultiError) AllErrors() []error { return m }
+
+// SelfMenuButtonValidationError is the validation error returned by
+// SelfMenuButton.Validate if the designated constraints aren't met.
+type SelfMenuButtonValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SelfMenuButtonValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SelfMenuButtonValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SelfMenuButtonValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SelfMenuButtonValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SelfMenuButtonValidationError) ErrorName() string { return "SelfMenuButtonValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SelfMenuButtonValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSelfMenuButton.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SelfMenuButtonValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SelfMenuButtonValidationError{}
+
+// Validate checks the field values on NewsButton with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *NewsButton) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NewsButton with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NewsButtonMultiError, or
+// nil if none found.
+func (m *NewsButton) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NewsButton) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	// no validation rules for Author
+
+	// no validation rules for Digest
+
+	// no validation rules for CoverUrl
+
+	// no validation rules for ContentUrl
+
+	// no validation rules for SourceUrl
+
+	// no validation rules for ShowCover
+
+	if len(errors) > 0 {
+		return NewsButtonMultiError(errors)
+	}
+
+	return nil
+}
+
+// NewsButtonMultiError is an error wrapping multiple validation errors
+// returned by NewsButton.ValidateAll() if the designated constraints aren't met.
+type NewsButtonMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NewsButtonMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NewsButtonMultiError) AllErrors() []error { return m }
+
+// NewsButtonValidationError is the validation error returned by
+// NewsButton.Validate if the designated constraints aren't met.
+type NewsButtonValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NewsButtonValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NewsButtonValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NewsButtonValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NewsButtonValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NewsButtonValidationError) ErrorName() string { return "NewsButtonValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NewsButtonValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNewsButton.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NewsButtonValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NewsButtonValidationError{}
+
+// Validate checks the field values on TryMatchMenuRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TryMatchMenuRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TryMatchMenuRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TryMatchMenuRequestMultiError, or nil if none found.
+func (m *TryMatchMenuRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TryMatchMenuRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := TryMatchMenuRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return TryMatchMenuRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TryMatchMenuRequestMultiError is an error wrapping multiple validation
+// errors returned by TryMatchMenuRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TryMatchMenuRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TryMatchMenuRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TryMatchMenuRequestMultiError) AllErrors() []error { return m }
+
+// TryMatchMenuRequestValidationError is the validation error returned by
+// TryMatchMenuRequest.Validate if the designated constraints aren't met.
+type TryMatchMenuRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TryMatchMenuRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TryMatchMenuRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TryMatchMenuRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TryMatchMenuRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TryMatchMenuRequestValidationError) ErrorName() string {
+	return "TryMatchMenuRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TryMatchMenuRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTryMatchMenuRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TryMatchMenuRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TryMatchMenuRequestValidationError{}
+
+// Validate checks the field values on TryMatchMenuReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TryMatchMenuReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TryMatchMenuReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TryMatchMenuReplyMultiError, or nil if none found.
+func (m *TryMatchMenuReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TryMatchMenuReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetButton() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TryMatchMenuReplyValidationError{
+						field:  fmt.Sprintf("Button[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TryMatchMenuReplyValidationError{
+						field:  fmt.Sprintf("Button[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TryMatchMenuReplyValidationError{
+					field:  fmt.Sprintf("Button[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TryMatchMenuReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// TryMatchMenuReplyMultiError is an error wrapping multiple validation errors
+// returned by TryMatchMenuReply.ValidateAll() if the designated constraints
+// aren't met.
+type TryMatchMenuReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TryMatchMenuReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TryMatchMenuReplyMultiError) AllErrors() []error { return m }
+
+// TryMatchMenuReplyValidationError is the validation error returned by
+// TryMatchMenuReply.Validate if the designated constraints aren't met.
+type TryMatchMenuReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TryMatchMenuReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TryMatchMenuReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TryMatchMenuReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TryMatchMenuReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TryMatchMenuReplyValidationError) ErrorName() string {
+	return "TryMatchMenuReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TryMatchMenuReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTryMatchMenuReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TryMatchMenuReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TryMatchMenuReplyValidationError{}
+
+// Validate checks the field values on MenuInfoReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MenuInfoReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MenuInfoReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MenuInfoReplyMultiError, or
+// nil if none found.
+func (m *MenuInfoReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MenuInfoReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMenu()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MenuInfoReplyValidationError{
+					field:  "Menu",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MenuInfoReplyValidationError{
+					field:  "Menu",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMenu()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MenuInfoReplyValidationError{
+				field:  "Menu",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetConditionalmenu() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MenuInfoReplyValidationError{
+						field:  fmt.Sprintf("Conditionalmenu[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MenuInfoReplyValidationError{
+						field:  fmt.Sprintf("Conditionalmenu[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MenuInfoReplyValidationError{
+					field:  fmt.Sprintf("Conditionalmenu[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MenuInfoReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// MenuInfoReplyMultiError is an error wrapping multiple validation errors
+// returned by MenuInfoReply.ValidateAll() if the designated constraints
+// aren't met.
+type MenuInfoReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MenuInfoReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MenuInfoReplyMultiError) AllErrors() []error { return m }
+
+// MenuInfoReplyValidationError is the validation error returned by
+// MenuInfoReply.Validate if the designated constraints aren't met.
+type MenuInfoReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MenuInfoReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MenuInfoReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MenuInfoReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MenuInfoReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MenuInfoReplyValidationError) ErrorName() string { return "MenuInfoReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MenuInfoReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMenuInfoReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MenuInfoReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MenuInfoReplyValidationError{}
+
+// Validate checks the field values on MenuButton with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MenuButton) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MenuButton with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MenuButtonMultiError, or
+// nil if none found.
+func (m *MenuButton) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MenuButton) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Name
+
+	// no validation rules for Key
+
+	// no validation rules for Url
+
+	// no validation rules for MediaId
+
+	// no validation rules for AppId
+
+	// no validation rules for PagePath
+
+	for idx, item := range m.GetSubButton() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MenuButtonValidationError{
+						field:  fmt.Sprintf("SubButton[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MenuButtonValidationError{
+						field:  fmt.Sprintf("SubButton[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MenuButtonValidationError{
+					field:  fmt.Sprintf("SubButton[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MenuButtonMultiError(errors)
+	}
+
+	return nil
+}
+
+// MenuButtonMultiError is an error wrapping multiple validation errors
+// returned by MenuButton.ValidateAll() if the designated constraints aren't met.
+type MenuButtonMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MenuButtonMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MenuButtonMultiError) AllErrors() []error { return m }
+
+// MenuButtonValidationError is the validation error returned by
+// MenuButton.Validate if the designated constraints aren't met.
+type MenuButtonValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MenuButtonValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MenuButtonValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MenuButtonValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MenuButtonValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MenuButtonValidationError) ErrorName() string { return "MenuButtonValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MenuButtonValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMenuButton.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MenuButtonValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MenuButtonValidationError{}
+
+// Validate checks the field values on ConditionalMenu with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ConditionalMenu) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConditionalMenu with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConditionalMenuMultiError, or nil if none found.
+func (m *ConditionalMenu) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConditionalMenu) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Menuid
+
+	for idx, item := range m.GetButton() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConditionalMenuValidationError{
+						field:  fmt.Sprintf("Button[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConditionalMenuValidationError{
+						field:  fmt.Sprintf("Button[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConditionalMenuValidationError{
+					field:  fmt.Sprintf("Button[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetMatchrule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConditionalMenuValidationError{
+					field:  "Matchrule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConditionalMenuValidationError{
+					field:  "Matchrule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMatchrule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConditionalMenuValidationError{
+				field:  "Matchrule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ConditionalMenuMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConditionalMenuMultiError is an error wrapping multiple validation errors
+// returned by ConditionalMenu.ValidateAll() if the designated constraints
+// aren't met.
+type ConditionalMenuMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConditionalMenuMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConditionalMenuMultiError) AllErrors() []error { return m }
+
+// ConditionalMenuValidationError is the validation error returned by
+// ConditionalMenu.Validate if the designated constraints aren't met.
+type ConditionalMenuValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConditionalMenuValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConditionalMenuValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConditionalMenuValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConditionalMenuValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConditionalMenuValidationError) ErrorName() string { return "ConditionalMenuValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConditionalMenuValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConditionalMenu.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConditionalMenuValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConditionalMenuValidationError{}
+
+// Validate checks the field values on ConditionalMatchRule with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConditionalMatchRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConditionalMatchRule with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConditionalMatchRuleMultiError, or nil if none found.
+func (m *ConditionalMatchRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConditionalMatchRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TagId
+
+	// no validation rules for ClientPlatformType
+
+	if len(errors) > 0 {
+		return ConditionalMatchRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConditionalMatchRuleMultiError is an error wrapping multiple validation
+// errors returned by ConditionalMatchRule.ValidateAll() if the designated
+// constraints aren't met.
+type ConditionalMatchRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConditionalMatchRuleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConditionalMatchRuleMultiError) AllErrors() []error { return m }
+
+// ConditionalMatchRuleValidationError is the validation error returned by
+// ConditionalMatchRule.Validate if the designated constraints aren't met.
+type ConditionalMatchRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConditionalMatchRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConditionalMatchRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConditionalMatchRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConditionalMatchRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConditionalMatchRuleValidationError) ErrorName() string {
+	return "ConditionalMatchRuleValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConditionalMatchRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConditionalMatchRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConditionalMatchRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConditionalMatchRuleValidationError{}
+
+// Validate checks the field values on FetchShortenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FetchShortenRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FetchShortenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FetchShortenRequestMultiError, or nil if none found.
+func (m *FetchShortenRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FetchShortenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetShortKey()) < 1 {
+		err := FetchShortenRequestValidationError{
+			field:  "ShortKey",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := FetchShortenRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	if len(errors) > 0 {
+		return FetchShortenRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FetchShortenRequestMultiError is an error wrapping multiple validation
+// errors returned by FetchShortenRequest.ValidateAll() if the designated
+// constraints aren't met.
+type FetchShortenRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FetchShortenRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FetchShortenRequestMultiError) AllErrors() []error { return m }
+
+// FetchShortenRequestValidationError is the validation error returned by
+// FetchShortenRequest.Validate if the designated constraints aren't met.
+type FetchShortenRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FetchShortenRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FetchShortenRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FetchShortenRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FetchShortenRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FetchShortenRequestValidationError) ErrorName() string {
+	return "FetchShortenRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FetchShortenRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFetchShortenRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FetchShortenRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FetchShortenRequestValidationError{}
+
+// Validate checks the field values on FetchShortenReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FetchShortenReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FetchShortenReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FetchShortenReplyMultiError, or nil if none found.
+func (m *FetchShortenReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FetchShortenReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for LongData
+
+	// no validation rules for CreateTime
+
+	// no validation rules for ExpireSeconds
+
+	if len(errors) > 0 {
+		return FetchShortenReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// FetchShortenReplyMultiError is an error wrapping multiple validation errors
+// returned by FetchShortenReply.ValidateAll() if the designated constraints
+// aren't met.
+type FetchShortenReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FetchShortenReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FetchShortenReplyMultiError) AllErrors() []error { return m }
+
+// FetchShortenReplyValidationError is the validation error returned by
+// FetchShortenReply.Validate if the designated constraints aren't met.
+type FetchShortenReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FetchShortenReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FetchShortenReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FetchShortenReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FetchShortenReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FetchShortenReplyValidationError) ErrorName() string {
+	return "FetchShortenReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FetchShortenReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFetchShortenReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FetchShortenReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FetchShortenReplyValidationError{}
+
+// Validate checks the field values on GenShortenRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GenShortenRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenShortenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenShortenRequestMultiError, or nil if none found.
+func (m *GenShortenRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenShortenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := GenShortenRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	if utf8.RuneCountInString(m.GetLongData()) < 1 {
+		err := GenShortenRequestValidationError{
+			field:  "LongData",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ExpireSeconds
+
+	if len(errors) > 0 {
+		return GenShortenRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenShortenRequestMultiError is an error wrapping multiple validation errors
+// returned by GenShortenRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GenShortenRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenShortenRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenShortenRequestMultiError) AllErrors() []error { return m }
+
+// GenShortenRequestValidationError is the validation error returned by
+// GenShortenRequest.Validate if the designated constraints aren't met.
+type GenShortenRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenShortenRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenShortenRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenShortenRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenShortenRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenShortenRequestValidationError) ErrorName() string {
+	return "GenShortenRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenShortenRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenShortenRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenShortenRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenShortenRequestValidationError{}
+
+// Validate checks the field values on GenShortenReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GenShortenReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenShortenReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenShortenReplyMultiError, or nil if none found.
+func (m *GenShortenReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenShortenReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ShortKey
+
+	if len(errors) > 0 {
+		return GenShortenReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenShortenReplyMultiError is an error wrapping multiple validation errors
+// returned by GenShortenReply.ValidateAll() if the designated constraints
+// aren't met.
+type GenShortenReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenShortenReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenShortenReplyMultiError) AllErrors() []error { return m }
+
+// GenShortenReplyValidationError is the validation error returned by
+// GenShortenReply.Validate if the designated constraints aren't met.
+type GenShortenReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenShortenReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenShortenReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenShortenReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenShortenReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenShortenReplyValidationError) ErrorName() string { return "GenShortenReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GenShortenReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenShortenReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenShortenReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenShortenReplyValidationError{}
+
+// Validate checks the field values on CreateQRCodeReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateQRCodeReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateQRCodeReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateQRCodeReplyMultiError, or nil if none found.
+func (m *CreateQRCodeReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateQRCodeReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Ticket
+
+	// no validation rules for ExpireSeconds
+
+	// no validation rules for URL
+
+	if len(errors) > 0 {
+		return CreateQRCodeReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateQRCodeReplyMultiError is an error wrapping multiple validation errors
+// returned by CreateQRCodeReply.ValidateAll() if the designated constraints
+// aren't met.
+type CreateQRCodeReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateQRCodeReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateQRCodeReplyMultiError) AllErrors() []error { return m }
+
+// CreateQRCodeReplyValidationError is the validation error returned by
+// CreateQRCodeReply.Validate if the designated constraints aren't met.
+type CreateQRCodeReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateQRCodeReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateQRCodeReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateQRCodeReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateQRCodeReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateQRCodeReplyValidationError) ErrorName() string {
+	return "CreateQRCodeReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateQRCodeReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateQRCodeReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateQRCodeReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateQRCodeReplyValidationError{}
+
+// Validate checks the field values on CreateQRCodeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateQRCodeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateQRCodeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateQRCodeRequestMultiError, or nil if none found.
+func (m *CreateQRCodeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateQRCodeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := CreateQRCodeRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	// no validation rules for ExpireSeconds
+
+	// no validation rules for Scene
+
+	if len(errors) > 0 {
+		return CreateQRCodeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateQRCodeRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateQRCodeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateQRCodeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateQRCodeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateQRCodeRequestMultiError) AllErrors() []error { return m }
+
+// CreateQRCodeRequestValidationError is the validation error returned by
+// CreateQRCodeRequest.Validate if the designated constraints aren't met.
+type CreateQRCodeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateQRCodeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateQRCodeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateQRCodeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateQRCodeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateQRCodeRequestValidationError) ErrorName() string {
+	return "CreateQRCodeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateQRCodeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateQRCodeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateQRCodeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateQRCodeRequestValidationError{}
+
+// Validate checks the field values on BatchUnTaggingMembersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchUnTaggingMembersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchUnTaggingMembersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchUnTaggingMembersRequestMultiError, or nil if none found.
+func (m *BatchUnTaggingMembersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchUnTaggingMembersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := BatchUnTaggingMembersRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	// no validation rules for Id
+
+	if len(m.GetOpenidList()) < 1 {
+		err := BatchUnTaggingMembersRequestValidationError{
+			field:  "OpenidList",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BatchUnTaggingMembersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchUnTaggingMembersRequestMultiError is an error wrapping multiple
+// validation errors returned by BatchUnTaggingMembersRequest.ValidateAll() if
+// the designated constraints aren't met.
+type BatchUnTaggingMembersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchUnTaggingMembersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchUnTaggingMembersRequestMultiError) AllErrors() []error { return m }
+
+// BatchUnTaggingMembersRequestValidationError is the validation error returned
+// by BatchUnTaggingMembersRequest.Validate if the designated constraints
+// aren't met.
+type BatchUnTaggingMembersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchUnTaggingMembersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchUnTaggingMembersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchUnTaggingMembersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchUnTaggingMembersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchUnTaggingMembersRequestValidationError) ErrorName() string {
+	return "BatchUnTaggingMembersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchUnTaggingMembersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchUnTaggingMembersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchUnTaggingMembersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchUnTaggingMembersRequestValidationError{}
+
+// Validate checks the field values on BatchTaggingMembersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchTaggingMembersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchTaggingMembersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchTaggingMembersRequestMultiError, or nil if none found.
+func (m *BatchTaggingMembersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchTaggingMembersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := BatchTaggingMembersRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	// no validation rules for Id
+
+	if len(m.GetOpenidList()) < 1 {
+		err := BatchTaggingMembersRequestValidationError{
+			field:  "OpenidList",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BatchTaggingMembersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchTaggingMembersRequestMultiError is an error wrapping multiple
+// validation errors returned by BatchTaggingMembersRequest.ValidateAll() if
+// the designated constraints aren't met.
+type BatchTaggingMembersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchTaggingMembersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchTaggingMembersRequestMultiError) AllErrors() []error { return m }
+
+// BatchTaggingMembersRequestValidationError is the validation error returned
+// by BatchTaggingMembersRequest.Validate if the designated constraints aren't met.
+type BatchTaggingMembersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchTaggingMembersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchTaggingMembersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchTaggingMembersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchTaggingMembersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchTaggingMembersRequestValidationError) ErrorName() string {
+	return "BatchTaggingMembersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchTaggingMembersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchTaggingMembersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchTaggingMembersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchTaggingMembersRequestValidationError{}
+
+// Validate checks the field values on GetTagMembersReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTagMembersReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTagMembersReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTagMembersReplyMultiError, or nil if none found.
+func (m *GetTagMembersReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTagMembersReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	// no validation rules for NextOpenid
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTagMembersReplyValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTagMembersReplyValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTagMembersReplyValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetTagMembersReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTagMembersReplyMultiError is an error wrapping multiple validation errors
+// returned by GetTagMembersReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetTagMembersReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTagMembersReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTagMembersReplyMultiError) AllErrors() []error { return m }
+
+// GetTagMembersReplyValidationError is the validation error returned by
+// GetTagMembersReply.Validate if the designated constraints aren't met.
+type GetTagMembersReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTagMembersReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTagMembersReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTagMembersReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTagMembersReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTagMembersReplyValidationError) ErrorName() string {
+	return "GetTagMembersReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTagMembersReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTagMembersReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTagMembersReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTagMembersReplyValidationError{}
+
+// Validate checks the field values on GetTagMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTagMembersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTagMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTagMembersRequestMultiError, or nil if none found.
+func (m *GetTagMembersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTagMembersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := GetTagMembersRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	// no validation rules for Id
+
+	// no validation rules for NextOpenid
+
+	if len(errors) > 0 {
+		return GetTagMembersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTagMembersRequestMultiError is an error wrapping multiple validation
+// errors returned by GetTagMembersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetTagMembersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTagMembersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTagMembersRequestMultiError) AllErrors() []error { return m }
+
+// GetTagMembersRequestValidationError is the validation error returned by
+// GetTagMembersRequest.Validate if the designated constraints aren't met.
+type GetTagMembersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTagMembersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTagMembersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTagMembersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTagMembersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTagMembersRequestValidationError) ErrorName() string {
+	return "GetTagMembersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTagMembersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTagMembersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTagMembersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTagMembersRequestValidationError{}
+
+// Validate checks the field values on DeleteTagRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTagRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTagRequestMultiError, or nil if none found.
+func (m *DeleteTagRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTagRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := DeleteTagRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteTagRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTagRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteTagRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteTagRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTagRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTagRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTagRequestValidationError is the validation error returned by
+// DeleteTagRequest.Validate if the designated constraints aren't met.
+type DeleteTagRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTagRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTagRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTagRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTagRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTagRequestValidationError) ErrorName() string { return "DeleteTagRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteTagRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTagRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTagRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTagRequestValidationError{}
+
+// Validate checks the field values on UpdateTagRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTagRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTagRequestMultiError, or nil if none found.
+func (m *UpdateTagRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTagRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := UpdateTagRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	// no validation rules for Id
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := UpdateTagRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateTagRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTagRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateTagRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateTagRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTagRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTagRequestMultiError) AllErrors() []error { return m }
+
+// UpdateTagRequestValidationError is the validation error returned by
+// UpdateTagRequest.Validate if the designated constraints aren't met.
+type UpdateTagRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTagRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTagRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTagRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTagRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTagRequestValidationError) ErrorName() string { return "UpdateTagRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UpdateTagRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTagRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTagRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTagRequestValidationError{}
+
+// Validate checks the field values on CreateTagRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateTagRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTagRequestMultiError, or nil if none found.
+func (m *CreateTagRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTagRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := CreateTagRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CreateTagRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateTagRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTagRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateTagRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateTagRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTagRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTagRequestMultiError) AllErrors() []error { return m }
+
+// CreateTagRequestValidationError is the validation error returned by
+// CreateTagRequest.Validate if the designated constraints aren't met.
+type CreateTagRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTagRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTagRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTagRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTagRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTagRequestValidationError) ErrorName() string { return "CreateTagRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateTagRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTagRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTagRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTagRequestValidationError{}
+
+// Validate checks the field values on CreateTagReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CreateTagReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTagReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CreateTagReplyMultiError,
+// or nil if none found.
+func (m *CreateTagReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTagReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTag()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTagReplyValidationError{
+					field:  "Tag",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTagReplyValidationError{
+					field:  "Tag",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTag()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTagReplyValidationError{
+				field:  "Tag",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateTagReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTagReplyMultiError is an error wrapping multiple validation errors
+// returned by CreateTagReply.ValidateAll() if the designated constraints
+// aren't met.
+type CreateTagReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTagReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTagReplyMultiError) AllErrors() []error { return m }
+
+// CreateTagReplyValidationError is the validation error returned by
+// CreateTagReply.Validate if the designated constraints aren't met.
+type CreateTagReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTagReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTagReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTagReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTagReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTagReplyValidationError) ErrorName() string { return "CreateTagReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateTagReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTagReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTagReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTagReplyValidationError{}
+
+// Validate checks the field values on GetTagListReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetTagListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTagListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTagListReplyMultiError, or nil if none found.
+func (m *GetTagListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTagListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetTagListReplyValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetTagListReplyValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetTagListReplyValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetTagListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTagListReplyMultiError is an error wrapping multiple validation errors
+// returned by GetTagListReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetTagListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTagListReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTagListReplyMultiError) AllErrors() []error { return m }
+
+// GetTagListReplyValidationError is the validation error returned by
+// GetTagListReply.Validate if the designated constraints aren't met.
+type GetTagListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTagListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTagListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTagListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTagListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTagListReplyValidationError) ErrorName() string { return "GetTagListReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetTagListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTagListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTagListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTagListReplyValidationError{}
+
+// Validate checks the field values on Tag with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Tag) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Tag with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TagMultiError, or nil if none found.
+func (m *Tag) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Tag) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return TagMultiError(errors)
+	}
+
+	return nil
+}
+
+// TagMultiError is an error wrapping multiple validation errors returned by
+// Tag.ValidateAll() if the designated constraints aren't met.
+type TagMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TagMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TagMultiError) AllErrors() []error { return m }
+
+// TagValidationError is the validation error returned by Tag.Validate if the
+// designated constraints aren't met.
+type TagValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TagValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TagValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TagValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TagValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TagValidationError) ErrorName() string { return "TagValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TagValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTag.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TagValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TagValidationError{}
+
+// Validate checks the field values on UpdateMemberRemarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateMemberRemarkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateMemberRemarkRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateMemberRemarkRequestMultiError, or nil if none found.
+func (m *UpdateMemberRemarkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateMemberRemarkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := UpdateMemberRemarkRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	if utf8.RuneCountInString(m.GetOpenid()) < 1 {
+		err := UpdateMemberRemarkRequestValidationError{
+			field:  "Openid",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Remark
+
+	if len(errors) > 0 {
+		return UpdateMemberRemarkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateMemberRemarkRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateMemberRemarkRequest.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateMemberRemarkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateMemberRemarkRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateMemberRemarkRequestMultiError) AllErrors() []error { return m }
+
+// UpdateMemberRemarkRequestValidationError is the validation error returned by
+// UpdateMemberRemarkRequest.Validate if the designated constraints aren't met.
+type UpdateMemberRemarkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateMemberRemarkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateMemberRemarkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateMemberRemarkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateMemberRemarkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateMemberRemarkRequestValidationError) ErrorName() string {
+	return "UpdateMemberRemarkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateMemberRemarkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateMemberRemarkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateMemberRemarkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateMemberRemarkRequestValidationError{}
+
+// Validate checks the field values on WXErrorReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WXErrorReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WXErrorReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WXErrorReplyMultiError, or
+// nil if none found.
+func (m *WXErrorReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WXErrorReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Errcode
+
+	// no validation rules for Errmsg
+
+	if len(errors) > 0 {
+		return WXErrorReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// WXErrorReplyMultiError is an error wrapping multiple validation errors
+// returned by WXErrorReply.ValidateAll() if the designated constraints aren't met.
+type WXErrorReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WXErrorReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WXErrorReplyMultiError) AllErrors() []error { return m }
+
+// WXErrorReplyValidationError is the validation error returned by
+// WXErrorReply.Validate if the designated constraints aren't met.
+type WXErrorReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WXErrorReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WXErrorReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WXErrorReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WXErrorReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WXErrorReplyValidationError) ErrorName() string { return "WXErrorReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WXErrorReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWXErrorReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WXErrorReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WXErrorReplyValidationError{}
+
+// Validate checks the field values on GetMemberTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMemberTagsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMemberTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMemberTagsRequestMultiError, or nil if none found.
+func (m *GetMemberTagsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMemberTagsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := GetMemberTagsRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	if utf8.RuneCountInString(m.GetOpenid()) < 1 {
+		err := GetMemberTagsRequestValidationError{
+			field:  "Openid",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetMemberTagsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMemberTagsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetMemberTagsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetMemberTagsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMemberTagsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMemberTagsRequestMultiError) AllErrors() []error { return m }
+
+// GetMemberTagsRequestValidationError is the validation error returned by
+// GetMemberTagsRequest.Validate if the designated constraints aren't met.
+type GetMemberTagsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMemberTagsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMemberTagsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMemberTagsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMemberTagsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMemberTagsRequestValidationError) ErrorName() string {
+	return "GetMemberTagsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMemberTagsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMemberTagsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMemberTagsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMemberTagsRequestValidationError{}
+
+// Validate checks the field values on GetMemberTagsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMemberTagsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMemberTagsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMemberTagsReplyMultiError, or nil if none found.
+func (m *GetMemberTagsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMemberTagsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetMemberTagsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMemberTagsReplyMultiError is an error wrapping multiple validation errors
+// returned by GetMemberTagsReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetMemberTagsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMemberTagsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMemberTagsReplyMultiError) AllErrors() []error { return m }
+
+// GetMemberTagsReplyValidationError is the validation error returned by
+// GetMemberTagsReply.Validate if the designated constraints aren't met.
+type GetMemberTagsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMemberTagsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMemberTagsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMemberTagsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMemberTagsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMemberTagsReplyValidationError) ErrorName() string {
+	return "GetMemberTagsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMemberTagsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMemberTagsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMemberTagsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMemberTagsReplyValidationError{}
+
+// Validate checks the field values on BatchGetMemberInfoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetMemberInfoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetMemberInfoRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetMemberInfoRequestMultiError, or nil if none found.
+func (m *BatchGetMemberInfoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetMemberInfoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := BatchGetMemberInfoRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	if len(m.GetUserList()) < 1 {
+		err := BatchGetMemberInfoRequestValidationError{
+			field:  "UserList",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetUserList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchGetMemberInfoRequestValidationError{
+						field:  fmt.Sprintf("UserList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchGetMemberInfoRequestValidationError{
+						field:  fmt.Sprintf("UserList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchGetMemberInfoRequestValidationError{
+					field:  fmt.Sprintf("UserList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetMemberInfoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetMemberInfoRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchGetMemberInfoRequest.ValidateAll() if the
+// designated constraints aren't met.
+type BatchGetMemberInfoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetMemberInfoRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetMemberInfoRequestMultiError) AllErrors() []error { return m }
+
+// BatchGetMemberInfoRequestValidationError is the validation error returned by
+// BatchGetMemberInfoRequest.Validate if the designated constraints aren't met.
+type BatchGetMemberInfoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetMemberInfoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetMemberInfoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetMemberInfoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetMemberInfoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetMemberInfoRequestValidationError) ErrorName() string {
+	return "BatchGetMemberInfoRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetMemberInfoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetMemberInfoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetMemberInfoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetMemberInfoRequestValidationError{}
+
+// Validate checks the field values on BatchGetMemberInfoReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetMemberInfoReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetMemberInfoReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetMemberInfoReplyMultiError, or nil if none found.
+func (m *BatchGetMemberInfoReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetMemberInfoReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUserListInfo() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchGetMemberInfoReplyValidationError{
+						field:  fmt.Sprintf("UserListInfo[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchGetMemberInfoReplyValidationError{
+						field:  fmt.Sprintf("UserListInfo[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchGetMemberInfoReplyValidationError{
+					field:  fmt.Sprintf("UserListInfo[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetMemberInfoReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetMemberInfoReplyMultiError is an error wrapping multiple validation
+// errors returned by BatchGetMemberInfoReply.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetMemberInfoReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetMemberInfoReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetMemberInfoReplyMultiError) AllErrors() []error { return m }
+
+// BatchGetMemberInfoReplyValidationError is the validation error returned by
+// BatchGetMemberInfoReply.Validate if the designated constraints aren't met.
+type BatchGetMemberInfoReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetMemberInfoReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetMemberInfoReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetMemberInfoReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetMemberInfoReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetMemberInfoReplyValidationError) ErrorName() string {
+	return "BatchGetMemberInfoReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetMemberInfoReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetMemberInfoReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetMemberInfoReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetMemberInfoReplyValidationError{}
+
+// Validate checks the field values on GetMemberInfoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMemberInfoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMemberInfoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMemberInfoRequestMultiError, or nil if none found.
+func (m *GetMemberInfoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMemberInfoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := GetMemberInfoRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	if utf8.RuneCountInString(m.GetOpenid()) < 1 {
+		err := GetMemberInfoRequestValidationError{
+			field:  "Openid",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Lang
+
+	if len(errors) > 0 {
+		return GetMemberInfoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMemberInfoRequestMultiError is an error wrapping multiple validation
+// errors returned by GetMemberInfoRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetMemberInfoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMemberInfoRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMemberInfoRequestMultiError) AllErrors() []error { return m }
+
+// GetMemberInfoRequestValidationError is the validation error returned by
+// GetMemberInfoRequest.Validate if the designated constraints aren't met.
+type GetMemberInfoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMemberInfoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMemberInfoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMemberInfoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMemberInfoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMemberInfoRequestValidationError) ErrorName() string {
+	return "GetMemberInfoRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMemberInfoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMemberInfoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMemberInfoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMemberInfoRequestValidationError{}
+
+// Validate checks the field values on GetMemberInfoReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMemberInfoReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMemberInfoReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMemberInfoReplyMultiError, or nil if none found.
+func (m *GetMemberInfoReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMemberInfoReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Subscribe
+
+	// no validation rules for Openid
+
+	// no validation rules for SubscribeTime
+
+	// no validation rules for Unionid
+
+	// no validation rules for Remark
+
+	// no validation rules for Groupid
+
+	// no validation rules for SubscribeScene
+
+	// no validation rules for QrScene
+
+	// no validation rules for QrSceneStr
+
+	// no validation rules for Language
+
+	if len(errors) > 0 {
+		return GetMemberInfoReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMemberInfoReplyMultiError is an error wrapping multiple validation errors
+// returned by GetMemberInfoReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetMemberInfoReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMemberInfoReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMemberInfoReplyMultiError) AllErrors() []error { return m }
+
+// GetMemberInfoReplyValidationError is the validation error returned by
+// GetMemberInfoReply.Validate if the designated constraints aren't met.
+type GetMemberInfoReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMemberInfoReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMemberInfoReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMemberInfoReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMemberInfoReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMemberInfoReplyValidationError) ErrorName() string {
+	return "GetMemberInfoReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMemberInfoReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMemberInfoReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMemberInfoReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMemberInfoReplyValidationError{}
+
+// Validate checks the field values on GetMemberListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMemberListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMemberListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMemberListRequestMultiError, or nil if none found.
+func (m *GetMemberListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMemberListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := GetMemberListRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	// no validation rules for NextOpenid
+
+	if len(errors) > 0 {
+		return GetMemberListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMemberListRequestMultiError is an error wrapping multiple validation
+// errors returned by GetMemberListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetMemberListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMemberListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMemberListRequestMultiError) AllErrors() []error { return m }
+
+// GetMemberListRequestValidationError is the validation error returned by
+// GetMemberListRequest.Validate if the designated constraints aren't met.
+type GetMemberListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMemberListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMemberListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMemberListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMemberListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMemberListRequestValidationError) ErrorName() string {
+	return "GetMemberListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMemberListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMemberListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMemberListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMemberListRequestValidationError{}
+
+// Validate checks the field values on GetMemberListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMemberListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMemberListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMemberListReplyMultiError, or nil if none found.
+func (m *GetMemberListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMemberListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	// no validation rules for Count
+
+	// no validation rules for NextOpenid
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetMemberListReplyValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetMemberListReplyValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetMemberListReplyValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetMemberListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMemberListReplyMultiError is an error wrapping multiple validation errors
+// returned by GetMemberListReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetMemberListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMemberListReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMemberListReplyMultiError) AllErrors() []error { return m }
+
+// GetMemberListReplyValidationError is the validation error returned by
+// GetMemberListReply.Validate if the designated constraints aren't met.
+type GetMemberListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMemberListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMemberListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMemberListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMemberListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMemberListReplyValidationError) ErrorName() string {
+	return "GetMemberListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMemberListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMemberListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMemberListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMemberListReplyValidationError{}
+
+// Validate checks the field values on OpenIdList with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OpenIdList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OpenIdList with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OpenIdListMultiError, or
+// nil if none found.
+func (m *OpenIdList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OpenIdList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Openid
+
+	if len(errors) > 0 {
+		return OpenIdListMultiError(errors)
+	}
+
+	return nil
+}
+
+// OpenIdListMultiError is an error wrapping multiple validation errors
+// returned by OpenIdList.ValidateAll() if the designated constraints aren't met.
+type OpenIdListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OpenIdListMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OpenIdListMultiError) AllErrors() []error { return m }
+
+// OpenIdListValidationError is the validation error returned by
+// OpenIdList.Validate if the designated constraints aren't met.
+type OpenIdListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OpenIdListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OpenIdListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OpenIdListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OpenIdListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OpenIdListValidationError) ErrorName() string { return "OpenIdListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OpenIdListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOpenIdList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OpenIdListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OpenIdListValidationError{}
+
+// Validate checks the field values on AccessTokenParam with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AccessTokenParam) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessTokenParam with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AccessTokenParamMultiError, or nil if none found.
+func (m *AccessTokenParam) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessTokenParam) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := AccessTokenParamValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	if len(errors) > 0 {
+		return AccessTokenParamMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccessTokenParamMultiError is an error wrapping multiple validation errors
+// returned by AccessTokenParam.ValidateAll() if the designated constraints
+// aren't met.
+type AccessTokenParamMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessTokenParamMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessTokenParamMultiError) AllErrors() []error { return m }
+
+// AccessTokenParamValidationError is the validation error returned by
+// AccessTokenParam.Validate if the designated constraints aren't met.
+type AccessTokenParamValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessTokenParamValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccessTokenParamValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccessTokenParamValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccessTokenParamValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccessTokenParamValidationError) ErrorName() string { return "AccessTokenParamValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AccessTokenParamValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessTokenParam.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessTokenParamValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessTokenParamValidationError{}
+
+// Validate checks the field values on DeleteMaterialReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteMaterialReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteMaterialReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteMaterialReqMultiError, or nil if none found.
+func (m *DeleteMaterialReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteMaterialReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := DeleteMaterialReqValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	if utf8.RuneCountInString(m.GetMediaId()) < 1 {
+		err := DeleteMaterialReqValidationError{
+			field:  "MediaId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteMaterialReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteMaterialReqMultiError is an error wrapping multiple validation errors
+// returned by DeleteMaterialReq.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteMaterialReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteMaterialReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteMaterialReqMultiError) AllErrors() []error { return m }
+
+// DeleteMaterialReqValidationError is the validation error returned by
+// DeleteMaterialReq.Validate if the designated constraints aren't met.
+type DeleteMaterialReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteMaterialReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteMaterialReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteMaterialReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteMaterialReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteMaterialReqValidationError) ErrorName() string {
+	return "DeleteMaterialReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteMaterialReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteMaterialReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteMaterialReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteMaterialReqValidationError{}
+
+// Validate checks the field values on GetMaterialCountReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMaterialCountReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMaterialCountReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMaterialCountReplyMultiError, or nil if none found.
+func (m *GetMaterialCountReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMaterialCountReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for VoiceCount
+
+	// no validation rules for VideoCount
+
+	// no validation rules for ImageCount
+
+	// no validation rules for NewsCount
+
+	if len(errors) > 0 {
+		return GetMaterialCountReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMaterialCountReplyMultiError is an error wrapping multiple validation
+// errors returned by GetMaterialCountReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetMaterialCountReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMaterialCountReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMaterialCountReplyMultiError) AllErrors() []error { return m }
+
+// GetMaterialCountReplyValidationError is the validation error returned by
+// GetMaterialCountReply.Validate if the designated constraints aren't met.
+type GetMaterialCountReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMaterialCountReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMaterialCountReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMaterialCountReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMaterialCountReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMaterialCountReplyValidationError) ErrorName() string {
+	return "GetMaterialCountReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMaterialCountReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMaterialCountReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMaterialCountReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMaterialCountReplyValidationError{}
+
+// Validate checks the field values on GetMaterialListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMaterialListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMaterialListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMaterialListRequestMultiError, or nil if none found.
+func (m *GetMaterialListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMaterialListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccessToken()) < 1 {
+		err := GetMaterialListRequestValidationError{
+			field:  "AccessToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AppId
+
+	if _, ok := _GetMaterialListRequest_Type_InLookup[m.GetType()]; !ok {
+		err := GetMaterialListRequestValidationError{
+			field:  "Type",
+			reason: "value must be in list [image video voice news]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOffset() < 0 {
+		err := GetMaterialListRequestValidationError{
+			field:  "Offset",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetCount(); val < 1 || val > 20 {
+		err := GetMaterialListRequestValidationError{
+			field:  "Count",
+			reason: "value must be inside range [1, 20]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetMaterialListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMaterialListRequestMultiError is an error wrapping multiple validation
+// errors returned by GetMaterialListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetMaterialListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMaterialListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMaterialListRequestMultiError) AllErrors() []error { return m }
+
+// GetMaterialListRequestValidationError is the validation error returned by
+// GetMaterialListRequest.Validate if the designated constraints aren't met.
+type GetMaterialListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMaterialListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMaterialListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMaterialListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMaterialListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMaterialListRequestValidationError) ErrorName() string {
+	return "GetMaterialListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMaterialListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMaterialListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMaterialListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMaterialListRequestValidationError{}
+
+var _GetMaterialListRequest_Type_InLookup = map[string]struct{}{
+	"image": {},
+	"video": {},
+	"voice": {},
+	"news":  {},
+}
+
+// Validate checks the field values on GetMaterialListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMaterialListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMaterialListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMaterialListReplyMultiError, or nil if none found.
+func (m *GetMaterialListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMaterialListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TotalCount
+
+	// no validation rules for ItemCount
+
+	for idx, item := range m.GetItem() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetMaterialListReplyValidationError{
+						field:  fmt.Sprintf("Item[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetMaterialListReplyValidationError{
+						field:  fmt.Sprintf("Item[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetMaterialListReplyValidationError{
+					field:  fmt.Sprintf("Item[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetMaterialListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMaterialListReplyMultiError is an error wrapping multiple validation
+// errors returned by GetMaterialListReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetMaterialListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMaterialListReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMaterialListReplyMultiError) AllErrors() []error { return m }
+
+// GetMaterialListReplyValidationError is the validation error returned by
+// GetMaterialListReply.Validate if the designated constraints aren't met.
+type GetMaterialListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMaterialListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMaterialListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMaterialListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMaterialListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMaterialListReplyValidationError) ErrorName() string {
+	return "GetMaterialListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMaterialListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMaterialListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMaterialListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMaterialListReplyValidationError{}
+
+// Validate checks the field values on MaterialItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MaterialItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MaterialItem with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MaterialItemMultiError, or
+// nil if none found.
+func (m *MaterialItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MaterialItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MediaId
+
+	// no validation rules for Name
+
+	// no validation rules for Url
+
+	// no validation rules for UpdateTime
+
+	if len(errors) > 0 {
+		return MaterialItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// MaterialItemMultiError is an error wrapping multiple validation errors
+// returned by MaterialItem.ValidateAll() if the designated constraints aren't met.
+type MaterialItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MaterialItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MaterialItemMultiError) AllErrors() []error { return m }
+
+// MaterialItemValidationError is the validation error returned by
+// MaterialItem.Validate if the designated constraints aren't met.
+type MaterialItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MaterialItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MaterialItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MaterialItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MaterialItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MaterialItemValidationError) ErrorName() string { return "MaterialItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MaterialItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMaterialItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MaterialItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MaterialItemValidationError{}
+
+// Validate checks the field values on GetMaterialNewsListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMaterialNewsListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMaterialNewsListReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMaterialNewsListReplyMultiError, or nil if none found.
+func (m *GetMaterialNewsListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMaterialNewsListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TotalCount
+
+	// no validation rules for ItemCount
+
+	for idx, item := range m.GetItem() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetMaterialNewsListReplyValidationError{
+						field:  fmt.Sprintf("Item[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetMaterialNewsListReplyValidationError{
+						field:  fmt.Sprintf("Item[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetMaterialNewsListReplyValidationError{
+					field:  fmt.Sprintf("Item[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetMaterialNewsListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMaterialNewsListReplyMultiError is an error wrapping multiple validation
+// errors returned by GetMaterialNewsListReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetMaterialNewsListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMaterialNewsListReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMaterialNewsListReplyMultiError) AllErrors() []error { return m }
+
+// GetMaterialNewsListReplyValidationError is the validation error returned by
+// GetMaterialNewsListReply.Validate if the designated constraints aren't met.
+type GetMaterialNewsListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMaterialNewsListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMaterialNewsListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMaterialNewsListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMaterialNewsListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMaterialNewsListReplyValidationError) ErrorName() string {
+	return "GetMaterialNewsListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMaterialNewsListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMaterialNewsListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMaterialNewsListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMaterialNewsListReplyValidationError{}
+
+// Validate checks the field values on MaterialNewsItem with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MaterialNewsItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MaterialNewsItem with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MaterialNewsItemMultiError, or nil if none found.
+func (m *MaterialNewsItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MaterialNewsItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MediaId
+
+	// no validation rules for UpdateTime
+
+	for idx, item := range m.GetArticles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MaterialNewsItemValidationError{
+						field:  fmt.Sprintf("Articles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MaterialNewsItemValidationError{
+						field:  fmt.Sprintf("Articles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MaterialNewsItemValidationError{
+					field:  fmt.Sprintf("Articles[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MaterialNewsItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// MaterialNewsItemMultiError is an error wrapping multiple validation errors
+// returned by MaterialNewsItem.ValidateAll() if the designated constraints
+// aren't met.
+type MaterialNewsItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MaterialNewsItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MaterialNewsItemMultiError) AllErrors() []error { return m }
+
+// MaterialNewsItemValidationError is the validation error returned by
+// MaterialNewsItem.Validate if the designated constraints aren't met.
+type MaterialNewsItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MaterialNewsItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MaterialNewsItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MaterialNewsItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MaterialNewsItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MaterialNewsItemValidationError) ErrorName() string { return "MaterialNewsItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MaterialNewsItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMaterialNewsItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MaterialNewsItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MaterialNewsItemValidationError{}
+
+// Validate checks the field values on NewsArticle with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *NewsArticle) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NewsArticle with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NewsArticleMultiError, or
+// nil if none found.
+func (m *NewsArticle) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NewsArticle) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	// no validation rules for Digest
+
+	// no validation rules for ShowCoverPic
+
+	// no validation rules for Author
+
+	// no validation rules for Content
+
+	// no validation rules for ContentSourceUrl
+
+	// no validation rules for Url
+
+	// no validation rules for ThumbMediaId
+
+	if len(errors) > 0 {
+		return NewsArticleMultiError(errors)
+	}
+
+	return nil
+}
+
+// NewsArticleMultiError is an error wrapping multiple validation errors
+// returned by NewsArticle.ValidateAll() if the designated constraints aren't met.
+type NewsArticleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NewsArticleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NewsArticleMultiError) AllErrors() []error { return m }
+
+// NewsArticleValidationError is the validation error returned by
+// NewsArticle.Validate if the designated constraints aren't met.
+type NewsArticleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NewsArticleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NewsArticleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NewsArticleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NewsArticleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NewsArticleValidationError) ErrorName() string { return "NewsArticleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NewsArticleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNewsArticle.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NewsArticleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NewsArticleValidationError{}
+
+// Validate checks the field values on
+// SendKFMiniProgramMsgRequest_KFMiniProgramMsg with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SendKFMiniProgramMsgRequest_KFMiniProgramMsg) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// SendKFMiniProgramMsgRequest_KFMiniProgramMsg with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// SendKFMiniProgramMsgRequest_KFMiniProgramMsgMultiError, or nil if none found.
+func (m *SendKFMiniProgramMsgRequest_KFMiniProgramMsg) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendKFMiniProgramMsgRequest_KFMiniProgramMsg) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	// no validation rules for PagePath
+
+	// no validation rules for ThumbMediaId
+
+	// no validation rules for AppId
+
+	if len(errors) > 0 {
+		return SendKFMiniProgramMsgRequest_KFMiniProgramMsgMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendKFMiniProgramMsgRequest_KFMiniProgramMsgMultiError is an error wrapping
+// multiple validation errors returned by
+// SendKFMiniProgramMsgRequest_KFMiniProgramMsg.ValidateAll() if the
+// designated constraints aren't met.
+type SendKFMiniProgramMsgRequest_KFMiniProgramMsgMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendKFMiniProgramMsgRequest_KFMiniProgramMsgMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendKFMiniProgramMsgRequest_KFMiniProgramMsgMultiError) AllErrors() []error { return m }
+
+// SendKFMiniProgramMsgRequest_KFMiniProgramMsgValidationError is the
+// validation error returned by
+// SendKFMiniProgramMsgRequest_KFMiniProgramMsg.Validate if the designated
+// constraints aren't met.
+type SendKFMiniProgramMsgRequest_KFMiniProgramMsgValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendKFMiniProgramMsgRequest_KFMiniProgramMsgValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendKFMiniProgramMsgRequest_KFMiniProgramMsgValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendKFMiniProgramMsgRequest_KFMiniProgramMsgValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendKFMiniProgramMsgRequest_KFMiniProgramMsgValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendKFMiniProgramMsgRequest_KFMiniProgramMsgValidationError) ErrorName() string {
+	return "SendKFMiniProgramMsgRequest_KFMiniProgramMsgValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendKFMiniProgramMsgRequest_KFMiniProgramMsgValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendKFMiniProgramMsgRequest_KFMiniProgramMsg.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendKFMiniProgramMsgRequest_KFMiniProgramMsgValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendKFMiniProgramMsgRequest_KFMiniProgramMsgValidationError{}
+
+// Validate checks the field values on SendKFCardMsgRequest_KFCardMsg with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendKFCardMsgRequest_KFCardMsg) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendKFCardMsgRequest_KFCardMsg with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SendKFCardMsgRequest_KFCardMsgMultiError, or nil if none found.
+func (m *SendKFCardMsgRequest_KFCardMsg) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendKFCardMsgRequest_KFCardMsg) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CardId
+
+	if len(errors) > 0 {
+		return SendKFCardMsgRequest_KFCardMsgMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendKFCardMsgRequest_KFCardMsgMultiError is an error wrapping multiple
+// validation errors returned by SendKFCardMsgRequest_KFCardMsg.ValidateAll()
+// if the designated constraints aren't met.
+type SendKFCardMsgRequest_KFCardMsgMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendKFCardMsgRequest_KFCardMsgMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendKFCardMsgRequest_KFCardMsgMultiError) AllErrors() []error { return m }
+
+// SendKFCardMsgRequest_KFCardMsgValidationError is the validation error
+// returned by SendKFCardMsgRequest_KFCardMsg.Validate if the designated
+// constraints aren't met.
+type SendKFCardMsgRequest_KFCardMsgValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendKFCardMsgRequest_KFCardMsgValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendKFCardMsgRequest_KFCardMsgValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendKFCardMsgRequest_KFCardMsgValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendKFCardMsgRequest_KFCardMsgValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendKFCardMsgRequest_KFCardMsgValidationError) ErrorName() string {
+	return "SendKFCardMsgRequest_KFCardMsgValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendKFCardMsgRequest_KFCardMsgValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendKFCardMsgRequest_KFCardMsg.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendKFCardMsgRequest_KFCardMsgValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendKFCardMsgRequest_KFCardMsgValidationError{}
+
+// Validate checks the field values on SendKFMenuMsgRequest_Item with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendKFMenuMsgRequest_Item) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendKFMenuMsgRequest_Item with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendKFMenuMsgRequest_ItemMultiError, or nil if none found.
+func (m *SendKFMenuMsgRequest_Item) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendKFMenuMsgRequest_Item) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return SendKFMenuMsgRequest_ItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendKFMenuMsgRequest_ItemMultiError is an error wrapping multiple validation
+// errors returned by SendKFMenuMsgRequest_Item.ValidateAll() if the
+// designated constraints aren't met.
+type SendKFMenuMsgRequest_ItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendKFMenuMsgRequest_ItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendKFMenuMsgRequest_ItemMultiError) AllErrors() []error { return m }
+
+// SendKFMenuMsgRequest_ItemValidationError is the validation error returned by
+// SendKFMenuMsgRequest_Item.Validate if the designated constraints aren't met.
+type SendKFMenuMsgRequest_ItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendKFMenuMsgRequest_ItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendKFMenuMsgRequest_ItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendKFMenuMsgRequest_ItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendKFMenuMsgRequest_ItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendKFMenuMsgRequest_ItemValidationError) ErrorName() string {
+	return "SendKFMenuMsgRequest_ItemValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendKFMenuMsgRequest_ItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendKFMenuMsgRequest_Item.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendKFMenuMsgRequest_ItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendKFMenuMsgRequest_ItemValidationError{}
+
+// Validate checks the field values on SendKFMenuMsgRequest_MenuMsg with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendKFMenuMsgRequest_MenuMsg) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendKFMenuMsgRequest_MenuMsg with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendKFMenuMsgRequest_MenuMsgMultiError, or nil if none found.
+func (m *SendKFMenuMsgRequest_MenuMsg) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendKFMenuMsgRequest_MenuMsg) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for HeadContent
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SendKFMenuMsgRequest_MenuMsgValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SendKFMenuMsgRequest_MenuMsgValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SendKFMenuMsgRequest_MenuMsgValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TailContent
+
+	if len(errors) > 0 {
+		return SendKFMenuMsgRequest_MenuMsgMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendKFMenuMsgRequest_MenuMsgMultiError is an error wrapping multiple
+// validation errors returned by SendKFMenuMsgRequest_MenuMsg.ValidateAll() if
+// the designated constraints aren't met.
+type SendKFMenuMsgRequest_MenuMsgMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendKFMenuMsgRequest_MenuMsgMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendKFMenuMsgRequest_MenuMsgMultiError) AllErrors() []error { return m }
+
+// SendKFMenuMsgRequest_MenuMsgValidationError is the validation error returned
+// by SendKFMenuMsgRequest_MenuMsg.Validate if the designated constraints
+// aren't met.
+type SendKFMenuMsgRequest_MenuMsgValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendKFMenuMsgRequest_MenuMsgValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendKFMenuMsgRequest_MenuMsgValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendKFMenuMsgRequest_MenuMsgValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendKFMenuMsgRequest_MenuMsgValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendKFMenuMsgRequest_MenuMsgValidationError) ErrorName() string {
+	return "SendKFMenuMsgRequest_MenuMsgValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendKFMenuMsgRequest_MenuMsgValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendKFMenuMsgRequest_MenuMsg.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendKFMenuMsgRequest_MenuMsgValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendKFMenuMsgRequest_MenuMsgValidationError{}
+
+// Validate checks the field values on SendKFToArticleMsgRequest_ToArticleMsg
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *SendKFToArticleMsgRequest_ToArticleMsg) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// SendKFToArticleMsgRequest_ToArticleMsg with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// SendKFToArticleMsgRequest_ToArticleMsgMultiError, or nil if none found.
+func (m *SendKFToArticleMsgRequest_ToArticleMsg) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendKFToArticleMsgRequest_ToArticleMsg) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ArticleId
+
+	if len(errors) > 0 {
+		return SendKFToArticleMsgRequest_ToArticleMsgMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendKFToArticleMsgRequest_ToArticleMsgMultiError is an error wrapping
+// multiple validation errors returned by
+// SendKFToArticleMsgRequest_ToArticleMsg.ValidateAll() if the designated
+// constraints aren't met.
+type SendKFToArticleMsgRequest_ToArticleMsgMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendKFToArticleMsgRequest_ToArticleMsgMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendKFToArticleMsgRequest_ToArticleMsgMultiError) AllErrors() []error { return m }
+
+// SendKFToArticleMsgRequest_ToArticleMsgValidationError is the validation
+// error returned by SendKFToArticleMsgRequest_ToArticleMsg.Validate if the
+// designated constraints aren't met.
+type SendKFToArticleMsgRequest_ToArticleMsgValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendKFToArticleMsgRequest_ToArticleMsgValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendKFToArticleMsgRequest_ToArticleMsgValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendKFToArticleMsgRequest_ToArticleMsgValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendKFToArticleMsgRequest_ToArticleMsgValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendKFToArticleMsgRequest_ToArticleMsgValidationError) ErrorName() string {
+	return "SendKFToArticleMsgRequest_ToArticleMsgValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendKFToArticleMsgRequest_ToArticleMsgValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendKFToArticleMsgRequest_ToArticleMsg.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendKFToArticleMsgRequest_ToArticleMsgValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendKFToArticleMsgRequest_ToArticleMsgValidationError{}
+
+// Validate checks the field values on SendKFNewsPageMsgRequest_KFNewsPageMsg
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *SendKFNewsPageMsgRequest_KFNewsPageMsg) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// SendKFNewsPageMsgRequest_KFNewsPageMsg with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// SendKFNewsPageMsgRequest_KFNewsPageMsgMultiError, or nil if none found.
+func (m *SendKFNewsPageMsgRequest_KFNewsPageMsg) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendKFNewsPageMsgRequest_KFNewsPageMsg) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MediaId
+
+	if len(errors) > 0 {
+		return SendKFNewsPageMsgRequest_KFNewsPageMsgMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendKFNewsPageMsgRequest_KFNewsPageMsgMultiError is an error wrapping
+// multiple validation errors returned by
+// SendKFNewsPageMsgRequest_KFNewsPageMsg.ValidateAll() if the designated
+// constraints aren't met.
+type SendKFNewsPageMsgRequest_KFNewsPageMsgMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendKFNewsPageMsgRequest_KFNewsPageMsgMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendKFNewsPageMsgRequest_KFNewsPageMsgMultiError) AllErrors() []error { return m }
+
+// SendKFNewsPageMsgRequest_KFNewsPageMsgValidationError is the validation
+// error returned by SendKFNewsPageMsgRequest_KFNewsPageMsg.Validate if the
+// designated constraints aren't met.
+type SendKFNewsPageMsgRequest_KFNewsPageMsgValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendKFNewsPageMsgRequest_KFNewsPageMsgValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendKFNewsPageMsgRequest_KFNewsPageMsgValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendKFNewsPageMsgRequest_KFNewsPageMsgValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendKFNewsPageMsgRequest_KFNewsPageMsgValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendKFNewsPageMsgRequest_KFNewsPageMsgValidationError) ErrorName() string {
+	return "SendKFNewsPageMsgRequest_KFNewsPageMsgValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendKFNewsPageMsgRequest_KFNewsPageMsgValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendKFNewsPageMsgRequest_KFNewsPageMsg.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendKFNewsPageMsgRequest_KFNewsPageMsgValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendKFNewsPageMsgRequest_KFNewsPageMsgValidationError{}
+
+// Validate checks the field values on SendKFNewsCardMsgRequest_KFNewsCardMsg
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *SendKFNewsCardMsgRequest_KFNewsCardMsg) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// SendKFNewsCardMsgRequest_KFNewsCardMsg with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// SendKFNewsCardMsgRequest_KFNewsCardMsgMultiError, or nil if none found.
+func (m *SendKFNewsCardMsgRequest_KFNewsCardMsg) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendKFNewsCardMsgRequest_KFNewsCardMsg) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	// no validation rules for Description
+
+	// no validation rules for Url
+
+	// no validation rules for PicUrl
+
+	if len(errors) > 0 {
+		return SendKFNewsCardMsgRequest_KFNewsCardMsgMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendKFNewsCardMsgRequest_KFNewsCardMsgMultiError is an error wrapping
+// multiple validation errors returned by
+// SendKFNewsCardMsgRequest_KFNewsCardMsg.ValidateAll() if the designated
+// constraints aren't met.
+type SendKFNewsCardMsgRequest_KFNewsCardMsgMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendKFNewsCardMsgRequest_KFNewsCardMsgMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendKFNewsCardMsgRequest_KFNewsCardMsgMultiError) AllErrors() []error { return m }
+
+// SendKFNewsCardMsgRequest_KFNewsCardMsgValidationError is the validation
+// error returned by SendKFNewsCardMsgRequest_KFNewsCardMsg.Validate if the
+// designated constraints aren't met.
+type SendKFNewsCardMsgRequest_KFNewsCardMsgValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendKFNewsCardMsgRequest_KFNewsCardMsgValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendKFNewsCardMsgRequest_KFNewsCardMsgValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendKFNewsCardMsgRequest_KFNewsCardMsgValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendKFNewsCardMsgRequest_KFNewsCardMsgValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendKFNewsCardMsgRequest_KFNewsCardMsgValidationError) ErrorName() string {
+	return "SendKFNewsCardMsgRequest_KFNewsCardMsgValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendKFNewsCardMsgRequest_KFNewsCardMsgValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendKFNewsCardMsgRequest_KFNewsCardMsg.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendKFNewsCardMsgRequest_KFNewsCardMsgValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendKFNewsCardMsgRequest_KFNewsCardMsgValidationError{}
+
+// Validate checks the field values on SendKFMusicMsgRequest_KFMusicMsg with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SendKFMusicMsgRequest_KFMusicMsg) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendKFMusicMsgRequest_KFMusicMsg with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SendKFMusicMsgRequest_KFMusicMsgMultiError, or nil if none found.
+func (m *SendKFMusicMsgRequest_KFMusicMsg) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendKFMusicMsgRequest_KFMusicMsg) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MusicUrl
+
+	// no validation rules for HQMusicUrl
+
+	// no validation rules for ThumbMediaId
+
+	// no validation rules for Title
+
+	// no validation rules for Description
+
+	if len(errors) > 0 {
+		return SendKFMusicMsgRequest_KFMusicMsgMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendKFMusicMsgRequest_KFMusicMsgMultiError is an error wrapping multiple
+// validation errors returned by
+// SendKFMusicMsgRequest_KFMusicMsg.ValidateAll() if the designated
+// constraints aren't met.
+type SendKFMusicMsgRequest_KFMusicMsgMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendKFMusicMsgRequest_KFMusicMsgMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendKFMusicMsgRequest_KFMusicMsgMultiError) AllErrors() []error { return m }
+
+// SendKFMusicMsgRequest_KFMusicMsgValidationError is the validation error
+// returned by SendKFMusicMsgRequest_KFMusicMsg.Validate if the designated
+// constraints aren't met.
+type SendKFMusicMsgRequest_KFMusicMsgValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendKFMusicMsgRequest_KFMusicMsgValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendKFMusicMsgRequest_KFMusicMsgValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendKFMusicMsgRequest_KFMusicMsgValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendKFMusicMsgRequest_KFMusicMsgValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendKFMusicMsgRequest_KFMusicMsgValidationError) ErrorName() string {
+	return "SendKFMusicMsgRequest_KFMusicMsgValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendKFMusicMsgRequest_KFMusicMsgValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendKFMusicMsgRequest_KFMusicMsg.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendKFMusicMsgRequest_KFMusicMsgValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendKFMusicMsgRequest_KFMusicMsgValidationError{}
+
+// Validate checks the field values on SendKFVideoMsgRequest_KFVideoMsg with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SendKFVideoMsgRequest_KFVideoMsg) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendKFVideoMsgRequest_KFVideoMsg with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SendKFVideoMsgRequest_KFVideoMsgMultiError, or nil if none found.
+func (m *SendKFVideoMsgRequest_KFVideoMsg) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendKFVideoMsgRequest_KFVideoMsg) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MediaId
+
+	// no validation rules for ThumbMediaId
+
+	// no validation rules for Title
+
+	// no validation rules for Description
+
+	if len(errors) > 0 {
+		return SendKFVideoMsgRequest_KFVideoMsgMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendKFVideoMsgRequest_KFVideoMsgMultiError is an error wrapping multiple
+// validation errors returned by
+// SendKFVideoMsgRequest_KFVideoMsg.ValidateAll() if the designated
+// constraints aren't met.
+type SendKFVideoMsgRequest_KFVideoMsgMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendKFVideoMsgRequest_KFVideoMsgMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendKFVideoMsgRequest_KFVideoMsgMultiError) AllErrors() []error { return m }
+
+// SendKFVideoMsgRequest_KFVideoMsgValidationError is the validation error
+// returned by SendKFVideoMsgRequest_KFVideoMsg.Validate if the designated
+// constraints aren't met.
+type SendKFVideoMsgRequest_KFVideoMsgValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendKFVideoMsgRequest_KFVideoMsgValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendKFVideoMsgRequest_KFVideoMsgValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendKFVideoMsgRequest_KFVideoMsgValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendKFVideoMsgRequest_KFVideoMsgValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendKFVideoMsgRequest_KFVideoMsgValidationError) ErrorName() string {
+	return "SendKFVideoMsgRequest_KFVideoMsgValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendKFVideoMsgRequest_KFVideoMsgValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendKFVideoMsgRequest_KFVideoMsg.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendKFVideoMsgRequest_KFVideoMsgValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendKFVideoMsgRequest_KFVideoMsgValidationError{}
+
+// Validate checks the field values on SendKFVoiceMsgRequest_KFVoiceMsg with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SendKFVoiceMsgRequest_KFVoiceMsg) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendKFVoiceMsgRequest_KFVoiceMsg with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SendKFVoiceMsgRequest_KFVoiceMsgMultiError, or nil if none found.
+func (m *SendKFVoiceMsgRequest_KFVoiceMsg) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendKFVoiceMsgRequest_KFVoiceMsg) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MediaId
+
+	if len(errors) > 0 {
+		return SendKFVoiceMsgRequest_KFVoiceMsgMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendKFVoiceMsgRequest_KFVoiceMsgMultiError is an error wrapping multiple
+// validation errors returned by
+// SendKFVoiceMsgRequest_KFVoiceMsg.ValidateAll() if the designated
+// constraints aren't met.
+type SendKFVoiceMsgRequest_KFVoiceMsgMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendKFVoiceMsgRequest_KFVoiceMsgMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendKFVoiceMsgRequest_KFVoiceMsgMultiError) AllErrors() []error { return m }
+
+// SendKFVoiceMsgRequest_KFVoiceMsgValidationError is the validation error
+// returned by SendKFVoiceMsgRequest_KFVoiceMsg.Validate if the designated
+// constraints aren't met.
+type SendKFVoiceMsgRequest_KFVoiceMsgValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendKFVoiceMsgRequest_KFVoiceMsgValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendKFVoiceMsgRequest_KFVoiceMsgValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendKFVoiceMsgRequest_KFVoiceMsgValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendKFVoiceMsgRequest_KFVoiceMsgValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendKFVoiceMsgRequest_KFVoiceMsgValidationError) ErrorName() string {
+	return "SendKFVoiceMsgRequest_KFVoiceMsgValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendKFVoiceMsgRequest_KFVoiceMsgValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendKFVoiceMsgRequest_KFVoiceMsg.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendKFVoiceMsgRequest_KFVoiceMsgValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendKFVoiceMsgRequest_KFVoiceMsgValidationError{}
+
+// Validate checks the field values on SendKFImageMsgRequest_KFImageMsg with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SendKFImageMsgRequest_KFImageMsg) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendKFImageMsgRequest_KFImageMsg with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SendKFImageMsgRequest_KFImageMsgMultiError, or nil if none found.
+func (m *SendKFImageMsgRequest_KFImageMsg) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendKFImageMsgRequest_KFImageMsg) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MediaId
+
+	if len(errors) > 0 {
+		return SendKFImageMsgRequest_KFImageMsgMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendKFImageMsgRequest_KFImageMsgMultiError is an error wrapping multiple
+// validation errors returned by
+// SendKFImageMsgRequest_KFImageMsg.ValidateAll() if the designated
+// constraints aren't met.
+type SendKFImageMsgRequest_KFImageMsgMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendKFImageMsgRequest_KFImageMsgMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendKFImageMsgRequest_KFImageMsgMultiError) AllErrors() []error { return m }
+
+// SendKFImageMsgRequest_KFImageMsgValidationError is the validation error
+// returned by SendKFImageMsgRequest_KFImageMsg.Validate if the designated
+// constraints aren't met.
+type SendKFImageMsgRequest_KFImageMsgValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendKFImageMsgRequest_KFImageMsgValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendKFImageMsgRequest_KFImageMsgValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendKFImageMsgRequest_KFImageMsgValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendKFImageMsgRequest_KFImageMsgValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendKFImageMsgRequest_KFImageMsgValidationError) ErrorName() string {
+	return "SendKFImageMsgRequest_KFImageMsgValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendKFImageMsgRequest_KFImageMsgValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendKFImageMsgRequest_KFImageMsg.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendKFImageMsgRequest_KFImageMsgValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendKFImageMsgRequest_KFImageMsgValidationError{}
+
+// Validate checks the field values on KFMessageCommon_KFAccount with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *KFMessageCommon_KFAccount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KFMessageCommon_KFAccount with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// KFMessageCommon_KFAccountMultiError, or nil if none found.
+func (m *KFMessageCommon_KFAccount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KFMessageCommon_KFAccount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for KfAccount
+
+	if len(errors) > 0 {
+		return KFMessageCommon_KFAccountMultiError(errors)
+	}
+
+	return nil
+}
+
+// KFMessageCommon_KFAccountMultiError is an error wrapping multiple validation
+// errors returned by KFMessageCommon_KFAccount.ValidateAll() if the
+// designated constraints aren't met.
+type KFMessageCommon_KFAccountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KFMessageCommon_KFAccountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KFMessageCommon_KFAccountMultiError) AllErrors() []error { return m }
+
+// KFMessageCommon_KFAccountValidationError is the validation error returned by
+// KFMessageCommon_KFAccount.Validate if the designated constraints aren't met.
+type KFMessageCommon_KFAccountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KFMessageCommon_KFAccountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KFMessageCommon_KFAccountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KFMessageCommon_KFAccountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KFMessageCommon_KFAccountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KFMessageCommon_KFAccountValidationError) ErrorName() string {
+	return "KFMessageCommon_KFAccountValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e KFMessageCommon_KFAccountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKFMessageCommon_KFAccount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KFMessageCommon_KFAccountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KFMessageCommon_KFAccountValidationError{}
+
+// Validate checks the field values on SendKFTextMsgRequest_KFTextMsg with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendKFTextMsgRequest_KFTextMsg) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendKFTextMsgRequest_KFTextMsg with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SendKFTextMsgRequest_KFTextMsgMultiError, or nil if none found.
+func (m *SendKFTextMsgRequest_KFTextMsg) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendKFTextMsgRequest_KFTextMsg) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return SendKFTextMsgRequest_KFTextMsgMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendKFTextMsgRequest_KFTextMsgMultiError is an error wrapping multiple
+// validation errors returned by SendKFTextMsgRequest_KFTextMsg.ValidateAll()
+// if the designated constraints aren't met.
+type SendKFTextMsgRequest_KFTextMsgMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendKFTextMsgRequest_KFTextMsgMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendKFTextMsgRequest_KFTextMsgMultiError) AllErrors() []error { return m }
+
+// SendKFTextMsgRequest_KFTextMsgValidationError is the validation error
+// returned by SendKFTextMsgRequest_KFTextMsg.Validate if the designated
+// constraints aren't met.
+type SendKFTextMsgRequest_KFTextMsgValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendKFTextMsgRequest_KFTextMsgValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendKFTextMsgRequest_KFTextMsgValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendKFTextMsgRequest_KFTextMsgValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendKFTextMsgRequest_KFTextMsgValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendKFTextMsgRequest_KFTextMsgValidationError) ErrorName() string {
+	return "SendKFTextMsgRequest_KFTextMsgValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendKFTextMsgRequest_KFTextMsgValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendKFTextMsgRequest_KFTextMsg.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendKFTextMsgRequest_KFTextMsgValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendKFTextMsgRequest_KFTextMsgValidationError{}
+
+// Validate checks the field values on GetKFSessionUnacceptedReply_WaitCase
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GetKFSessionUnacceptedReply_WaitCase) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetKFSessionUnacceptedReply_WaitCase
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetKFSessionUnacceptedReply_WaitCaseMultiError, or nil if none found.
+func (m *GetKFSessionUnacceptedReply_WaitCase) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetKFSessionUnacceptedReply_WaitCase) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for LatestTime
+
+	// no validation rules for OpenId
+
+	if len(errors) > 0 {
+		return GetKFSessionUnacceptedReply_WaitCaseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetKFSessionUnacceptedReply_WaitCaseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetKFSessionUnacceptedReply_WaitCase.ValidateAll() if the designated
+// constraints aren't met.
+type GetKFSessionUnacceptedReply_WaitCaseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetKFSessionUnacceptedReply_WaitCaseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetKFSessionUnacceptedReply_WaitCaseMultiError) AllErrors() []error { return m }
+
+// GetKFSessionUnacceptedReply_WaitCaseValidationError is the validation error
+// returned by GetKFSessionUnacceptedReply_WaitCase.Validate if the designated
+// constraints aren't met.
+type GetKFSessionUnacceptedReply_WaitCaseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetKFSessionUnacceptedReply_WaitCaseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetKFSessionUnacceptedReply_WaitCaseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetKFSessionUnacceptedReply_WaitCaseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetKFSessionUnacceptedReply_WaitCaseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetKFSessionUnacceptedReply_WaitCaseValidationError) ErrorName() string {
+	return "GetKFSessionUnacceptedReply_WaitCaseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetKFSessionUnacceptedReply_WaitCaseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetKFSessionUnacceptedReply_WaitCase.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetKFSessionUnacceptedReply_WaitCaseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetKFSessionUnacceptedReply_WaitCaseValidationError{}
+
+// Validate checks the field values on SendSubscribeMessageRequest_DataItem
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *SendSubscribeMessageRequest_DataItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendSubscribeMessageRequest_DataItem
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SendSubscribeMessageRequest_DataItemMultiError, or nil if none found.
+func (m *SendSubscribeMessageRequest_DataItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendSubscribeMessageRequest_DataItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Value
+
+	if len(errors) > 0 {
+		return SendSubscribeMessageRequest_DataItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendSubscribeMessageRequest_DataItemMultiError is an error wrapping multiple
+// validation errors returned by
+// SendSubscribeMessageRequest_DataItem.ValidateAll() if the designated
+// constraints aren't met.
+type SendSubscribeMessageRequest_DataItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendSubscribeMessageRequest_DataItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendSubscribeMessageRequest_DataItemMultiError) AllErrors() []error { return m }
+
+// SendSubscribeMessageRequest_DataItemValidationError is the validation error
+// returned by SendSubscribeMessageRequest_DataItem.Validate if the designated
+// constraints aren't met.
+type SendSubscribeMessageRequest_DataItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendSubscribeMessageRequest_DataItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendSubscribeMessageRequest_DataItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendSubscribeMessageRequest_DataItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendSubscribeMessageRequest_DataItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendSubscribeMessageRequest_DataItemValidationError) ErrorName() string {
+	return "SendSubscribeMessageRequest_DataItemValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendSubscribeMessageRequest_DataItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendSubscribeMessageRequest_DataItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendSubscribeMessageRequest_DataItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendSubscribeMessageRequest_DataItemValidationError{}
+
+// Validate checks the field values on GetSubscribePrivateTplReply_Item with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetSubscribePrivateTplReply_Item) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSubscribePrivateTplReply_Item with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetSubscribePrivateTplReply_ItemMultiError, or nil if none found.
+func (m *GetSubscribePrivateTplReply_Item) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSubscribePrivateTplReply_Item) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PriTmplId
+
+	// no validation rules for Title
+
+	// no validation rules for Content
+
+	// no validation rules for Example
+
+	// no validation rules for Type
+
+	if len(errors) > 0 {
+		return GetSubscribePrivateTplReply_ItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSubscribePrivateTplReply_ItemMultiError is an error wrapping multiple
+// validation errors returned by
+// GetSubscribePrivateTplReply_Item.ValidateAll() if the designated
+// constraints aren't met.
+type GetSubscribePrivateTplReply_ItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSubscribePrivateTplReply_ItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSubscribePrivateTplReply_ItemMultiError) AllErrors() []error { return m }
+
+// GetSubscribePrivateTplReply_ItemValidationError is the validation error
+// returned by GetSubscribePrivateTplReply_Item.Validate if the designated
+// constraints aren't met.
+type GetSubscribePrivateTplReply_ItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSubscribePrivateTplReply_ItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSubscribePrivateTplReply_ItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSubscribePrivateTplReply_ItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSubscribePrivateTplReply_ItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSubscribePrivateTplReply_ItemValidationError) ErrorName() string {
+	return "GetSubscribePrivateTplReply_ItemValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSubscribePrivateTplReply_ItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSubscribePrivateTplReply_Item.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSubscribePrivateTplReply_ItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSubscribePrivateTplReply_ItemValidationError{}
+
+// Validate checks the field values on GetSubscribeTplTitlesReply_Item with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSubscribeTplTitlesReply_Item) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSubscribeTplTitlesReply_Item with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetSubscribeTplTitlesReply_ItemMultiError, or nil if none found.
+func (m *GetSubscribeTplTitlesReply_Item) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSubscribeTplTitlesReply_Item) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tid
+
+	// no validation rules for Title
+
+	// no validation rules for Type
+
+	// no validation rules for CategoryId
+
+	if len(errors) > 0 {
+		return GetSubscribeTplTitlesReply_ItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSubscribeTplTitlesReply_ItemMultiError is an error wrapping multiple
+// validation errors returned by GetSubscribeTplTitlesReply_Item.ValidateAll()
+// if the designated constraints aren't met.
+type GetSubscribeTplTitlesReply_ItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSubscribeTplTitlesReply_ItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSubscribeTplTitlesReply_ItemMultiError) AllErrors() []error { return m }
+
+// GetSubscribeTplTitlesReply_ItemValidationError is the validation error
+// returned by GetSubscribeTplTitlesReply_Item.Validate if the designated
+// constraints aren't met.
+type GetSubscribeTplTitlesReply_ItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSubscribeTplTitlesReply_ItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSubscribeTplTitlesReply_ItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSubscribeTplTitlesReply_ItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSubscribeTplTitlesReply_ItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSubscribeTplTitlesReply_ItemValidationError) ErrorName() string {
+	return "GetSubscribeTplTitlesReply_ItemValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSubscribeTplTitlesReply_ItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSubscribeTplTitlesReply_Item.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSubscribeTplTitlesReply_ItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSubscribeTplTitlesReply_ItemValidationError{}
+
+// Validate checks the field values on GetSubscribeTplKeywordsReply_Item with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetSubscribeTplKeywordsReply_Item) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSubscribeTplKeywordsReply_Item
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetSubscribeTplKeywordsReply_ItemMultiError, or nil if none found.
+func (m *GetSubscribeTplKeywordsReply_Item) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSubscribeTplKeywordsReply_Item) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kid
+
+	// no validation rules for Name
+
+	// no validation rules for Rule
+
+	// no validation rules for Example
+
+	if len(errors) > 0 {
+		return GetSubscribeTplKeywordsReply_ItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSubscribeTplKeywordsReply_ItemMultiError is an error wrapping multiple
+// validation errors returned by
+// GetSubscribeTplKeywordsReply_Item.ValidateAll() if the designated
+// constraints aren't met.
+type GetSubscribeTplKeywordsReply_ItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSubscribeTplKeywordsReply_ItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSubscribeTplKeywordsReply_ItemMultiError) AllErrors() []error { return m }
+
+// GetSubscribeTplKeywordsReply_ItemValidationError is the validation error
+// returned by GetSubscribeTplKeywordsReply_Item.Validate if the designated
+// constraints aren't met.
+type GetSubscribeTplKeywordsReply_ItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSubscribeTplKeywordsReply_ItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSubscribeTplKeywordsReply_ItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSubscribeTplKeywordsReply_ItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSubscribeTplKeywordsReply_ItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSubscribeTplKeywordsReply_ItemValidationError) ErrorName() string {
+	return "GetSubscribeTplKeywordsReply_ItemValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSubscribeTplKeywordsReply_ItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSubscribeTplKeywordsReply_Item.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSubscribeTplKeywordsReply_ItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSubscribeTplKeywordsReply_ItemValidationError{}
+
+// Validate checks the field values on GetSubscribeCategoryReply_Category with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetSubscribeCategoryReply_Category) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSubscribeCategoryReply_Category
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetSubscribeCategoryReply_CategoryMultiError, or nil if none found.
+func (m *GetSubscribeCategoryReply_Category) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSubscribeCategoryReply_Category) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return GetSubscribeCategoryReply_CategoryMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSubscribeCategoryReply_CategoryMultiError is an error wrapping multiple
+// validation errors returned by
+// GetSubscribeCategoryReply_Category.ValidateAll() if the designated
+// constraints aren't met.
+type GetSubscribeCategoryReply_CategoryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSubscribeCategoryReply_CategoryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSubscribeCategoryReply_CategoryMultiError) AllErrors() []error { return m }
+
+// GetSubscribeCategoryReply_CategoryValidationError is the validation error
+// returned by GetSubscribeCategoryReply_Category.Validate if the designated
+// constraints aren't met.
+type GetSubscribeCategoryReply_CategoryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSubscribeCategoryReply_CategoryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSubscribeCategoryReply_CategoryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSubscribeCategoryReply_CategoryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSubscribeCategoryReply_CategoryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSubscribeCategoryReply_CategoryValidationError) ErrorName() string {
+	return "GetSubscribeCategoryReply_CategoryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSubscribeCategoryReply_CategoryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSubscribeCategoryReply_Category.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSubscribeCategoryReply_CategoryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSubscribeCategoryReply_CategoryValidationError{}
+
+// Validate checks the field values on GetBlockedTplMsgReply_BlockedMsgInfo
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GetBlockedTplMsgReply_BlockedMsgInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBlockedTplMsgReply_BlockedMsgInfo
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetBlockedTplMsgReply_BlockedMsgInfoMultiError, or nil if none found.
+func (m *GetBlockedTplMsgReply_BlockedMsgInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBlockedTplMsgReply_BlockedMsgInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Openid
+
+	// no validation rules for TmplMsgId
+
+	// no validation rules for Title
+
+	// no validation rules for Content
+
+	// no validation rules for SendTimestamp
+
+	if len(errors) > 0 {
+		return GetBlockedTplMsgReply_BlockedMsgInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBlockedTplMsgReply_BlockedMsgInfoMultiError is an error wrapping multiple
+// validation errors returned by
+// GetBlockedTplMsgReply_BlockedMsgInfo.ValidateAll() if the designated
+// constraints aren't met.
+type GetBlockedTplMsgReply_BlockedMsgInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBlockedTplMsgReply_BlockedMsgInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBlockedTplMsgReply_BlockedMsgInfoMultiError) AllErrors() []error { return m }
+
+// GetBlockedTplMsgReply_BlockedMsgInfoValidationError is the validation error
+// returned by GetBlockedTplMsgReply_BlockedMsgInfo.Validate if the designated
+// constraints aren't met.
+type GetBlockedTplMsgReply_BlockedMsgInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBlockedTplMsgReply_BlockedMsgInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBlockedTplMsgReply_BlockedMsgInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBlockedTplMsgReply_BlockedMsgInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBlockedTplMsgReply_BlockedMsgInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBlockedTplMsgReply_BlockedMsgInfoValidationError) ErrorName() string {
+	return "GetBlockedTplMsgReply_BlockedMsgInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBlockedTplMsgReply_BlockedMsgInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBlockedTplMsgReply_BlockedMsgInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBlockedTplMsgReply_BlockedMsgInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBlockedTplMsgReply_BlockedMsgInfoValidationError{}
+
+// Validate checks the field values on SendSubscribeMsgRequest_DataItem with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SendSubscribeMsgRequest_DataItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendSubscribeMsgRequest_DataItem with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SendSubscribeMsgRequest_DataItemMultiError, or nil if none found.
+func (m *SendSubscribeMsgRequest_DataItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendSubscribeMsgRequest_DataItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Value
+
+	// no validation rules for Color
+
+	if len(errors) > 0 {
+		return SendSubscribeMsgRequest_DataItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendSubscribeMsgRequest_DataItemMultiError is an error wrapping multiple
+// validation errors returned by
+// SendSubscribeMsgRequest_DataItem.ValidateAll() if the designated
+// constraints aren't met.
+type SendSubscribeMsgRequest_DataItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendSubscribeMsgRequest_DataItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendSubscribeMsgRequest_DataItemMultiError) AllErrors() []error { return m }
+
+// SendSubscribeMsgRequest_DataItemValidationError is the validation error
+// returned by SendSubscribeMsgRequest_DataItem.Validate if the designated
+// constraints aren't met.
+type SendSubscribeMsgRequest_DataItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendSubscribeMsgRequest_DataItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendSubscribeMsgRequest_DataItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendSubscribeMsgRequest_DataItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendSubscribeMsgRequest_DataItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendSubscribeMsgRequest_DataItemValidationError) ErrorName() string {
+	return "SendSubscribeMsgRequest_DataItemValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendSubscribeMsgRequest_DataItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendSubscribeMsgRequest_DataItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendSubscribeMsgRequest_DataItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendSubscribeMsgRequest_DataItemValidationError{}
+
+// Validate checks the field values on SendTplMsgRequest_DataItem with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendTplMsgRequest_DataItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendTplMsgRequest_DataItem with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendTplMsgRequest_DataItemMultiError, or nil if none found.
+func (m *SendTplMsgRequest_DataItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendTplMsgRequest_DataItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Value
+
+	// no validation rules for Color
+
+	if len(errors) > 0 {
+		return SendTplMsgRequest_DataItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendTplMsgRequest_DataItemMultiError is an error wrapping multiple
+// validation errors returned by SendTplMsgRequest_DataItem.ValidateAll() if
+// the designated constraints aren't met.
+type SendTplMsgRequest_DataItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendTplMsgRequest_DataItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendTplMsgRequest_DataItemMultiError) AllErrors() []error { return m }
+
+// SendTplMsgRequest_DataItemValidationError is the validation error returned
+// by SendTplMsgRequest_DataItem.Validate if the designated constraints aren't met.
+type SendTplMsgRequest_DataItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendTplMsgRequest_DataItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendTplMsgRequest_DataItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendTplMsgRequest_DataItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendTplMsgRequest_DataItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendTplMsgRequest_DataItemValidationError) ErrorName() string {
+	return "SendTplMsgRequest_DataItemValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendTplMsgRequest_DataItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendTplMsgRequest_DataItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendTplMsgRequest_DataItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendTplMsgRequest_DataItemValidationError{}
+
+// Validate checks the field values on GetAllPrivateTplReply_TplInfo with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAllPrivateTplReply_TplInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAllPrivateTplReply_TplInfo with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetAllPrivateTplReply_TplInfoMultiError, or nil if none found.
+func (m *GetAllPrivateTplReply_TplInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAllPrivateTplReply_TplInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TemplateId
+
+	// no validation rules for Title
+
+	// no validation rules for Content
+
+	// no validation rules for Example
+
+	// no validation rules for PrimaryIndustry
+
+	// no validation rules for SecondaryIndustry
+
+	if len(errors) > 0 {
+		return GetAllPrivateTplReply_TplInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAllPrivateTplReply_TplInfoMultiError is an error wrapping multiple
+// validation errors returned by GetAllPrivateTplReply_TplInfo.ValidateAll()
+// if the designated constraints aren't met.
+type GetAllPrivateTplReply_TplInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAllPrivateTplReply_TplInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAllPrivateTplReply_TplInfoMultiError) AllErrors() []error { return m }
+
+// GetAllPrivateTplReply_TplInfoValidationError is the validation error
+// returned by GetAllPrivateTplReply_TplInfo.Validate if the designated
+// constraints aren't met.
+type GetAllPrivateTplReply_TplInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAllPrivateTplReply_TplInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAllPrivateTplReply_TplInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAllPrivateTplReply_TplInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAllPrivateTplReply_TplInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAllPrivateTplReply_TplInfoValidationError) ErrorName() string {
+	return "GetAllPrivateTplReply_TplInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAllPrivateTplReply_TplInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAllPrivateTplReply_TplInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAllPrivateTplReply_TplInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAllPrivateTplReply_TplInfoValidationError{}
+
+// Validate checks the field values on GetIndustryReply_Industry with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetIndustryReply_Industry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetIndustryReply_Industry with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetIndustryReply_IndustryMultiError, or nil if none found.
+func (m *GetIndustryReply_Industry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetIndustryReply_Industry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FirstClass
+
+	// no validation rules for SecondClass
+
+	if len(errors) > 0 {
+		return GetIndustryReply_IndustryMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetIndustryReply_IndustryMultiError is an error wrapping multiple validation
+// errors returned by GetIndustryReply_Industry.ValidateAll() if the
+// designated constraints aren't met.
+type GetIndustryReply_IndustryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetIndustryReply_IndustryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetIndustryReply_IndustryMultiError) AllErrors() []error { return m }
+
+// GetIndustryReply_IndustryValidationError is the validation error returned by
+// GetIndustryReply_Industry.Validate if the designated constraints aren't met.
+type GetIndustryReply_IndustryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetIndustryReply_IndustryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetIndustryReply_IndustryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetIndustryReply_IndustryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetIndustryReply_IndustryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetIndustryReply_IndustryValidationError) ErrorName() string {
+	return "GetIndustryReply_IndustryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetIndustryReply_IndustryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetIndustryReply_Industry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetIndustryReply_IndustryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetIndustryReply_IndustryValidationError{}
+
+// Validate checks the field values on SelfMenuReply_MenuInfoType with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SelfMenuReply_MenuInfoType) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SelfMenuReply_MenuInfoType with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SelfMenuReply_MenuInfoTypeMultiError, or nil if none found.
+func (m *SelfMenuReply_MenuInfoType) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SelfMenuReply_MenuInfoType) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetButton() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SelfMenuReply_MenuInfoTypeValidationError{
+						field:  fmt.Sprintf("Button[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SelfMenuReply_MenuInfoTypeValidationError{
+						field:  fmt.Sprintf("Button[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SelfMenuReply_MenuInfoTypeValidationError{
+					field:  fmt.Sprintf("Button[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SelfMenuReply_MenuInfoTypeMultiError(errors)
+	}
+
+	return nil
+}
+
+// SelfMenuReply_MenuInfoTypeMultiError is an error wrapping multiple
+// validation errors returned by SelfMenuReply_MenuInfoType.ValidateAll() if
+// the designated constraints aren't met.
+type SelfMenuReply_MenuInfoTypeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SelfMenuReply_MenuInfoTypeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SelfMenuReply_MenuInfoTypeMultiError) AllErrors() []error { return m }
+
+// SelfMenuReply_MenuInfoTypeValidationError is the validation error returned
+// by SelfMenuReply_MenuInfoType.Validate if the designated constraints aren't met.
+type SelfMenuReply_MenuInfoTypeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SelfMenuReply_MenuInfoTypeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SelfMenuReply_MenuInfoTypeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SelfMenuReply_MenuInfoTypeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SelfMenuReply_MenuInfoTypeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SelfMenuReply_MenuInfoTypeValidationError) ErrorName() string {
+	return "SelfMenuReply_MenuInfoTypeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SelfMenuReply_MenuInfoTypeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSelfMenuReply_MenuInfoType.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SelfMenuReply_MenuInfoTypeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SelfMenuReply_MenuInfoTypeValidationError{}
+
+// Validate checks the field values on SelfMenuButton_SubButtonType with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SelfMenuButton_SubButtonType) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SelfMenuButton_SubButtonType with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SelfMenuButton_SubButtonTypeMultiError, or nil if none found.
+func (m *SelfMenuButton_SubButtonType) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SelfMenuButton_SubButtonType) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SelfMenuButton_SubButtonTypeValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SelfMenuButton_SubButtonTypeValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SelfMenuButton_SubButtonTypeValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SelfMenuButton_SubButtonTypeMultiError(errors)
+	}
+
+	return nil
+}
+
+// SelfMenuButton_SubButtonTypeMultiError is an error wrapping multiple
+// validation errors returned by SelfMenuButton_SubButtonType.ValidateAll() if
+// the designated constraints aren't met.
+type SelfMenuButton_SubButtonTypeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SelfMenuButton_SubButtonTypeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SelfMenuButton_SubButtonTypeMultiError) AllErrors() []error { return m }
+
+// SelfMenuButton_SubButtonTypeValidationError is the validation error returned
+// by SelfMenuButton_SubButtonType.Validate if the designated constraints
+// aren't met.
+type SelfMenuButton_SubButtonTypeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SelfMenuButton_SubButtonTypeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SelfMenuButton_SubButtonTypeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SelfMenuButton_SubButtonTypeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SelfMenuButton_SubButtonTypeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SelfMenuButton_SubButtonTypeValidationError) ErrorName() string {
+	return "SelfMenuButton_SubButtonTypeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SelfMenuButton_SubButtonTypeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSelfMenuButton_SubButtonType.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SelfMenuButton_SubButtonTypeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SelfMenuButton_SubButtonTypeValidationError{}
+
+// Validate checks the field values on SelfMenuButton_NewsButtonType with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SelfMenuButton_NewsButtonType) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SelfMenuButton_NewsButtonType with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SelfMenuButton_NewsButtonTypeMultiError, or nil if none found.
+func (m *SelfMenuButton_NewsButtonType) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SelfMenuButton_NewsButtonType) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SelfMenuButton_NewsButtonTypeValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SelfMenuButton_NewsButtonTypeValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SelfMenuButton_NewsButtonTypeValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SelfMenuButton_NewsButtonTypeMultiError(errors)
+	}
+
+	return nil
+}
+
+// SelfMenuButton_NewsButtonTypeMultiError is an error wrapping multiple
+// validation errors returned by SelfMenuButton_NewsButtonType.ValidateAll()
+// if the designated constraints aren't met.
+type SelfMenuButton_NewsButtonTypeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SelfMenuButton_NewsButtonTypeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SelfMenuButton_NewsButtonTypeMultiError) AllErrors() []error { return m }
+
+// SelfMenuButton_NewsButtonTypeValidationError is the validation error
+// returned by SelfMenuButton_NewsButtonType.Validate if the designated
+// constraints aren't met.
+type SelfMenuButton_NewsButtonTypeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SelfMenuButton_NewsButtonTypeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SelfMenuButton_NewsButtonTypeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SelfMenuButton_NewsButtonTypeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SelfMenuButton_NewsButtonTypeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SelfMenuButton_NewsButtonTypeValidationError) ErrorName() string {
+	return "SelfMenuButton_NewsButtonTypeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SelfMenuButton_NewsButtonTypeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSelfMenuButton_NewsButtonType.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SelfMenuButton_NewsButtonTypeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SelfMenuButton_NewsButtonTypeValidationError{}
+
+// Validate checks the field values on MenuInfoReply_MenuType with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MenuInfoReply_MenuType) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MenuInfoReply_MenuType with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MenuInfoReply_MenuTypeMultiError, or nil if none found.
+func (m *MenuInfoReply_MenuType) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MenuInfoReply_MenuType) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Menuid
+
+	for idx, item := range m.GetButton() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MenuInfoReply_MenuTypeValidationError{
+						field:  fmt.Sprintf("Button[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MenuInfoReply_MenuTypeValidationError{
+						field:  fmt.Sprintf("Button[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MenuInfoReply_MenuTypeValidationError{
+					field:  fmt.Sprintf("Button[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MenuInfoReply_MenuTypeMultiError(errors)
+	}
+
+	return nil
+}
+
+// MenuInfoReply_MenuTypeMultiError is an error wrapping multiple validation
+// errors returned by MenuInfoReply_MenuType.ValidateAll() if the designated
+// constraints aren't met.
+type MenuInfoReply_MenuTypeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MenuInfoReply_MenuTypeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MenuInfoReply_MenuTypeMultiError) AllErrors() []error { return m }
+
+// MenuInfoReply_MenuTypeValidationError is the validation error returned by
+// MenuInfoReply_MenuType.Validate if the designated constraints aren't met.
+type MenuInfoReply_MenuTypeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MenuInfoReply_MenuTypeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MenuInfoReply_MenuTypeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MenuInfoReply_MenuTypeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MenuInfoReply_MenuTypeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MenuInfoReply_MenuTypeValidationError) ErrorName() string {
+	return "MenuInfoReply_MenuTypeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MenuInfoReply_MenuTypeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMenuInfoReply_MenuType.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MenuInfoReply_MenuTypeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MenuInfoReply_MenuTypeValidationError{}
+
+// Validate checks the field values on GetTagMembersReply_DataT with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTagMembersReply_DataT) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTagMembersReply_DataT with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTagMembersReply_DataTMultiError, or nil if none found.
+func (m *GetTagMembersReply_DataT) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTagMembersReply_DataT) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetTagMembersReply_DataTMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTagMembersReply_DataTMultiError is an error wrapping multiple validation
+// errors returned by GetTagMembersReply_DataT.ValidateAll() if the designated
+// constraints aren't met.
+type GetTagMembersReply_DataTMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTagMembersReply_DataTMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTagMembersReply_DataTMultiError) AllErrors() []error { return m }
+
+// GetTagMembersReply_DataTValidationError is the validation error returned by
+// GetTagMembersReply_DataT.Validate if the designated constraints aren't met.
+type GetTagMembersReply_DataTValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTagMembersReply_DataTValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTagMembersReply_DataTValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTagMembersReply_DataTValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTagMembersReply_DataTValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTagMembersReply_DataTValidationError) ErrorName() string {
+	return "GetTagMembersReply_DataTValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTagMembersReply_DataTValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTagMembersReply_DataT.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTagMembersReply_DataTValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTagMembersReply_DataTValidationError{}
+
+// Validate checks the field values on BatchGetMemberInfoRequest_OpenIdList
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *BatchGetMemberInfoRequest_OpenIdList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetMemberInfoRequest_OpenIdList
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// BatchGetMemberInfoRequest_OpenIdListMultiError, or nil if none found.
+func (m *BatchGetMemberInfoRequest_OpenIdList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetMemberInfoRequest_OpenIdList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Openid
+
+	if len(errors) > 0 {
+		return BatchGetMemberInfoRequest_OpenIdListMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetMemberInfoRequest_OpenIdListMultiError is an error wrapping multiple
+// validation errors returned by
+// BatchGetMemberInfoRequest_OpenIdList.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetMemberInfoRequest_OpenIdListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetMemberInfoRequest_OpenIdListMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetMemberInfoRequest_OpenIdListMultiError) AllErrors() []error { return m }
+
+// BatchGetMemberInfoRequest_OpenIdListValidationError is the validation error
+// returned by BatchGetMemberInfoRequest_OpenIdList.Validate if the designated
+// constraints aren't met.
+type BatchGetMemberInfoRequest_OpenIdListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetMemberInfoRequest_OpenIdListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetMemberInfoRequest_OpenIdListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetMemberInfoRequest_OpenIdListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetMemberInfoRequest_OpenIdListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetMemberInfoRequest_OpenIdListValidationError) ErrorName() string {
+	return "BatchGetMemberInfoRequest_OpenIdListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetMemberInfoRequest_OpenIdListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetMemberInfoRequest_OpenIdList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetMemberInfoRequest_OpenIdListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetMemberInfoRequest_OpenIdListValidationError{}
+
+// Validate checks the field values on GetMemberListReply_IdList with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMemberListReply_IdList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMemberListReply_IdList with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMemberListReply_IdListMultiError, or nil if none found.
+func (m *GetMemberListReply_IdList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMemberListReply_IdList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOpenid() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetMemberListReply_IdListValidationError{
+						field:  fmt.Sprintf("Openid[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetMemberListReply_IdListValidationError{
+						field:  fmt.Sprintf("Openid[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetMemberListReply_IdListValidationError{
+					field:  fmt.Sprintf("Openid[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetMemberListReply_IdListMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMemberListReply_IdListMultiError is an error wrapping multiple validation
+// errors returned by GetMemberListReply_IdList.ValidateAll() if the
+// designated constraints aren't met.
+type GetMemberListReply_IdListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMemberListReply_IdListMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMemberListReply_IdListMultiError) AllErrors() []error { return m }
+
+// GetMemberListReply_IdListValidationError is the validation error returned by
+// GetMemberListReply_IdList.Validate if the designated constraints aren't met.
+type GetMemberListReply_IdListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMemberListReply_IdListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMemberListReply_IdListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMemberListReply_IdListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMemberListReply_IdListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMemberListReply_IdListValidationError) ErrorName() string {
+	return "GetMemberListReply_IdListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMemberListReply_IdListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMemberListReply_IdList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMemberListReply_IdListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMemberListReply_IdListValidationError{}
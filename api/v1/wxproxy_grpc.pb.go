@@ -89,6 +89,14 @@ const (
 	Mpproxy_BlockMember_FullMethodName             = "/api.wxproxy.v1.Mpproxy/BlockMember"
 	Mpproxy_UnBlockMember_FullMethodName           = "/api.wxproxy.v1.Mpproxy/UnBlockMember"
 	Mpproxy_GetBlacklist_FullMethodName            = "/api.wxproxy.v1.Mpproxy/GetBlacklist"
+	Mpproxy_GetAccessToken_FullMethodName          = "/api.wxproxy.v1.Mpproxy/GetAccessToken"
+	Mpproxy_RefreshAccessToken_FullMethodName      = "/api.wxproxy.v1.Mpproxy/RefreshAccessToken"
+	Mpproxy_DescribeErrorCode_FullMethodName       = "/api.wxproxy.v1.Mpproxy/DescribeErrorCode"
+	Mpproxy_GetRidInfo_FullMethodName              = "/api.wxproxy.v1.Mpproxy/GetRidInfo"
+	Mpproxy_GetApiQuota_FullMethodName             = "/api.wxproxy.v1.Mpproxy/GetApiQuota"
+	Mpproxy_ClearQuota_FullMethodName              = "/api.wxproxy.v1.Mpproxy/ClearQuota"
+	Mpproxy_ClearQuotaV2_FullMethodName            = "/api.wxproxy.v1.Mpproxy/ClearQuotaV2"
+	Mpproxy_GetQuotaUsage_FullMethodName           = "/api.wxproxy.v1.Mpproxy/GetQuotaUsage"
 )
 
 // MpproxyClient is the client API for Mpproxy service.
@@ -169,6 +177,22 @@ type MpproxyClient interface {
 	BlockMember(ctx context.Context, in *BlockMemberReq, opts ...grpc.CallOption) (*WXErrorReply, error)
 	UnBlockMember(ctx context.Context, in *BlockMemberReq, opts ...grpc.CallOption) (*WXErrorReply, error)
 	GetBlacklist(ctx context.Context, in *GetBlacklistReq, opts ...grpc.CallOption) (*GetBlacklistReply, error)
+	// GetAccessToken 获取托管的AccessToken, 调用方须经过认证(API Key或mTLS)且在账号的clients中
+	GetAccessToken(ctx context.Context, in *GetAccessTokenRequest, opts ...grpc.CallOption) (*GetAccessTokenReply, error)
+	// RefreshAccessToken 刷新托管的AccessToken(管理接口)
+	RefreshAccessToken(ctx context.Context, in *RefreshAccessTokenRequest, opts ...grpc.CallOption) (*RefreshAccessTokenReply, error)
+	// DescribeErrorCode 查询微信错误码的说明
+	DescribeErrorCode(ctx context.Context, in *DescribeErrorCodeRequest, opts ...grpc.CallOption) (*DescribeErrorCodeReply, error)
+	// GetRidInfo 查询微信接口错误信息中rid对应的请求详情
+	GetRidInfo(ctx context.Context, in *GetRidInfoRequest, opts ...grpc.CallOption) (*GetRidInfoReply, error)
+	// GetApiQuota 查询接口的每日调用额度
+	GetApiQuota(ctx context.Context, in *GetApiQuotaRequest, opts ...grpc.CallOption) (*GetApiQuotaReply, error)
+	// ClearQuota 使用AccessToken重置公众号所有接口的调用次数(管理接口)
+	ClearQuota(ctx context.Context, in *ClearQuotaRequest, opts ...grpc.CallOption) (*WXErrorReply, error)
+	// ClearQuotaV2 使用托管的AppSecret重置公众号所有接口的调用次数(管理接口)
+	ClearQuotaV2(ctx context.Context, in *ClearQuotaV2Request, opts ...grpc.CallOption) (*WXErrorReply, error)
+	// GetQuotaUsage 查询WXProxy记录的账号每日接口调用次数
+	GetQuotaUsage(ctx context.Context, in *GetQuotaUsageRequest, opts ...grpc.CallOption) (*GetQuotaUsageReply, error)
 }
 
 type mpproxyClient struct {
@@ -897,6 +921,86 @@ func (c *mpproxyClient) GetBlacklist(ctx context.Context, in *GetBlacklistReq, o
 	return out, nil
 }
 
+func (c *mpproxyClient) GetAccessToken(ctx context.Context, in *GetAccessTokenRequest, opts ...grpc.CallOption) (*GetAccessTokenReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccessTokenReply)
+	err := c.cc.Invoke(ctx, Mpproxy_GetAccessToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mpproxyClient) RefreshAccessToken(ctx context.Context, in *RefreshAccessTokenRequest, opts ...grpc.CallOption) (*RefreshAccessTokenReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshAccessTokenReply)
+	err := c.cc.Invoke(ctx, Mpproxy_RefreshAccessToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mpproxyClient) DescribeErrorCode(ctx context.Context, in *DescribeErrorCodeRequest, opts ...grpc.CallOption) (*DescribeErrorCodeReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DescribeErrorCodeReply)
+	err := c.cc.Invoke(ctx, Mpproxy_DescribeErrorCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mpproxyClient) GetRidInfo(ctx context.Context, in *GetRidInfoRequest, opts ...grpc.CallOption) (*GetRidInfoReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRidInfoReply)
+	err := c.cc.Invoke(ctx, Mpproxy_GetRidInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mpproxyClient) GetApiQuota(ctx context.Context, in *GetApiQuotaRequest, opts ...grpc.CallOption) (*GetApiQuotaReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetApiQuotaReply)
+	err := c.cc.Invoke(ctx, Mpproxy_GetApiQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mpproxyClient) ClearQuota(ctx context.Context, in *ClearQuotaRequest, opts ...grpc.CallOption) (*WXErrorReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WXErrorReply)
+	err := c.cc.Invoke(ctx, Mpproxy_ClearQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mpproxyClient) ClearQuotaV2(ctx context.Context, in *ClearQuotaV2Request, opts ...grpc.CallOption) (*WXErrorReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WXErrorReply)
+	err := c.cc.Invoke(ctx, Mpproxy_ClearQuotaV2_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mpproxyClient) GetQuotaUsage(ctx context.Context, in *GetQuotaUsageRequest, opts ...grpc.CallOption) (*GetQuotaUsageReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQuotaUsageReply)
+	err := c.cc.Invoke(ctx, Mpproxy_GetQuotaUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MpproxyServer is the server API for Mpproxy service.
 // All implementations must embed UnimplementedMpproxyServer
 // for forward compatibility.
@@ -975,6 +1079,22 @@ type MpproxyServer interface {
 	BlockMember(context.Context, *BlockMemberReq) (*WXErrorReply, error)
 	UnBlockMember(context.Context, *BlockMemberReq) (*WXErrorReply, error)
 	GetBlacklist(context.Context, *GetBlacklistReq) (*GetBlacklistReply, error)
+	// GetAccessToken 获取托管的AccessToken, 调用方须经过认证(API Key或mTLS)且在账号的clients中
+	GetAccessToken(context.Context, *GetAccessTokenRequest) (*GetAccessTokenReply, error)
+	// RefreshAccessToken 刷新托管的AccessToken(管理接口)
+	RefreshAccessToken(context.Context, *RefreshAccessTokenRequest) (*RefreshAccessTokenReply, error)
+	// DescribeErrorCode 查询微信错误码的说明
+	DescribeErrorCode(context.Context, *DescribeErrorCodeRequest) (*DescribeErrorCodeReply, error)
+	// GetRidInfo 查询微信接口错误信息中rid对应的请求详情
+	GetRidInfo(context.Context, *GetRidInfoRequest) (*GetRidInfoReply, error)
+	// GetApiQuota 查询接口的每日调用额度
+	GetApiQuota(context.Context, *GetApiQuotaRequest) (*GetApiQuotaReply, error)
+	// ClearQuota 使用AccessToken重置公众号所有接口的调用次数(管理接口)
+	ClearQuota(context.Context, *ClearQuotaRequest) (*WXErrorReply, error)
+	// ClearQuotaV2 使用托管的AppSecret重置公众号所有接口的调用次数(管理接口)
+	ClearQuotaV2(context.Context, *ClearQuotaV2Request) (*WXErrorReply, error)
+	// GetQuotaUsage 查询WXProxy记录的账号每日接口调用次数
+	GetQuotaUsage(context.Context, *GetQuotaUsageRequest) (*GetQuotaUsageReply, error)
 	mustEmbedUnimplementedMpproxyServer()
 }
 
@@ -1195,6 +1315,30 @@ func (UnimplementedMpproxyServer) UnBlockMember(context.Context, *BlockMemberReq
 func (UnimplementedMpproxyServer) GetBlacklist(context.Context, *GetBlacklistReq) (*GetBlacklistReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlacklist not implemented")
 }
+func (UnimplementedMpproxyServer) GetAccessToken(context.Context, *GetAccessTokenRequest) (*GetAccessTokenReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccessToken not implemented")
+}
+func (UnimplementedMpproxyServer) RefreshAccessToken(context.Context, *RefreshAccessTokenRequest) (*RefreshAccessTokenReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshAccessToken not implemented")
+}
+func (UnimplementedMpproxyServer) DescribeErrorCode(context.Context, *DescribeErrorCodeRequest) (*DescribeErrorCodeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeErrorCode not implemented")
+}
+func (UnimplementedMpproxyServer) GetRidInfo(context.Context, *GetRidInfoRequest) (*GetRidInfoReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRidInfo not implemented")
+}
+func (UnimplementedMpproxyServer) GetApiQuota(context.Context, *GetApiQuotaRequest) (*GetApiQuotaReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApiQuota not implemented")
+}
+func (UnimplementedMpproxyServer) ClearQuota(context.Context, *ClearQuotaRequest) (*WXErrorReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearQuota not implemented")
+}
+func (UnimplementedMpproxyServer) ClearQuotaV2(context.Context, *ClearQuotaV2Request) (*WXErrorReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearQuotaV2 not implemented")
+}
+func (UnimplementedMpproxyServer) GetQuotaUsage(context.Context, *GetQuotaUsageRequest) (*GetQuotaUsageReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuotaUsage not implemented")
+}
 func (UnimplementedMpproxyServer) mustEmbedUnimplementedMpproxyServer() {}
 func (UnimplementedMpproxyServer) testEmbeddedByValue()                 {}
 
@@ -2462,6 +2606,150 @@ func _Mpproxy_GetBlacklist_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Mpproxy_GetAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MpproxyServer).GetAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Mpproxy_GetAccessToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MpproxyServer).GetAccessToken(ctx, req.(*GetAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mpproxy_RefreshAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MpproxyServer).RefreshAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Mpproxy_RefreshAccessToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MpproxyServer).RefreshAccessToken(ctx, req.(*RefreshAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mpproxy_DescribeErrorCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeErrorCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MpproxyServer).DescribeErrorCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Mpproxy_DescribeErrorCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MpproxyServer).DescribeErrorCode(ctx, req.(*DescribeErrorCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mpproxy_GetRidInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRidInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MpproxyServer).GetRidInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Mpproxy_GetRidInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MpproxyServer).GetRidInfo(ctx, req.(*GetRidInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mpproxy_GetApiQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApiQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MpproxyServer).GetApiQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Mpproxy_GetApiQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MpproxyServer).GetApiQuota(ctx, req.(*GetApiQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mpproxy_ClearQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MpproxyServer).ClearQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Mpproxy_ClearQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MpproxyServer).ClearQuota(ctx, req.(*ClearQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mpproxy_ClearQuotaV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearQuotaV2Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MpproxyServer).ClearQuotaV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Mpproxy_ClearQuotaV2_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MpproxyServer).ClearQuotaV2(ctx, req.(*ClearQuotaV2Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mpproxy_GetQuotaUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuotaUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MpproxyServer).GetQuotaUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Mpproxy_GetQuotaUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MpproxyServer).GetQuotaUsage(ctx, req.(*GetQuotaUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Mpproxy_ServiceDesc is the grpc.ServiceDesc for Mpproxy service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2741,6 +3029,38 @@ var Mpproxy_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBlacklist",
 			Handler:    _Mpproxy_GetBlacklist_Handler,
 		},
+		{
+			MethodName: "GetAccessToken",
+			Handler:    _Mpproxy_GetAccessToken_Handler,
+		},
+		{
+			MethodName: "RefreshAccessToken",
+			Handler:    _Mpproxy_RefreshAccessToken_Handler,
+		},
+		{
+			MethodName: "DescribeErrorCode",
+			Handler:    _Mpproxy_DescribeErrorCode_Handler,
+		},
+		{
+			MethodName: "GetRidInfo",
+			Handler:    _Mpproxy_GetRidInfo_Handler,
+		},
+		{
+			MethodName: "GetApiQuota",
+			Handler:    _Mpproxy_GetApiQuota_Handler,
+		},
+		{
+			MethodName: "ClearQuota",
+			Handler:    _Mpproxy_ClearQuota_Handler,
+		},
+		{
+			MethodName: "ClearQuotaV2",
+			Handler:    _Mpproxy_ClearQuotaV2_Handler,
+		},
+		{
+			MethodName: "GetQuotaUsage",
+			Handler:    _Mpproxy_GetQuotaUsage_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
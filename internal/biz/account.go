@@ -0,0 +1,161 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"sync"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/seth16888/wxproxy/internal/config"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// ErrAccountNotFound AppId未注册
+	ErrAccountNotFound = status.Error(codes.NotFound, "account not registered")
+	// ErrClientNotAllowed 调用方无权获取该AppId的AccessToken
+	ErrClientNotAllowed = status.Error(codes.PermissionDenied, "client not allowed for this account")
+)
+
+// Account 公众号账号
+type Account struct {
+	AppId     string `json:"app_id"`
+	AppSecret string `json:"app_secret"`
+	// UseStableToken 使用stable_token接口获取AccessToken
+	UseStableToken bool `json:"use_stable_token"`
+	// Clients 允许获取AccessToken的调用方
+	Clients []string `json:"clients"`
+}
+
+// AllowClient 调用方是否可以获取该账号的AccessToken
+func (a *Account) AllowClient(clientId string) bool {
+	if clientId == "" {
+		return false
+	}
+	return slices.Contains(a.Clients, clientId)
+}
+
+// AccountUsecase 公众号账号注册表
+//
+// 账号来源: 配置文件中的accounts, 以及Redis Hash({prefix}:accounts, field为AppId, value为Account的JSON).
+// 同一AppId两边都存在时以配置文件为准.
+type AccountUsecase struct {
+	log      *zap.Logger
+	rdb      redis.UniversalClient
+	key      string
+	accounts map[string]*Account
+	// registered 已确认在Redis中注册的AppId
+	registered sync.Map
+}
+
+func NewAccountUsecase(accounts []*config.Account, conf *config.Token,
+	rdb redis.UniversalClient, logger *zap.Logger,
+) *AccountUsecase {
+	m := make(map[string]*Account, len(accounts))
+	for _, item := range accounts {
+		if item == nil || item.AppId == "" {
+			continue
+		}
+		m[item.AppId] = &Account{
+			AppId:          item.AppId,
+			AppSecret:      item.AppSecret,
+			UseStableToken: item.UseStableToken,
+			Clients:        item.Clients,
+		}
+	}
+
+	return &AccountUsecase{
+		log:      logger,
+		rdb:      rdb,
+		key:      keyPrefix(conf) + ":accounts",
+		accounts: m,
+	}
+}
+
+// GetAccount 获取AppId对应的账号
+func (a *AccountUsecase) GetAccount(ctx context.Context, appId string) (*Account, error) {
+	if account, ok := a.accounts[appId]; ok {
+		return account, nil
+	}
+
+	val, err := a.rdb.HGet(ctx, a.key, appId).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrAccountNotFound
+	}
+	if err != nil {
+		ctxLogger(ctx, a.log).Error("get account error", zap.String("appId", appId), zap.Error(err))
+		return nil, err
+	}
+
+	account := &Account{}
+	if err := json.Unmarshal([]byte(val), account); err != nil {
+		ctxLogger(ctx, a.log).Error("unmarshal account error", zap.String("appId", appId), zap.Error(err))
+		return nil, err
+	}
+	if account.AppId == "" {
+		account.AppId = appId
+	}
+	a.registered.Store(appId, struct{}{})
+
+	return account, nil
+}
+
+// Registered AppId是否已注册, Redis中的AppId确认后缓存, 未注册或Redis出错时返回false
+func (a *AccountUsecase) Registered(ctx context.Context, appId string) bool {
+	if appId == "" {
+		return false
+	}
+	if _, ok := a.accounts[appId]; ok {
+		return true
+	}
+	if _, ok := a.registered.Load(appId); ok {
+		return true
+	}
+
+	ok, err := a.rdb.HExists(ctx, a.key, appId).Result()
+	if err != nil || !ok {
+		return false
+	}
+	a.registered.Store(appId, struct{}{})
+	return true
+}
+
+// ListAccounts 列出所有已注册的账号
+func (a *AccountUsecase) ListAccounts(ctx context.Context) ([]*Account, error) {
+	accounts := make([]*Account, 0, len(a.accounts))
+	for _, account := range a.accounts {
+		accounts = append(accounts, account)
+	}
+
+	vals, err := a.rdb.HGetAll(ctx, a.key).Result()
+	if err != nil {
+		ctxLogger(ctx, a.log).Error("list accounts error", zap.Error(err))
+		return accounts, err
+	}
+	for appId, val := range vals {
+		if _, ok := a.accounts[appId]; ok {
+			continue
+		}
+		account := &Account{}
+		if err := json.Unmarshal([]byte(val), account); err != nil {
+			ctxLogger(ctx, a.log).Error("unmarshal account error", zap.String("appId", appId), zap.Error(err))
+			continue
+		}
+		account.AppId = appId
+		accounts = append(accounts, account)
+	}
+
+	return accounts, nil
+}
+
+// keyPrefix Redis key前缀, 默认wxproxy
+func keyPrefix(conf *config.Token) string {
+	if conf == nil || conf.KeyPrefix == "" {
+		return "wxproxy"
+	}
+	return conf.KeyPrefix
+}
@@ -0,0 +1,79 @@
+package biz
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+	v1 "github.com/seth16888/wxproxy/api/v1"
+	"github.com/seth16888/wxproxy/internal/config"
+	"go.uber.org/zap"
+)
+
+// ErrInvalidAPIKey API Key未注册
+var ErrInvalidAPIKey = v1.ErrorUnauthenticated("invalid api key")
+
+// APIKeyUsecase API Key注册表
+//
+// 只保存API Key的SHA-256. 来源: 配置文件中的auth.api_keys,
+// 以及Redis Hash({prefix}:api_keys, field为API Key的SHA-256, value为调用方标识).
+// 同一哈希两边都存在时以配置文件为准.
+type APIKeyUsecase struct {
+	log  *zap.Logger
+	rdb  redis.UniversalClient
+	key  string
+	keys map[string]string
+}
+
+func NewAPIKeyUsecase(conf *config.Auth, tokenConf *config.Token,
+	rdb redis.UniversalClient, logger *zap.Logger,
+) *APIKeyUsecase {
+	keys := make(map[string]string)
+	if conf != nil {
+		for _, item := range conf.APIKeys {
+			if item == nil || item.Hash == "" || item.Client == "" {
+				continue
+			}
+			keys[strings.ToLower(item.Hash)] = item.Client
+		}
+	}
+
+	return &APIKeyUsecase{
+		log:  logger,
+		rdb:  rdb,
+		key:  keyPrefix(tokenConf) + ":api_keys",
+		keys: keys,
+	}
+}
+
+// Authenticate 校验API Key, 返回调用方标识
+func (a *APIKeyUsecase) Authenticate(ctx context.Context, apiKey string) (string, error) {
+	if apiKey == "" {
+		return "", ErrInvalidAPIKey
+	}
+
+	hash := HashAPIKey(apiKey)
+	if client, ok := a.keys[hash]; ok {
+		return client, nil
+	}
+
+	client, err := a.rdb.HGet(ctx, a.key, hash).Result()
+	if errors.Is(err, redis.Nil) || (err == nil && client == "") {
+		return "", ErrInvalidAPIKey
+	}
+	if err != nil {
+		ctxLogger(ctx, a.log).Error("get api key error", zap.Error(err))
+		return "", err
+	}
+
+	return client, nil
+}
+
+// HashAPIKey API Key的SHA-256, 十六进制
+func HashAPIKey(apiKey string) string {
+	sum := sha256.Sum256([]byte(apiKey))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,48 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	v1 "github.com/seth16888/wxproxy/api/v1"
+	"github.com/seth16888/wxproxy/internal/config"
+	"go.uber.org/zap"
+)
+
+func TestAPIKeyAuthenticate(t *testing.T) {
+	mr, rdb := newTestRedis(t)
+	ctx := context.Background()
+
+	a := NewAPIKeyUsecase(&config.Auth{APIKeys: []*config.APIKey{
+		// 配置中的哈希不区分大小写
+		{Client: "jssdk", Hash: strings.ToUpper(HashAPIKey("key-jssdk"))},
+		{Client: "ops", Hash: HashAPIKey("key-shared")},
+	}}, nil, rdb, zap.NewNop())
+	mr.HSet("wxproxy:api_keys", HashAPIKey("key-redis"), "worker")
+	mr.HSet("wxproxy:api_keys", HashAPIKey("key-shared"), "other")
+	mr.HSet("wxproxy:api_keys", HashAPIKey("key-empty"), "")
+
+	for apiKey, want := range map[string]string{
+		"key-jssdk":  "jssdk",
+		"key-redis":  "worker",
+		"key-shared": "ops", // 两边都存在时以配置文件为准
+	} {
+		client, err := a.Authenticate(ctx, apiKey)
+		if err != nil || client != want {
+			t.Fatalf("Authenticate(%s) = %q, %v, want %q", apiKey, client, err, want)
+		}
+	}
+
+	for _, apiKey := range []string{"", "key-unknown", "key-empty", HashAPIKey("key-jssdk")} {
+		if _, err := a.Authenticate(ctx, apiKey); !v1.IsUnauthenticated(err) {
+			t.Fatalf("Authenticate(%q) err = %v, want UNAUTHENTICATED", apiKey, err)
+		}
+	}
+
+	// Redis不可用时返回原始错误, 由Auth拦截器转为UNAVAILABLE
+	mr.Close()
+	if _, err := a.Authenticate(ctx, "key-redis"); err == nil || v1.IsUnauthenticated(err) {
+		t.Fatalf("err = %v, want redis error", err)
+	}
+}
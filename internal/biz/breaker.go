@@ -0,0 +1,249 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	v1 "github.com/seth16888/wxproxy/api/v1"
+	"github.com/seth16888/wxproxy/internal/config"
+	"go.uber.org/zap"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	// defaultBreakerFailures 默认连续失败多少次后熔断
+	defaultBreakerFailures = 5
+	// defaultBreakerOpenTimeout 默认熔断多久后进入半开状态
+	defaultBreakerOpenTimeout = 30 * time.Second
+	// defaultBreakerProbes 默认半开状态允许的并发探测请求数
+	defaultBreakerProbes = 1
+	// breakerProbeTimeout 定时探测请求的超时
+	breakerProbeTimeout = 5 * time.Second
+)
+
+// breakerState 熔断器状态
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+func (s breakerState) String() string {
+	switch s {
+	case breakerOpen:
+		return "open"
+	case breakerHalfOpen:
+		return "half-open"
+	}
+	return "closed"
+}
+
+// BreakerHealthService 熔断器状态在健康检查中的服务名
+func BreakerHealthService(name string) string {
+	return "wxproxy.breaker/" + name
+}
+
+// apiGroup 接口分组, 如/cgi-bin/message/custom/send为message, /wxa/getwxacode为wxa
+func apiGroup(path string) string {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) > 1 && parts[0] == "cgi-bin" {
+		return parts[1]
+	}
+	return parts[0]
+}
+
+// CircuitBreaker 熔断器
+//
+// 连续失败failures次后熔断, 熔断期间请求直接返回Unavailable; openTimeout后进入半开状态,
+// 允许probes个探测请求, 探测成功则恢复, 失败则重新熔断.
+// 熔断后由定时器在openTimeout后进入半开状态并发送探测请求(BreakerGroup.SetProbe), 没有请求时也能恢复.
+type CircuitBreaker struct {
+	name   string
+	host   string
+	group  *BreakerGroup
+	mu     sync.Mutex
+	state  breakerState
+	fails  int
+	probes int
+	until  time.Time
+}
+
+// Allow 检查是否允许请求, 允许时须调用Done报告结果
+func (b *CircuitBreaker) Allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case breakerOpen:
+		if time.Now().Before(b.until) {
+			return v1.ErrorUpstreamUnavailable("circuit breaker %s is open", b.name).
+				WithMetadata(map[string]string{"breaker": b.name})
+		}
+		b.setState(breakerHalfOpen)
+		fallthrough
+	case breakerHalfOpen:
+		if b.probes >= b.group.probes {
+			return v1.ErrorUpstreamUnavailable("circuit breaker %s is half-open", b.name).
+				WithMetadata(map[string]string{"breaker": b.name})
+		}
+		b.probes++
+	}
+	return nil
+}
+
+// Done 报告请求结果
+func (b *CircuitBreaker) Done(success bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == breakerHalfOpen && b.probes > 0 {
+		b.probes--
+	}
+	if success {
+		b.fails = 0
+		if b.state == breakerHalfOpen {
+			b.setState(breakerClosed)
+		}
+		return
+	}
+
+	b.fails++
+	if b.state == breakerHalfOpen || (b.state == breakerClosed && b.fails >= b.group.failures) {
+		b.until = time.Now().Add(b.group.openTimeout)
+		b.setState(breakerOpen)
+		time.AfterFunc(b.group.openTimeout, b.recover)
+	}
+}
+
+// recover 熔断超时后进入半开状态, 配置了探测函数时发送一个探测请求
+//
+// 熔断期间有请求到达时可能已由Allow进入半开状态, 或已重新熔断, 此时不处理.
+func (b *CircuitBreaker) recover() {
+	b.mu.Lock()
+	if b.state != breakerOpen || time.Now().Before(b.until) {
+		b.mu.Unlock()
+		return
+	}
+	b.setState(breakerHalfOpen)
+	probe := b.group.probe
+	if probe == nil {
+		b.mu.Unlock()
+		return
+	}
+	b.probes++
+	b.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), breakerProbeTimeout)
+	defer cancel()
+	err := probe(ctx, b.host)
+	if err != nil {
+		b.group.log.Warn("circuit breaker probe failed", zap.String("breaker", b.name), zap.Error(err))
+	}
+	b.Done(err == nil)
+}
+
+// Release 请求被取消, 不计入结果
+func (b *CircuitBreaker) Release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == breakerHalfOpen && b.probes > 0 {
+		b.probes--
+	}
+}
+
+func (b *CircuitBreaker) setState(state breakerState) {
+	if b.state == state {
+		return
+	}
+	b.group.log.Warn("circuit breaker state changed", zap.String("breaker", b.name),
+		zap.Stringer("from", b.state), zap.Stringer("to", state), zap.Int("failures", b.fails))
+	b.state = state
+	b.probes = 0
+
+	if b.group.health != nil {
+		status := healthpb.HealthCheckResponse_SERVING
+		if state != breakerClosed {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		b.group.health.SetServingStatus(BreakerHealthService(b.name), status)
+	}
+}
+
+// isBreakerOpen 错误是否为熔断器拒绝请求
+func isBreakerOpen(err error) bool {
+	return err != nil && errors.FromError(err).Metadata["breaker"] != ""
+}
+
+// BreakerGroup 按上游域名和接口分组管理熔断器
+type BreakerGroup struct {
+	log         *zap.Logger
+	health      HealthReporter
+	failures    int
+	openTimeout time.Duration
+	probes      int
+	// probe 向上游域名发送探测请求, 返回nil表示域名可用
+	probe func(ctx context.Context, host string) error
+
+	mu       sync.Mutex
+	breakers map[string]*CircuitBreaker
+}
+
+// NewBreakerGroup 配置disabled时返回nil
+func NewBreakerGroup(conf *config.Breaker, health HealthReporter, logger *zap.Logger) *BreakerGroup {
+	g := &BreakerGroup{
+		log:         logger,
+		health:      health,
+		failures:    defaultBreakerFailures,
+		openTimeout: defaultBreakerOpenTimeout,
+		probes:      defaultBreakerProbes,
+		breakers:    make(map[string]*CircuitBreaker),
+	}
+	if conf == nil {
+		return g
+	}
+	if conf.Disabled {
+		return nil
+	}
+	if conf.FailureThreshold > 0 {
+		g.failures = conf.FailureThreshold
+	}
+	if conf.OpenTimeout > 0 {
+		g.openTimeout = time.Duration(conf.OpenTimeout) * time.Second
+	}
+	if conf.HalfOpenProbes > 0 {
+		g.probes = conf.HalfOpenProbes
+	}
+	return g
+}
+
+// SetProbe 设置熔断超时后的探测函数, 未设置时只进入半开状态, 由下一个请求探测; g为nil时不处理
+func (g *BreakerGroup) SetProbe(probe func(ctx context.Context, host string) error) {
+	if g == nil {
+		return
+	}
+	g.probe = probe
+}
+
+// Get 获取上游域名和接口分组对应的熔断器, 名称为{host}/{group}
+func (g *BreakerGroup) Get(host, path string) *CircuitBreaker {
+	name := host + "/" + apiGroup(path)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	b, ok := g.breakers[name]
+	if !ok {
+		b = &CircuitBreaker{name: name, host: host, group: g}
+		g.breakers[name] = b
+		if g.health != nil {
+			g.health.SetServingStatus(BreakerHealthService(name), healthpb.HealthCheckResponse_SERVING)
+		}
+	}
+	return b
+}
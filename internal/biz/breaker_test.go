@@ -0,0 +1,217 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/seth16888/wxproxy/internal/config"
+	"go.uber.org/zap"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// fakeHealth 记录最新的健康状态
+type fakeHealth struct {
+	mu       sync.Mutex
+	statuses map[string]healthpb.HealthCheckResponse_ServingStatus
+}
+
+func (h *fakeHealth) SetServingStatus(service string, status healthpb.HealthCheckResponse_ServingStatus) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.statuses == nil {
+		h.statuses = make(map[string]healthpb.HealthCheckResponse_ServingStatus)
+	}
+	h.statuses[service] = status
+}
+
+func (h *fakeHealth) status(service string) healthpb.HealthCheckResponse_ServingStatus {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.statuses[service]
+}
+
+const testOpenTimeout = 50 * time.Millisecond
+
+// newTestBreaker 连续失败2次熔断, 半开状态允许1个探测请求
+func newTestBreaker(t *testing.T) (*CircuitBreaker, *fakeHealth) {
+	t.Helper()
+	health := &fakeHealth{}
+	g := NewBreakerGroup(&config.Breaker{FailureThreshold: 2, HalfOpenProbes: 1}, health, zap.NewNop())
+	g.openTimeout = testOpenTimeout
+	return g.Get("api.weixin.qq.com", "/cgi-bin/menu/get"), health
+}
+
+func (b *CircuitBreaker) currentState() breakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// trip 连续失败直到熔断
+func trip(t *testing.T, b *CircuitBreaker) {
+	t.Helper()
+	for range b.group.failures {
+		if err := b.Allow(); err != nil {
+			t.Fatal(err)
+		}
+		b.Done(false)
+	}
+	if s := b.currentState(); s != breakerOpen {
+		t.Fatalf("state = %s, want open", s)
+	}
+}
+
+func TestBreakerOpensAfterFailures(t *testing.T) {
+	b, health := newTestBreaker(t)
+	service := BreakerHealthService(b.name)
+
+	if err := b.Allow(); err != nil {
+		t.Fatal(err)
+	}
+	b.Done(false)
+	// 成功后重新计数
+	if err := b.Allow(); err != nil {
+		t.Fatal(err)
+	}
+	b.Done(true)
+	if err := b.Allow(); err != nil {
+		t.Fatal(err)
+	}
+	b.Done(false)
+	if s := b.currentState(); s != breakerClosed {
+		t.Fatalf("state = %s, want closed", s)
+	}
+
+	// 连续第2次失败
+	if err := b.Allow(); err != nil {
+		t.Fatal(err)
+	}
+	b.Done(false)
+	if s := b.currentState(); s != breakerOpen {
+		t.Fatalf("state = %s, want open", s)
+	}
+	if err := b.Allow(); !isBreakerOpen(err) {
+		t.Fatalf("err = %v, want breaker open", err)
+	}
+	if s := health.status(service); s != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("health = %s, want NOT_SERVING", s)
+	}
+}
+
+func TestBreakerHalfOpenProbes(t *testing.T) {
+	b, health := newTestBreaker(t)
+	trip(t, b)
+	time.Sleep(2 * testOpenTimeout)
+
+	// 半开状态只允许1个探测请求
+	if err := b.Allow(); err != nil {
+		t.Fatal(err)
+	}
+	if s := b.currentState(); s != breakerHalfOpen {
+		t.Fatalf("state = %s, want half-open", s)
+	}
+	if err := b.Allow(); !isBreakerOpen(err) {
+		t.Fatalf("second probe: err = %v, want rejected", err)
+	}
+
+	// 被取消的探测请求不计入结果, 释放探测名额
+	b.Release()
+	if s := b.currentState(); s != breakerHalfOpen {
+		t.Fatalf("state after release = %s, want half-open", s)
+	}
+	if err := b.Allow(); err != nil {
+		t.Fatalf("probe after release: %v", err)
+	}
+
+	b.Done(true)
+	if s := b.currentState(); s != breakerClosed {
+		t.Fatalf("state = %s, want closed", s)
+	}
+	if s := health.status(BreakerHealthService(b.name)); s != healthpb.HealthCheckResponse_SERVING {
+		t.Fatalf("health = %s, want SERVING", s)
+	}
+}
+
+func TestBreakerHalfOpenFailureReopens(t *testing.T) {
+	b, _ := newTestBreaker(t)
+	trip(t, b)
+	time.Sleep(2 * testOpenTimeout)
+
+	if err := b.Allow(); err != nil {
+		t.Fatal(err)
+	}
+	b.Done(false)
+	if s := b.currentState(); s != breakerOpen {
+		t.Fatalf("state = %s, want open", s)
+	}
+	if err := b.Allow(); !isBreakerOpen(err) {
+		t.Fatalf("err = %v, want breaker open", err)
+	}
+}
+
+func TestBreakerReleaseNotCounted(t *testing.T) {
+	b, _ := newTestBreaker(t)
+	// 被取消的请求不计为失败
+	for range 2 * b.group.failures {
+		if err := b.Allow(); err != nil {
+			t.Fatal(err)
+		}
+		b.Release()
+	}
+	if s := b.currentState(); s != breakerClosed {
+		t.Fatalf("state = %s, want closed", s)
+	}
+}
+
+// waitHealth 等待健康状态变为want
+func waitHealth(t *testing.T, health *fakeHealth, service string, want healthpb.HealthCheckResponse_ServingStatus) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for health.status(service) != want {
+		if time.Now().After(deadline) {
+			t.Fatalf("health = %s, want %s", health.status(service), want)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestBreakerRecoversWithoutTraffic(t *testing.T) {
+	b, health := newTestBreaker(t)
+	service := BreakerHealthService(b.name)
+
+	var probes atomic.Int32
+	var healthy atomic.Bool
+	b.group.SetProbe(func(ctx context.Context, host string) error {
+		probes.Add(1)
+		if host != "api.weixin.qq.com" {
+			t.Errorf("probe host = %q", host)
+		}
+		if !healthy.Load() {
+			return errors.New("unavailable")
+		}
+		return nil
+	})
+
+	trip(t, b)
+	// 探测失败后重新熔断, 之后定时再次探测
+	deadline := time.Now().Add(2 * time.Second)
+	for probes.Load() < 2 {
+		if time.Now().After(deadline) {
+			t.Fatalf("probes = %d, want at least 2", probes.Load())
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	if s := health.status(service); s != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("health = %s, want NOT_SERVING", s)
+	}
+
+	healthy.Store(true)
+	waitHealth(t, health, service, healthpb.HealthCheckResponse_SERVING)
+	if s := b.currentState(); s != breakerClosed {
+		t.Fatalf("state = %s, want closed", s)
+	}
+}
@@ -0,0 +1,513 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	wxError "github.com/seth16888/wxcommon/error"
+	"github.com/seth16888/wxcommon/hc"
+	v1 "github.com/seth16888/wxproxy/api/v1"
+	"github.com/seth16888/wxproxy/internal/consts"
+	"github.com/seth16888/wxproxy/internal/metrics"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
+)
+
+// tracer 微信接口请求的tracer, 使用全局TracerProvider
+var tracer = otel.Tracer("github.com/seth16888/wxproxy/internal/biz")
+
+const (
+	// ErrCodeInvalidCredential 获取access_token时AppSecret错误, 或者access_token无效
+	ErrCodeInvalidCredential = 40001
+	// ErrCodeInvalidAccessToken 不合法的access_token
+	ErrCodeInvalidAccessToken = 40014
+	// ErrCodeAccessTokenExpired access_token超时
+	ErrCodeAccessTokenExpired = 42001
+)
+
+// pathProbe 熔断器探测请求的接口, 获取微信服务器IP
+const pathProbe = "/cgi-bin/getcallbackip"
+
+// IsTokenInvalid 错误码是否表示AccessToken无效或过期
+func IsTokenInvalid(errCode int64) bool {
+	switch errCode {
+	case ErrCodeInvalidCredential, ErrCodeInvalidAccessToken, ErrCodeAccessTokenExpired:
+		return true
+	}
+	return false
+}
+
+// wxClient 调用微信接口的统一入口
+//
+// 微信返回错误时记录errcode、errmsg和rid.
+// 上下文中带有AppId(consts.AppIdKey)时, 按接口路径统计调用次数; 若微信返回AccessToken无效的错误码,
+// 则作废缓存的AccessToken, 重新获取后重放一次请求.
+// 每次请求先按接口路径检查频率限制(RateLimiter.AllowPath), 再经过上游域名和接口分组对应的熔断器,
+// 熔断期间直接返回Unavailable; 失败时按域名池(DomainPool)换域名重试.
+type wxClient struct {
+	log      *zap.Logger
+	hc       *hc.Client
+	token    *TokenUsecase
+	quota    *QuotaCounter
+	limiter  *RateLimiter
+	breakers *BreakerGroup
+	domains  *DomainPool
+	metrics  *metrics.Metrics
+}
+
+func newWXClient(hc *hc.Client, token *TokenUsecase, quota *QuotaCounter, limiter *RateLimiter,
+	breakers *BreakerGroup, domains *DomainPool, m *metrics.Metrics, logger *zap.Logger,
+) *wxClient {
+	c := &wxClient{
+		log:      logger,
+		hc:       hc,
+		token:    token,
+		quota:    quota,
+		limiter:  limiter,
+		breakers: breakers,
+		domains:  domains,
+		metrics:  m,
+	}
+	breakers.SetProbe(c.probe)
+	return c
+}
+
+// probe 熔断器的探测请求: 不带AccessToken请求getcallbackip, 微信返回errcode(如41001)即认为域名可用,
+// 不经过熔断器、频率限制和调用次数统计
+func (c *wxClient) probe(ctx context.Context, host string) error {
+	resp, err := httpDo(ctx, c.hc, http.MethodGet, "https://"+host+pathProbe, "", nil)
+	if err != nil {
+		return requestError(err)
+	}
+	wxErr, err := peekWXError(resp)
+	if err != nil {
+		return err
+	}
+	if isFailure(resp, wxErr, nil) {
+		return v1.ErrorUpstreamUnavailable("probe %s: http %d, errcode %d", host, resp.StatusCode, wxErr.ErrCode)
+	}
+	return nil
+}
+
+// Get 发送GET请求
+func (c *wxClient) Get(ctx context.Context, url string) (*http.Response, error) {
+	return c.do(ctx, http.MethodGet, url, "", nil)
+}
+
+// Post 发送POST请求
+func (c *wxClient) Post(ctx context.Context, url string, contentType string, body io.Reader) (*http.Response, error) {
+	var data []byte
+	if body != nil {
+		var err error
+		// 读取请求体, 以便重放请求
+		if data, err = io.ReadAll(body); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(ctx, http.MethodPost, url, contentType, data)
+}
+
+func (c *wxClient) do(ctx context.Context, method, rawURL, contentType string, body []byte) (*http.Response, error) {
+	appId, _ := ctx.Value(consts.AppIdKey).(string)
+
+	resp, wxErr, err := c.sendWithRetry(ctx, method, rawURL, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	if wxErr.ErrCode == 0 {
+		return resp, nil
+	}
+	c.logFailure(ctx, rawURL, wxErr)
+
+	if appId == "" || c.token == nil || !IsTokenInvalid(wxErr.ErrCode) {
+		return resp, nil
+	}
+
+	replayURL, err := c.renewToken(ctx, appId, rawURL)
+	if err != nil {
+		ctxLogger(ctx, c.log).Error("renew access token error", zap.String("appId", appId),
+			zap.Int64("errcode", wxErr.ErrCode), zap.Error(err))
+		return resp, nil
+	}
+	ctxLogger(ctx, c.log).Warn("access token invalid, replay request", zap.String("appId", appId),
+		zap.Int64("errcode", wxErr.ErrCode))
+	resp.Body.Close()
+
+	resp, wxErr, err = c.sendWithRetry(ctx, method, replayURL, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	if wxErr.ErrCode != 0 {
+		c.logFailure(ctx, replayURL, wxErr)
+	}
+	return resp, nil
+}
+
+// count 统计账号的接口调用次数, 每次发出请求(含重试、换域名和对冲)计一次, 被频率限制或熔断拒绝的请求不计; 未知AppId时不统计
+func (c *wxClient) count(ctx context.Context, appId, rawURL string) {
+	if appId == "" || c.quota == nil {
+		return
+	}
+	c.quota.Incr(ctx, appId, urlPath(rawURL))
+}
+
+// logFailure 记录微信接口返回的错误, rid可通过GetRidInfo查询请求详情
+func (c *wxClient) logFailure(ctx context.Context, rawURL string, wxErr *wxError.WXError) {
+	ctxLogger(ctx, c.log).Warn("wechat api error", zap.String("path", urlPath(rawURL)),
+		zap.Int64("errcode", wxErr.ErrCode), zap.String("errmsg", wxErr.ErrMsg),
+		zap.String("rid", ExtractRid(wxErr.ErrMsg)))
+}
+
+// renewToken 作废url中的AccessToken并重新获取, 返回替换了AccessToken的url
+func (c *wxClient) renewToken(ctx context.Context, appId, rawURL string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+	query := u.Query()
+
+	if err := c.token.InvalidateAccessToken(ctx, appId, query.Get("access_token")); err != nil {
+		return "", err
+	}
+	token, err := c.token.AccessToken(ctx, appId)
+	if err != nil {
+		return "", err
+	}
+
+	query.Set("access_token", token)
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+// sendWithRetry 按上下文中的重试策略(RetryPolicies.WithRetry)发送请求
+//
+// 网络错误、HTTP 5xx和系统繁忙(-1)时退避后重试, 熔断器或频率限制拒绝的请求不重试.
+func (c *wxClient) sendWithRetry(ctx context.Context, method, rawURL, contentType string,
+	body []byte,
+) (*http.Response, *wxError.WXError, error) {
+	st := retryFromContext(ctx)
+	for attempt := 1; ; attempt++ {
+		if st != nil {
+			st.attempts.Add(1)
+		}
+		resp, wxErr, err := c.send(ctx, method, rawURL, contentType, body)
+		if st == nil || attempt >= st.policy.MaxAttempts || !isFailure(resp, wxErr, err) || isBreakerOpen(err) ||
+			isRateLimited(err) {
+			return resp, wxErr, err
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		backoff := st.policy.Backoff(attempt)
+		ctxLogger(ctx, c.log).Warn("wechat api retry", zap.String("path", urlPath(rawURL)), zap.Int("attempt", attempt),
+			zap.Duration("backoff", backoff), zap.Error(err))
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, nil, status.FromContextError(ctx.Err()).Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// send 按域名池依次尝试发送请求
+//
+// 请求失败时换下一个域名重试: 可以重复发送的请求(幂等方法或携带幂等键, 见RetryPolicies)任何失败都重试,
+// 其他请求仅在未发出(熔断)时重试, 避免重复执行. 开启对冲请求时, 可以重复发送的请求由hedge发送.
+func (c *wxClient) send(ctx context.Context, method, rawURL, contentType string,
+	body []byte,
+) (*http.Response, *wxError.WXError, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		resp, wxErr, _, err := c.sendTo(ctx, method, rawURL, contentType, body)
+		return resp, wxErr, err
+	}
+
+	candidates := c.domains.Candidates(u.Host)
+	if canHedge(ctx) && len(candidates) > 1 && c.domains.HedgeDelay() > 0 {
+		return c.hedge(ctx, method, u, contentType, body, candidates)
+	}
+
+	var (
+		resp  *http.Response
+		wxErr *wxError.WXError
+		sent  bool
+	)
+	for i, host := range candidates {
+		u.Host = host
+		resp, wxErr, sent, err = c.sendTo(ctx, method, u.String(), contentType, body)
+		if !isFailure(resp, wxErr, err) || !canFailover(ctx, method, sent) || isRateLimited(err) ||
+			i == len(candidates)-1 || ctx.Err() != nil {
+			break
+		}
+
+		ctxLogger(ctx, c.log).Warn("wechat api failover", zap.String("domain", host),
+			zap.String("next", candidates[i+1]), zap.String("path", u.Path), zap.Error(err))
+		if resp != nil {
+			resp.Body.Close()
+		}
+	}
+	return resp, wxErr, err
+}
+
+// hedgeResult 对冲请求的结果
+type hedgeResult struct {
+	resp  *http.Response
+	wxErr *wxError.WXError
+	err   error
+}
+
+// hedge 对冲请求: 向第一个域名发送请求, hedgeDelay内未返回或失败时向下一个域名发送, 使用先成功的响应,
+// 返回时取消其他请求
+func (c *wxClient) hedge(ctx context.Context, method string, u *url.URL, contentType string, body []byte,
+	candidates []string,
+) (*http.Response, *wxError.WXError, error) {
+	// 响应体已在roundTrip中读取, 返回后可以取消
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan hedgeResult, len(candidates))
+	next, pending := 0, 0
+	launch := func() {
+		target := *u
+		target.Host = candidates[next]
+		next++
+		pending++
+		go func() {
+			resp, wxErr, _, err := c.sendTo(ctx, method, target.String(), contentType, body)
+			results <- hedgeResult{resp: resp, wxErr: wxErr, err: err}
+		}()
+	}
+
+	launch()
+	timer := time.NewTimer(c.domains.HedgeDelay())
+	defer timer.Stop()
+
+	var last hedgeResult
+	for pending > 0 {
+		select {
+		case <-timer.C:
+			if next < len(candidates) {
+				ctxLogger(ctx, c.log).Debug("wechat api hedged request", zap.String("domain", candidates[next]),
+					zap.String("path", u.Path))
+				launch()
+			}
+			continue
+		case r := <-results:
+			pending--
+			if last.resp != nil {
+				last.resp.Body.Close()
+			}
+			last = r
+		}
+
+		if !isFailure(last.resp, last.wxErr, last.err) {
+			break
+		}
+		if next < len(candidates) {
+			launch()
+		}
+	}
+
+	// 丢弃未返回的请求
+	go func(n int) {
+		for range n {
+			if r := <-results; r.resp != nil {
+				r.resp.Body.Close()
+			}
+		}
+	}(pending)
+
+	return last.resp, last.wxErr, last.err
+}
+
+// isFailure 网络错误、HTTP 5xx和系统繁忙(-1)计为失败
+func isFailure(resp *http.Response, wxErr *wxError.WXError, err error) bool {
+	return err != nil || resp.StatusCode >= http.StatusInternalServerError || wxErr.ErrCode == -1
+}
+
+// canFailover 失败的请求能否换域名重试
+//
+// 按上下文中重试策略的幂等分类判断, 与重试一致; 上下文中没有重试策略时只有GET请求可以重复发送.
+func canFailover(ctx context.Context, method string, sent bool) bool {
+	if !sent {
+		return true
+	}
+	if st := retryFromContext(ctx); st != nil {
+		return st.repeatable
+	}
+	return method == http.MethodGet
+}
+
+// canHedge 请求能否对冲, 须上下文中的重试策略表明请求可以重复发送(幂等方法或携带幂等键);
+// 上下文中没有重试策略时(如获取AccessToken)不对冲
+func canHedge(ctx context.Context) bool {
+	st := retryFromContext(ctx)
+	return st != nil && st.repeatable
+}
+
+// sendTo 经频率限制和熔断器发送请求, 返回响应及其中的errcode和errmsg; sent为false表示请求被拒绝未发出
+//
+// ctx取消导致的失败不计入熔断器和域名状态.
+func (c *wxClient) sendTo(ctx context.Context, method, rawURL, contentType string,
+	body []byte,
+) (*http.Response, *wxError.WXError, bool, error) {
+	appId, _ := ctx.Value(consts.AppIdKey).(string)
+	if err := c.limiter.AllowPath(ctx, appId, urlPath(rawURL)); err != nil {
+		return nil, nil, false, err
+	}
+
+	var (
+		host    = ""
+		breaker *CircuitBreaker
+	)
+	if u, err := url.Parse(rawURL); err == nil {
+		host = u.Host
+		if c.breakers != nil {
+			breaker = c.breakers.Get(u.Host, u.Path)
+			if err := breaker.Allow(); err != nil {
+				return nil, nil, false, err
+			}
+		}
+	}
+
+	ctx, span := startSpan(ctx, method, rawURL, host, appId)
+	defer span.End()
+
+	c.count(ctx, appId, rawURL)
+	start := time.Now()
+	resp, wxErr, err := c.roundTrip(ctx, method, rawURL, contentType, body)
+	code := upstreamCode(ctx, resp, wxErr, err)
+	c.metrics.ObserveUpstream(urlPath(rawURL), c.token.metricAppId(ctx, appId), code, time.Since(start))
+	endSpan(span, resp, code, err)
+	if err != nil && ctx.Err() != nil {
+		if breaker != nil {
+			breaker.Release()
+		}
+		return nil, nil, true, status.FromContextError(ctx.Err()).Err()
+	}
+
+	success := !isFailure(resp, wxErr, err)
+	if breaker != nil {
+		breaker.Done(success)
+	}
+	c.domains.Report(host, time.Since(start), success)
+
+	return resp, wxErr, true, err
+}
+
+func (c *wxClient) roundTrip(ctx context.Context, method, url, contentType string,
+	body []byte,
+) (*http.Response, *wxError.WXError, error) {
+	var reader io.Reader
+	if method != http.MethodGet {
+		reader = bytes.NewReader(body)
+	}
+	resp, err := httpDo(ctx, c.hc, method, url, contentType, reader)
+	if err != nil {
+		return nil, nil, requestError(err)
+	}
+
+	wxErr, err := peekWXError(resp)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp, wxErr, nil
+}
+
+// startSpan 为一次微信接口请求创建span, 记录接口路径、AppId、域名和尝试次数
+func startSpan(ctx context.Context, method, rawURL, host, appId string) (context.Context, trace.Span) {
+	path := urlPath(rawURL)
+	attrs := []attribute.KeyValue{
+		attribute.String("http.request.method", method),
+		attribute.String("wx.api.path", path),
+		attribute.String("wx.domain", host),
+	}
+	if appId != "" {
+		attrs = append(attrs, attribute.String("wx.app_id", appId))
+	}
+	if st := retryFromContext(ctx); st != nil {
+		attrs = append(attrs, attribute.Int("wx.attempt", int(st.attempts.Load())))
+	}
+
+	return tracer.Start(ctx, "wechat "+path,
+		trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
+
+// endSpan 记录微信接口请求的结果, code为upstreamCode的返回值
+func endSpan(span trace.Span, resp *http.Response, code string, err error) {
+	span.SetAttributes(attribute.String("wx.errcode", code))
+	if resp != nil {
+		span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+	}
+	// 错误信息中可能带有AccessToken, 只记录errcode
+	if err != nil || code != "0" {
+		span.SetStatus(codes.Error, "wechat api error "+code)
+	}
+}
+
+// upstreamCode 微信接口请求结果在指标中的errcode
+func upstreamCode(ctx context.Context, resp *http.Response, wxErr *wxError.WXError, err error) string {
+	switch {
+	case err != nil && ctx.Err() != nil:
+		return "canceled"
+	case err != nil:
+		return "error"
+	case wxErr != nil && wxErr.ErrCode != 0:
+		return strconv.FormatInt(wxErr.ErrCode, 10)
+	case resp != nil && resp.StatusCode >= http.StatusBadRequest:
+		return "http_" + strconv.Itoa(resp.StatusCode)
+	}
+	return "0"
+}
+
+// httpDo 发送带上下文的HTTP请求, ctx取消时中止请求
+func httpDo(ctx context.Context, hc *hc.Client, method, url, contentType string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	return hc.Do(req)
+}
+
+// urlPath 返回url的路径部分, 不含access_token等参数
+func urlPath(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return u.Path
+}
+
+// peekWXError 读取响应中的errcode和errmsg, 响应体可被再次读取
+func peekWXError(resp *http.Response) (*wxError.WXError, error) {
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, v1.ErrorUpstreamUnavailable("read wechat api response: %v", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+
+	wxErr := &wxError.WXError{}
+	if err := json.Unmarshal(data, wxErr); err != nil {
+		// 非JSON响应(如媒体文件)
+		return &wxError.WXError{}, nil
+	}
+	return wxErr, nil
+}
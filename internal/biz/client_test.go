@@ -0,0 +1,241 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/seth16888/wxproxy/internal/config"
+	"github.com/seth16888/wxproxy/internal/middleware"
+	"go.uber.org/goleak"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// blockingServer 收到请求后阻塞到请求被取消, started在收到请求时写入
+func blockingServer(t *testing.T, started chan<- struct{}) *httptest.Server {
+	t.Helper()
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if started != nil {
+			started <- struct{}{}
+		}
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	// 先放行阻塞的请求再关闭, Close会等待请求处理完成
+	t.Cleanup(srv.Close)
+	t.Cleanup(func() { close(release) })
+	return srv
+}
+
+// okServer 立即返回errcode 0
+func okServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, `{"errcode":0,"errmsg":"ok","from":"fast"}`)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// verifyNoLeak 测试结束、服务器关闭后检查是否有测试开始后创建的goroutine未退出, 须在创建服务器前调用
+func verifyNoLeak(t *testing.T) {
+	t.Helper()
+	ignore := goleak.IgnoreCurrent()
+	t.Cleanup(func() { goleak.VerifyNone(t, ignore) })
+}
+
+// readCtx 只读方法的重试策略, 可以换域名和对冲
+func readCtx(ctx context.Context) context.Context {
+	return NewRetryPolicies(nil).WithRetry(ctx, "/api.v1.MPProxy/PullMenu", "")
+}
+
+func newTestWXClient(domains *DomainPool) *wxClient {
+	return newWXClient(NewHttpClient(), nil, nil, nil, nil, domains, nil, zap.NewNop())
+}
+
+func hostOf(t *testing.T, srv *httptest.Server) string {
+	t.Helper()
+	u, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return u.Host
+}
+
+func TestCancelThroughInterceptorsNoLeak(t *testing.T) {
+	verifyNoLeak(t)
+
+	started := make(chan struct{}, 1)
+	srv := blockingServer(t, started)
+	c := newTestWXClient(nil)
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/api.v1.MPProxy/GetMenuInfo"}
+	timeout := middleware.TimeoutInterceptor(middleware.NewTimeouts(nil))
+	disconnect := middleware.ClientDisconnectInterceptor()
+	handler := func(ctx context.Context, req any) (any, error) {
+		resp, err := c.Get(ctx, srv.URL+"/cgi-bin/get_current_selfmenu_info?access_token=token")
+		if resp != nil {
+			resp.Body.Close()
+		}
+		return resp, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+	}()
+	_, err := timeout(ctx, nil, info, func(ctx context.Context, req any) (any, error) {
+		return disconnect(ctx, req, info, handler)
+	})
+	if status.Code(err) != codes.Canceled {
+		t.Fatalf("err = %v, want Canceled", err)
+	}
+}
+
+func TestHedgeDrainNoLeak(t *testing.T) {
+	verifyNoLeak(t)
+
+	slow := blockingServer(t, nil)
+	fast := okServer(t)
+	domains := NewDomainPool(&config.Upstream{
+		Domains:    []string{hostOf(t, slow), hostOf(t, fast)},
+		HedgeDelay: 10,
+	}, nil, zap.NewNop())
+	c := newTestWXClient(domains)
+
+	resp, err := c.Get(readCtx(context.Background()), slow.URL+"/cgi-bin/get_current_selfmenu_info?access_token=token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	if want := `"from":"fast"`; !strings.Contains(string(body), want) {
+		t.Fatalf("body = %s, want response from the hedged domain", body)
+	}
+}
+
+func TestHedgeCancelNoLeak(t *testing.T) {
+	verifyNoLeak(t)
+
+	started := make(chan struct{}, 2)
+	a := blockingServer(t, started)
+	b := blockingServer(t, started)
+	domains := NewDomainPool(&config.Upstream{
+		Domains:    []string{hostOf(t, a), hostOf(t, b)},
+		HedgeDelay: 10,
+	}, nil, zap.NewNop())
+	c := newTestWXClient(domains)
+
+	ctx, cancel := context.WithCancel(readCtx(context.Background()))
+	go func() {
+		// 两个域名都收到请求后取消
+		<-started
+		<-started
+		cancel()
+	}()
+
+	done := make(chan error, 1)
+	go func() {
+		resp, err := c.Get(ctx, a.URL+"/cgi-bin/get_current_selfmenu_info?access_token=token")
+		if resp != nil {
+			resp.Body.Close()
+		}
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if status.Code(err) != codes.Canceled {
+			t.Fatalf("err = %v, want Canceled", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("hedged request did not return after cancel")
+	}
+}
+
+// countingServer 统计收到的请求, 延迟delay后返回errcode 0
+func countingServer(t *testing.T, delay time.Duration, n *atomic.Int32, bodies chan<- string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n.Add(1)
+		if bodies != nil {
+			body, _ := io.ReadAll(r.Body)
+			bodies <- string(body)
+		}
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+		_, _ = io.WriteString(w, `{"errcode":0,"errmsg":"ok"}`)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestNoHedgeForNonIdempotentGet(t *testing.T) {
+	verifyNoLeak(t)
+
+	var first, second atomic.Int32
+	a := countingServer(t, 100*time.Millisecond, &first, nil)
+	b := countingServer(t, 0, &second, nil)
+	domains := NewDomainPool(&config.Upstream{
+		Domains:    []string{hostOf(t, a), hostOf(t, b)},
+		HedgeDelay: 10,
+	}, nil, zap.NewNop())
+	c := newTestWXClient(domains)
+
+	// DeleteMenu使用GET请求, 但不是幂等的方法
+	ctx := NewRetryPolicies(nil).WithRetry(context.Background(), "/api.v1.MPProxy/DeleteMenu", "")
+	resp, err := c.Get(ctx, a.URL+"/cgi-bin/menu/delete?access_token=token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if first.Load() != 1 || second.Load() != 0 {
+		t.Fatalf("requests = %d/%d, want 1/0", first.Load(), second.Load())
+	}
+}
+
+func TestHedgeReadOnlyPost(t *testing.T) {
+	verifyNoLeak(t)
+
+	var first, second atomic.Int32
+	bodies := make(chan string, 2)
+	a := countingServer(t, time.Minute, &first, bodies)
+	b := countingServer(t, 0, &second, bodies)
+	domains := NewDomainPool(&config.Upstream{
+		Domains:    []string{hostOf(t, a), hostOf(t, b)},
+		HedgeDelay: 10,
+	}, nil, zap.NewNop())
+	c := newTestWXClient(domains)
+
+	ctx := NewRetryPolicies(nil).WithRetry(context.Background(), "/api.v1.MPProxy/GetMaterialList", "")
+	const body = `{"type":"image","offset":0,"count":20}`
+	resp, err := c.Post(ctx, a.URL+"/cgi-bin/material/batchget_material?access_token=token",
+		"application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if first.Load() != 1 || second.Load() != 1 {
+		t.Fatalf("requests = %d/%d, want 1/1", first.Load(), second.Load())
+	}
+	for range 2 {
+		if got := <-bodies; got != body {
+			t.Fatalf("hedged body = %q, want %q", got, body)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package biz
+
+import (
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/seth16888/wxcommon/domain"
+	"github.com/seth16888/wxproxy/internal/config"
+	"go.uber.org/zap"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	// defaultDomainCooldown 默认域名请求失败后降低优先级的时长
+	defaultDomainCooldown = 30 * time.Second
+	// defaultDomainSlowThreshold 默认平均耗时超过该值的域名降低优先级
+	defaultDomainSlowThreshold = 2 * time.Second
+	// domainLatencyWeight 平均耗时(EWMA)中最近一次请求的权重
+	domainLatencyWeight = 0.2
+)
+
+// DomainHealthService 微信接口域名状态在健康检查中的服务名
+func DomainHealthService(host string) string {
+	return "wxproxy.domain/" + host
+}
+
+// domainStat 域名的请求统计
+type domainStat struct {
+	failures int
+	// until 失败后在该时间前降低优先级
+	until   time.Time
+	latency time.Duration
+}
+
+// DomainPool 微信接口域名池
+//
+// 请求按域名的健康状态依次尝试: 近期未失败且平均耗时未超过slow的域名优先, 同等条件下按配置顺序.
+// 开启对冲请求时, 只读请求在hedgeDelay内未返回则向下一个域名发送备份请求, 使用先返回的响应.
+type DomainPool struct {
+	log        *zap.Logger
+	health     HealthReporter
+	domains    []string
+	cooldown   time.Duration
+	slow       time.Duration
+	hedgeDelay time.Duration
+
+	mu    sync.Mutex
+	stats map[string]*domainStat
+}
+
+// NewDomainPool 未配置域名时只使用domain.GetWXAPIDomain()
+func NewDomainPool(conf *config.Upstream, health HealthReporter, logger *zap.Logger) *DomainPool {
+	p := &DomainPool{
+		log:      logger,
+		health:   health,
+		domains:  []string{domain.GetWXAPIDomain()},
+		cooldown: defaultDomainCooldown,
+		slow:     defaultDomainSlowThreshold,
+		stats:    make(map[string]*domainStat),
+	}
+	if conf != nil {
+		if len(conf.Domains) > 0 {
+			p.domains = conf.Domains
+		}
+		if conf.FailureCooldown > 0 {
+			p.cooldown = time.Duration(conf.FailureCooldown) * time.Second
+		}
+		if conf.SlowThreshold > 0 {
+			p.slow = time.Duration(conf.SlowThreshold) * time.Millisecond
+		}
+		if conf.HedgeDelay > 0 {
+			p.hedgeDelay = time.Duration(conf.HedgeDelay) * time.Millisecond
+		}
+	}
+
+	for _, d := range p.domains {
+		p.stats[d] = &domainStat{}
+		p.setStatus(d, healthpb.HealthCheckResponse_SERVING)
+	}
+	return p
+}
+
+// Candidates 返回请求host时依次尝试的域名, host不在域名池中时只返回host
+func (p *DomainPool) Candidates(host string) []string {
+	if p == nil || !slices.Contains(p.domains, host) {
+		return []string{host}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	rank := func(d string) int {
+		st := p.stats[d]
+		switch {
+		case now.Before(st.until):
+			return 2
+		case st.latency > p.slow:
+			return 1
+		}
+		return 0
+	}
+
+	candidates := slices.Clone(p.domains)
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return rank(candidates[i]) < rank(candidates[j])
+	})
+	return candidates
+}
+
+// HedgeDelay 对冲请求的等待时间, 为0时不开启
+func (p *DomainPool) HedgeDelay() time.Duration {
+	if p == nil {
+		return 0
+	}
+	return p.hedgeDelay
+}
+
+// Report 报告域名的请求结果
+func (p *DomainPool) Report(host string, latency time.Duration, success bool) {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	st, ok := p.stats[host]
+	if !ok {
+		return
+	}
+
+	if st.latency == 0 {
+		st.latency = latency
+	} else {
+		st.latency = time.Duration(domainLatencyWeight*float64(latency) + (1-domainLatencyWeight)*float64(st.latency))
+	}
+
+	if success {
+		if st.failures > 0 {
+			p.log.Info("wechat api domain recovered", zap.String("domain", host))
+			p.setStatus(host, healthpb.HealthCheckResponse_SERVING)
+		}
+		st.failures = 0
+		st.until = time.Time{}
+		return
+	}
+
+	st.failures++
+	st.until = time.Now().Add(p.cooldown)
+	if st.failures == 1 {
+		p.log.Warn("wechat api domain failed", zap.String("domain", host))
+		p.setStatus(host, healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+}
+
+func (p *DomainPool) setStatus(host string, status healthpb.HealthCheckResponse_ServingStatus) {
+	if p.health != nil {
+		p.health.SetServingStatus(DomainHealthService(host), status)
+	}
+}
@@ -0,0 +1,215 @@
+package biz
+
+import (
+	"github.com/go-kratos/kratos/v2/errors"
+	v1 "github.com/seth16888/wxproxy/api/v1"
+)
+
+// 错误码分类
+const (
+	ErrCategorySystem     = "system"     // 微信系统错误
+	ErrCategoryToken      = "token"      // AccessToken无效或过期
+	ErrCategoryArgument   = "argument"   // 参数错误
+	ErrCategoryPermission = "permission" // 无接口权限、IP白名单、帐号受限
+	ErrCategoryNotFound   = "not_found"  // 资源不存在
+	ErrCategoryQuota      = "quota"      // 调用次数或频率超过限制
+	ErrCategoryBusiness   = "business"   // 业务规则限制
+)
+
+// categoryReasons 错误码分类对应的错误
+var categoryReasons = map[string]func(format string, args ...interface{}) *errors.Error{
+	ErrCategorySystem:     v1.ErrorUpstreamUnavailable,
+	ErrCategoryToken:      v1.ErrorAccessTokenInvalid,
+	ErrCategoryArgument:   v1.ErrorInvalidArgument,
+	ErrCategoryPermission: v1.ErrorPermissionDenied,
+	ErrCategoryNotFound:   v1.ErrorNotFound,
+	ErrCategoryQuota:      v1.ErrorQuotaExceeded,
+	ErrCategoryBusiness:   v1.ErrorWxError,
+}
+
+// ErrCodeInfo 微信错误码说明
+type ErrCodeInfo struct {
+	Code      int64
+	Zh        string // 中文说明
+	En        string // 英文说明
+	Category  string // 分类, 见ErrCategory*
+	Retryable bool   // 原样重试是否可能成功
+	Action    string // 建议的处理方式
+}
+
+// errCodeCatalog 微信公众号常见错误码
+var errCodeCatalog = map[int64]*ErrCodeInfo{}
+
+func init() {
+	for _, info := range []*ErrCodeInfo{
+		{-1, "系统繁忙", "system error", ErrCategorySystem, true, "稍候重试"},
+		{40001, "AppSecret错误或者access_token无效", "invalid credential, access_token is invalid or not latest", ErrCategoryToken, true, "刷新AccessToken后重试; 获取AccessToken时返回则检查AppSecret"},
+		{40002, "不合法的凭证类型", "invalid grant_type", ErrCategoryArgument, false, "grant_type应为client_credential"},
+		{40003, "不合法的OpenID", "invalid openid", ErrCategoryArgument, false, "检查OpenID是否属于该公众号"},
+		{40004, "不合法的媒体文件类型", "invalid media type", ErrCategoryArgument, false, "检查媒体文件类型"},
+		{40005, "不合法的文件类型", "invalid file type", ErrCategoryArgument, false, "检查上传文件的格式"},
+		{40006, "不合法的文件大小", "invalid file size", ErrCategoryArgument, false, "检查上传文件的大小"},
+		{40007, "不合法的媒体文件id", "invalid media_id", ErrCategoryArgument, false, "检查media_id是否存在或已过期"},
+		{40008, "不合法的消息类型", "invalid message type", ErrCategoryArgument, false, "检查msgtype"},
+		{40009, "不合法的图片文件大小", "invalid image size", ErrCategoryArgument, false, "压缩图片后重新上传"},
+		{40010, "不合法的语音文件大小", "invalid voice size", ErrCategoryArgument, false, "检查语音文件的大小"},
+		{40011, "不合法的视频文件大小", "invalid video size", ErrCategoryArgument, false, "检查视频文件的大小"},
+		{40012, "不合法的缩略图文件大小", "invalid thumb size", ErrCategoryArgument, false, "检查缩略图的大小"},
+		{40013, "不合法的AppID", "invalid appid", ErrCategoryArgument, false, "检查AppID是否正确"},
+		{40014, "不合法的access_token", "invalid access_token", ErrCategoryToken, true, "刷新AccessToken后重试"},
+		{40015, "不合法的菜单类型", "invalid menu type", ErrCategoryArgument, false, "检查菜单的type"},
+		{40016, "不合法的按钮个数", "invalid button count", ErrCategoryArgument, false, "一级菜单最多3个"},
+		{40017, "不合法的按钮类型", "invalid button type", ErrCategoryArgument, false, "检查按钮的type"},
+		{40018, "不合法的按钮名字长度", "invalid button name size", ErrCategoryArgument, false, "缩短按钮名字"},
+		{40019, "不合法的按钮KEY长度", "invalid button key size", ErrCategoryArgument, false, "缩短按钮KEY"},
+		{40020, "不合法的按钮URL长度", "invalid button url size", ErrCategoryArgument, false, "缩短按钮URL"},
+		{40023, "不合法的子菜单按钮个数", "invalid sub button count", ErrCategoryArgument, false, "二级菜单最多5个"},
+		{40024, "不合法的子菜单按钮类型", "invalid sub button type", ErrCategoryArgument, false, "检查子菜单按钮的type"},
+		{40025, "不合法的子菜单按钮名字长度", "invalid sub button name size", ErrCategoryArgument, false, "缩短子菜单按钮名字"},
+		{40026, "不合法的子菜单按钮KEY长度", "invalid sub button key size", ErrCategoryArgument, false, "缩短子菜单按钮KEY"},
+		{40027, "不合法的子菜单按钮URL长度", "invalid sub button url size", ErrCategoryArgument, false, "缩短子菜单按钮URL"},
+		{40029, "无效的oauth_code", "invalid code", ErrCategoryArgument, false, "重新发起网页授权获取code"},
+		{40030, "不合法的refresh_token", "invalid refresh_token", ErrCategoryArgument, false, "重新发起网页授权"},
+		{40033, "不合法的请求字符", "invalid charset", ErrCategoryArgument, false, "请求内容使用UTF-8编码, 不能包含\\uxxxx格式的字符"},
+		{40035, "不合法的参数", "invalid parameter", ErrCategoryArgument, false, "检查请求参数"},
+		{40036, "不合法的template_id长度", "invalid template_id size", ErrCategoryArgument, false, "检查template_id"},
+		{40037, "不合法的template_id", "invalid template_id", ErrCategoryArgument, false, "检查模板是否存在且属于该公众号"},
+		{40039, "不合法的URL长度", "invalid url size", ErrCategoryArgument, false, "缩短URL"},
+		{40048, "无效的url", "invalid url domain", ErrCategoryArgument, false, "检查URL的域名"},
+		{40050, "不合法的分组id", "invalid group id", ErrCategoryArgument, false, "检查分组id"},
+		{40051, "分组名字不合法", "invalid group name", ErrCategoryArgument, false, "检查分组名字"},
+		{40054, "不合法的子菜单按钮url域名", "invalid sub button url domain", ErrCategoryArgument, false, "检查子菜单按钮URL的域名"},
+		{40055, "不合法的菜单按钮url域名", "invalid button url domain", ErrCategoryArgument, false, "检查菜单按钮URL的域名"},
+		{40066, "不合法的url", "invalid url", ErrCategoryArgument, false, "检查URL"},
+		{40117, "分组名字不合法", "invalid group name", ErrCategoryArgument, false, "检查分组名字"},
+		{40118, "media_id大小不合法", "invalid media_id size", ErrCategoryArgument, false, "检查media_id"},
+		{40119, "button类型错误", "invalid use button type", ErrCategoryArgument, false, "检查按钮的type"},
+		{40120, "子button类型错误", "invalid use sub button type", ErrCategoryArgument, false, "检查子菜单按钮的type"},
+		{40121, "不合法的media_id类型", "invalid media_id type", ErrCategoryArgument, false, "检查media_id对应的素材类型"},
+		{40125, "无效的AppSecret", "invalid appsecret", ErrCategoryArgument, false, "检查账号配置的AppSecret"},
+		{40132, "微信号不合法", "invalid wechat id", ErrCategoryArgument, false, "检查微信号"},
+		{40137, "不支持的图片格式", "invalid image format", ErrCategoryArgument, false, "使用jpg或png格式"},
+		{40155, "请勿添加其他公众号的主页链接", "please do not add other official account homepage link", ErrCategoryArgument, false, "移除其他公众号的主页链接"},
+		{40163, "oauth_code已使用", "code been used", ErrCategoryArgument, false, "重新发起网页授权获取code"},
+		{40164, "调用接口的IP地址不在白名单中", "invalid ip, not in whitelist", ErrCategoryPermission, false, "在公众平台将出口IP加入白名单"},
+		{40243, "AppSecret已被冻结", "appsecret has been frozen", ErrCategoryPermission, false, "在公众平台解冻AppSecret"},
+		{41001, "缺少access_token参数", "access_token missing", ErrCategoryToken, false, "传入AccessToken或AppId"},
+		{41002, "缺少appid参数", "appid missing", ErrCategoryArgument, false, "传入appid"},
+		{41003, "缺少refresh_token参数", "refresh_token missing", ErrCategoryArgument, false, "传入refresh_token"},
+		{41004, "缺少secret参数", "appsecret missing", ErrCategoryArgument, false, "检查账号配置的AppSecret"},
+		{41005, "缺少多媒体文件数据", "media data missing", ErrCategoryArgument, false, "检查上传的文件"},
+		{41006, "缺少media_id参数", "media_id missing", ErrCategoryArgument, false, "传入media_id"},
+		{41007, "缺少子菜单数据", "sub_menu data missing", ErrCategoryArgument, false, "检查子菜单数据"},
+		{41008, "缺少oauth code", "missing code", ErrCategoryArgument, false, "传入code"},
+		{41009, "缺少openid", "missing openid", ErrCategoryArgument, false, "传入openid"},
+		{42001, "access_token超时", "access_token expired", ErrCategoryToken, true, "刷新AccessToken后重试"},
+		{42002, "refresh_token超时", "refresh_token expired", ErrCategoryArgument, false, "重新发起网页授权"},
+		{42003, "oauth_code超时", "code expired", ErrCategoryArgument, false, "重新发起网页授权获取code"},
+		{42007, "用户修改微信密码, access_token和refresh_token失效", "access_token and refresh_token exception", ErrCategoryToken, false, "用户需要重新授权"},
+		{43001, "需要GET请求", "require GET method", ErrCategoryArgument, false, "使用GET请求"},
+		{43002, "需要POST请求", "require POST method", ErrCategoryArgument, false, "使用POST请求"},
+		{43003, "需要HTTPS请求", "require https", ErrCategoryArgument, false, "使用HTTPS请求"},
+		{43004, "需要接收者关注", "require subscribe", ErrCategoryBusiness, false, "用户关注公众号后再发送"},
+		{43005, "需要好友关系", "require friend relations", ErrCategoryBusiness, false, "确认好友关系"},
+		{43019, "需要将接收者从黑名单中移除", "require remove blacklist", ErrCategoryBusiness, false, "将用户移出黑名单"},
+		{43101, "用户拒绝接受消息", "user refuse to accept the msg", ErrCategoryBusiness, false, "用户需要重新订阅"},
+		{44001, "多媒体文件为空", "empty media data", ErrCategoryArgument, false, "检查上传的文件"},
+		{44002, "POST的数据包为空", "empty post data", ErrCategoryArgument, false, "检查请求体"},
+		{44003, "图文消息内容为空", "empty news data", ErrCategoryArgument, false, "检查图文消息内容"},
+		{44004, "文本消息内容为空", "empty content", ErrCategoryArgument, false, "检查文本消息内容"},
+		{44005, "列表为空", "empty list size", ErrCategoryArgument, false, "检查列表参数"},
+		{45001, "多媒体文件大小超过限制", "media size out of limit", ErrCategoryArgument, false, "压缩文件后重新上传"},
+		{45002, "消息内容超过限制", "content size out of limit", ErrCategoryArgument, false, "缩短消息内容"},
+		{45003, "标题字段超过限制", "title size out of limit", ErrCategoryArgument, false, "缩短标题"},
+		{45004, "描述字段超过限制", "description size out of limit", ErrCategoryArgument, false, "缩短描述"},
+		{45005, "链接字段超过限制", "url size out of limit", ErrCategoryArgument, false, "缩短链接"},
+		{45006, "图片链接字段超过限制", "picurl size out of limit", ErrCategoryArgument, false, "缩短图片链接"},
+		{45007, "语音播放时间超过限制", "playtime out of limit", ErrCategoryArgument, false, "缩短语音"},
+		{45008, "图文消息超过限制", "article size out of limit", ErrCategoryArgument, false, "减少图文数量"},
+		{45009, "接口调用超过限制", "reach max api daily quota limit", ErrCategoryQuota, false, "次日额度重置, 或调用ClearQuota清零"},
+		{45010, "创建菜单个数超过限制", "create menu limit", ErrCategoryQuota, false, "减少菜单数量"},
+		{45011, "API调用太频繁", "api minute-quota reach limit", ErrCategoryQuota, true, "降低调用频率, 稍候重试"},
+		{45015, "回复时间超过限制", "response out of time limit or subscription is canceled", ErrCategoryBusiness, false, "用户48小时内未与公众号互动, 无法发送客服消息"},
+		{45016, "系统分组, 不允许修改", "system group cannot be modified", ErrCategoryPermission, false, "不要修改系统分组"},
+		{45017, "分组名字过长", "group name too long", ErrCategoryArgument, false, "缩短分组名字"},
+		{45018, "分组数量超过上限", "too many groups", ErrCategoryQuota, false, "删除不用的分组"},
+		{45047, "客服接口下行条数超过上限", "out of response count limit", ErrCategoryQuota, false, "等待用户再次互动后发送"},
+		{45056, "创建的标签数过多", "too many tags", ErrCategoryQuota, false, "删除不用的标签, 标签最多100个"},
+		{45057, "该标签下粉丝数超过10w, 不允许直接删除", "tag has too many fans", ErrCategoryBusiness, false, "先取消粉丝的标签再删除"},
+		{45058, "不能修改0/1/2这三个系统默认保留的标签", "cannot modify system tag", ErrCategoryPermission, false, "不要修改系统标签"},
+		{45059, "有粉丝身上的标签数已经超过限制", "user tag count out of limit", ErrCategoryQuota, false, "用户最多打20个标签"},
+		{45157, "标签名非法", "invalid tag name", ErrCategoryArgument, false, "检查标签名, 不能与其他标签重名"},
+		{45158, "标签名长度超过30个字节", "tag name too long", ErrCategoryArgument, false, "缩短标签名"},
+		{45159, "非法的tag_id", "invalid tag id", ErrCategoryArgument, false, "检查tag_id"},
+		{46001, "不存在媒体数据", "media data no exist", ErrCategoryNotFound, false, "检查media_id"},
+		{46002, "不存在的菜单版本", "menu version no exist", ErrCategoryNotFound, false, "检查菜单版本"},
+		{46003, "不存在的菜单数据", "menu no exist", ErrCategoryNotFound, false, "先创建菜单"},
+		{46004, "不存在的用户", "user no exist", ErrCategoryNotFound, false, "检查OpenID"},
+		{47001, "解析JSON/XML内容错误", "data format error", ErrCategoryArgument, false, "检查请求体格式"},
+		{47003, "参数值不符合限制要求", "argument invalid", ErrCategoryArgument, false, "按接口文档检查参数"},
+		{48001, "api功能未授权", "api unauthorized", ErrCategoryPermission, false, "确认公众号已获得该接口权限"},
+		{48002, "粉丝拒收消息", "user block receive message", ErrCategoryPermission, false, "粉丝关闭了接收消息, 无法发送"},
+		{48004, "api接口被封禁", "api forbidden for irregularities", ErrCategoryPermission, false, "登录公众平台查看封禁原因"},
+		{48005, "api禁止删除被自动回复和自定义菜单引用的素材", "forbid to delete material used by auto-reply or menu", ErrCategoryBusiness, false, "先解除素材的引用"},
+		{48006, "api禁止清零调用次数, 因为清零次数达到上限", "forbid to clear quota because of reaching the limit", ErrCategoryQuota, false, "次日再清零"},
+		{48008, "没有该类型消息的发送权限", "no permission for this msgtype", ErrCategoryPermission, false, "确认公众号有该类型消息的权限"},
+		{49003, "传入的openid不属于此AppID", "not match openid with appid", ErrCategoryArgument, false, "检查OpenID与AppId是否对应"},
+		{50001, "用户未授权该api", "user unauthorized", ErrCategoryPermission, false, "引导用户授权"},
+		{50002, "用户受限, 可能是违规后接口被封禁", "user limited", ErrCategoryPermission, false, "登录公众平台查看违规记录"},
+		{50005, "用户未关注公众号", "user is unsubscribed", ErrCategoryBusiness, false, "用户关注公众号后再调用"},
+		{53500, "发布功能被封禁", "publish function is banned", ErrCategoryPermission, false, "登录公众平台查看封禁原因"},
+		{61004, "当前ip不在第三方平台的白名单中", "access clientip is not registered", ErrCategoryPermission, false, "在第三方平台将出口IP加入白名单"},
+		{61007, "第三方平台未被授权该接口", "api is unauthorized to component", ErrCategoryPermission, false, "公众号需要向第三方平台授权该权限集"},
+		{61450, "系统错误", "system error", ErrCategorySystem, true, "稍候重试"},
+		{61451, "参数错误", "invalid parameter", ErrCategoryArgument, false, "检查请求参数"},
+		{61452, "无效客服账号", "invalid kf_account", ErrCategoryArgument, false, "检查客服账号"},
+		{61453, "客服帐号已存在", "kf_account exsited", ErrCategoryBusiness, false, "使用其他客服账号"},
+		{61454, "客服帐号名长度超过限制", "invalid kf_acount length", ErrCategoryArgument, false, "客服帐号名最多10个字符"},
+		{61455, "客服帐号名包含非法字符", "illegal character in kf_account", ErrCategoryArgument, false, "客服帐号名仅支持英文和数字"},
+		{61456, "客服帐号个数超过限制", "kf_account count exceeded", ErrCategoryQuota, false, "删除不用的客服帐号"},
+		{61457, "无效头像文件类型", "invalid file type", ErrCategoryArgument, false, "头像使用jpg格式"},
+		{61500, "日期格式错误", "date format error", ErrCategoryArgument, false, "检查日期格式"},
+		{63001, "部分参数为空", "some arguments are empty", ErrCategoryArgument, false, "检查必填参数"},
+		{63002, "无效的签名", "invalid signature", ErrCategoryArgument, false, "检查签名算法"},
+		{65400, "未开通新版客服功能", "please enable new custom service", ErrCategoryPermission, false, "在公众平台开通新版客服功能"},
+		{65401, "无效客服帐号", "invalid custom service account", ErrCategoryArgument, false, "检查客服帐号"},
+		{65403, "客服昵称不合法", "illegal nickname", ErrCategoryArgument, false, "检查客服昵称"},
+		{65404, "客服帐号不合法", "illegal custom service account", ErrCategoryArgument, false, "检查客服帐号"},
+		{65405, "帐号数目已达到上限", "custom service account count exceeded", ErrCategoryQuota, false, "删除不用的客服帐号"},
+		{65406, "已经存在的客服帐号", "custom service account exists", ErrCategoryBusiness, false, "使用其他客服帐号"},
+		{65407, "邀请对象已经是该公众号客服", "the wechat account is already a custom service", ErrCategoryBusiness, false, "无需重复邀请"},
+		{65408, "本公众号已经有一个邀请给该微信", "invite already sent to the wechat account", ErrCategoryBusiness, false, "等待对方确认邀请"},
+		{65409, "无效的微信号", "invalid wechat account", ErrCategoryArgument, false, "检查邀请的微信号"},
+		{65410, "邀请对象绑定公众号客服数达到上限", "the wechat account bound too many custom services", ErrCategoryQuota, false, "邀请其他微信号"},
+		{65411, "该帐号已经有一个等待确认的邀请", "invitation is waiting for confirm", ErrCategoryBusiness, false, "等待对方确认邀请"},
+		{65412, "该帐号已经绑定微信号", "custom service account already bound", ErrCategoryBusiness, false, "无需重复邀请"},
+		{65413, "不存在对应用户的会话信息", "no session for the user", ErrCategoryNotFound, false, "检查OpenID"},
+		{65414, "客户正在被其他客服接待", "user is being served by another custom service", ErrCategoryBusiness, false, "等待会话结束"},
+		{65415, "客服不在线", "custom service is offline", ErrCategoryBusiness, false, "选择在线的客服"},
+		{65416, "查询参数不合法", "invalid query parameter", ErrCategoryArgument, false, "检查查询参数"},
+		{65417, "查询时间段超出限制", "query time range exceeded", ErrCategoryArgument, false, "查询时间段最长24小时"},
+		{87009, "无效的签名", "invalid signature", ErrCategoryArgument, false, "检查签名算法"},
+		{200011, "此账号已被封禁, 无法操作", "account is banned", ErrCategoryPermission, false, "登录公众平台查看封禁原因"},
+		{200012, "私有模板数已达上限", "private template count exceeded", ErrCategoryQuota, false, "删除不用的模板, 模板最多50个"},
+		{200013, "此模板已被封禁, 无法选用", "template is banned", ErrCategoryPermission, false, "选用其他模板"},
+		{200014, "模板tid参数错误", "invalid template tid", ErrCategoryArgument, false, "检查tid"},
+		{200020, "关键词列表kidList参数错误", "invalid kidList", ErrCategoryArgument, false, "检查kidList"},
+		{200021, "场景描述sceneDesc参数错误", "invalid sceneDesc", ErrCategoryArgument, false, "检查sceneDesc"},
+	} {
+		errCodeCatalog[info.Code] = info
+	}
+}
+
+// LookupErrCode 查询错误码说明, 不在目录中时返回nil
+func LookupErrCode(errCode int64) *ErrCodeInfo {
+	return errCodeCatalog[errCode]
+}
+
+// DescribeErrCode 查询错误码说明及对应的错误原因
+func DescribeErrCode(errCode int64) (*ErrCodeInfo, string, error) {
+	info := LookupErrCode(errCode)
+	if info == nil {
+		return nil, "", v1.ErrorNotFound("errcode %d not in catalog", errCode)
+	}
+	return info, wxErrorReason(errCode)("").Reason, nil
+}
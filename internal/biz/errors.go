@@ -0,0 +1,108 @@
+package biz
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	wxError "github.com/seth16888/wxcommon/error"
+	v1 "github.com/seth16888/wxproxy/api/v1"
+)
+
+// ridPattern 微信errmsg末尾的请求ID, 如: "invalid credential rid: 6486d6f1-5be4d1ac-1bea40f7"
+var ridPattern = regexp.MustCompile(`rid:\s*([0-9a-zA-Z-]+)`)
+
+// wxErrorReason 微信错误码对应的错误
+//
+// 错误码目录中的错误码按分类转换, 其余按号段归类, 无法归类的为WX_ERROR.
+func wxErrorReason(errCode int64) func(format string, args ...interface{}) *errors.Error {
+	if info := LookupErrCode(errCode); info != nil {
+		return categoryReasons[info.Category]
+	}
+
+	switch {
+	case errCode >= 40002 && errCode < 42000, errCode >= 44000 && errCode < 45000,
+		errCode >= 47000 && errCode < 48000:
+		// 40xxx不合法的参数, 41xxx缺少参数, 44xxx参数为空, 47xxx参数格式错误
+		return v1.ErrorInvalidArgument
+	}
+	return v1.ErrorWxError
+}
+
+// NewWXError 将微信接口返回的errcode转换为错误
+//
+// ErrorInfo的metadata中携带errcode、errmsg和rid, 错误码在目录中时还携带
+// category、retryable、description、description_en和action.
+func NewWXError(errCode int64, errMsg string) *errors.Error {
+	md := map[string]string{
+		"errcode": strconv.FormatInt(errCode, 10),
+		"errmsg":  errMsg,
+		"rid":     ExtractRid(errMsg),
+	}
+	if info := LookupErrCode(errCode); info != nil {
+		md["category"] = info.Category
+		md["retryable"] = strconv.FormatBool(info.Retryable)
+		md["description"] = info.Zh
+		md["description_en"] = info.En
+		md["action"] = info.Action
+	}
+
+	return wxErrorReason(errCode)("%s", errMsg).WithMetadata(md)
+}
+
+// ExtractRid 从errmsg中提取rid
+func ExtractRid(errMsg string) string {
+	if m := ridPattern.FindStringSubmatch(errMsg); len(m) > 1 {
+		return m[1]
+	}
+	return ""
+}
+
+// requestError 请求微信接口的网络错误
+//
+// 错误会返回给调用方, 消息中只包含接口路径; url.Error会输出完整的URL, 其中带有access_token、secret等参数.
+func requestError(err error) *errors.Error {
+	var urlErr *url.Error
+	if stderrors.As(err, &urlErr) {
+		return v1.ErrorUpstreamUnavailable("request wechat api %s %s: %v", urlErr.Op, urlPath(urlErr.URL), urlErr.Err)
+	}
+	return v1.ErrorUpstreamUnavailable("request wechat api: %v", err)
+}
+
+// decodeResponse 解析微信接口的响应, errcode非0时返回NewWXError
+func decodeResponse[T any](resp *http.Response, err error) (*T, error) {
+	if err != nil {
+		if _, ok := err.(*errors.Error); ok {
+			return nil, err
+		}
+		return nil, requestError(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return nil, v1.ErrorUpstreamUnavailable("wechat api http status: %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, v1.ErrorUpstreamUnavailable("read wechat api response: %v", err)
+	}
+
+	var wxErr wxError.WXError
+	if err := json.Unmarshal(data, &wxErr); err != nil {
+		return nil, v1.ErrorWxError("decode wechat api response: %v", err)
+	}
+	if wxErr.ErrCode != 0 {
+		return nil, NewWXError(wxErr.ErrCode, wxErr.ErrMsg)
+	}
+
+	rt := new(T)
+	if err := json.Unmarshal(data, rt); err != nil {
+		return nil, v1.ErrorWxError("decode wechat api response: %v", err)
+	}
+	return rt, nil
+}
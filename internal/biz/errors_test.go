@@ -0,0 +1,32 @@
+package biz
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/seth16888/wxcommon/hc"
+)
+
+func TestRequestErrorHidesQuery(t *testing.T) {
+	client := hc.NewClient(hc.DefaultTimeout, hc.DefaultIdleConnTimeout, hc.CommonCheckRedirect)
+	// 无法连接的地址
+	rawURL := "http://127.0.0.1:1/cgi-bin/token?grant_type=client_credential&appid=wx1&secret=app-secret&access_token=token-value"
+
+	resp, err := httpDo(context.Background(), client, http.MethodGet, rawURL, "", nil)
+	if err == nil {
+		resp.Body.Close()
+		t.Fatal("want network error")
+	}
+	_, err = decodeResponse[struct{}](resp, err)
+	msg := err.Error()
+	for _, secret := range []string{"app-secret", "token-value", "secret=", "access_token"} {
+		if strings.Contains(msg, secret) {
+			t.Fatalf("error message contains %q: %s", secret, msg)
+		}
+	}
+	if !strings.Contains(msg, "/cgi-bin/token") {
+		t.Fatalf("error message should contain the path: %s", msg)
+	}
+}
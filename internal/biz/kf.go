@@ -7,7 +7,6 @@ import (
 	"github.com/seth16888/wxcommon/domain"
 	wxError "github.com/seth16888/wxcommon/error"
 	"github.com/seth16888/wxcommon/helpers"
-	. "github.com/seth16888/wxcommon/logger"
 	"github.com/seth16888/wxcommon/paths"
 
 	v1 "github.com/seth16888/wxproxy/api/v1"
@@ -228,7 +227,7 @@ type GetUnacceptedSessionListRes struct {
 // AddKFAccount
 func (m *MPProxyUsecase) AddKFAccount(ctx context.Context, token string, account string, nick string, pwd string) error {
 	url := fmt.Sprintf("https://%s%s?access_token=%s", domain.GetWXAPIDomain(), paths.Path_Add_KfAccount, token)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	type AddKFAccountReq struct {
 		Account string `json:"kf_account"`
@@ -240,20 +239,14 @@ func (m *MPProxyUsecase) AddKFAccount(ctx context.Context, token string, account
 	}
 	reader, err := helpers.BuildRequestBody[*AddKFAccountReq](req)
 	if err != nil {
-		Errorf("build request body error: %s", err.Error())
+		m.logger(ctx).Errorf("build request body error: %s", err.Error())
 		return err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", reader)
-	rt, wxErr := helpers.BuildHttpResponse[wxError.WXError](resp, err)
-	if wxErr != nil {
-		Errorf("AddKFAccount error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return fmt.Errorf("AddKFAccount error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if rt.ErrCode != 0 {
-		Errorf("AddKFAccount error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return fmt.Errorf("AddKFAccount error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Post(ctx, url, "application/json", reader)
+	if _, err := decodeResponse[wxError.WXError](resp, err); err != nil {
+		m.logger(ctx).Errorf("AddKFAccount error: %s", err.Error())
+		return err
 	}
 
 	return nil
@@ -262,7 +255,7 @@ func (m *MPProxyUsecase) AddKFAccount(ctx context.Context, token string, account
 // InviteKFWorker
 func (m *MPProxyUsecase) InviteKFWorker(ctx context.Context, token string, account string, inviteWx string) error {
 	url := fmt.Sprintf("https://%s%s?access_token=%s", domain.GetWXAPIDomain(), paths.Path_Invite_Worker, token)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	type InviteKFWorkerReq struct {
 		Account string `json:"kf_account"`
@@ -274,20 +267,14 @@ func (m *MPProxyUsecase) InviteKFWorker(ctx context.Context, token string, accou
 	}
 	reader, err := helpers.BuildRequestBody[*InviteKFWorkerReq](req)
 	if err != nil {
-		Errorf("build request body error: %s", err.Error())
+		m.logger(ctx).Errorf("build request body error: %s", err.Error())
 		return err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", reader)
-	rt, wxErr := helpers.BuildHttpResponse[wxError.WXError](resp, err)
-	if wxErr != nil {
-		Errorf("InviteKFWorker error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return fmt.Errorf("InviteKFWorker error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if rt.ErrCode != 0 {
-		Errorf("InviteKFWorker error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return fmt.Errorf("InviteKFWorker error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Post(ctx, url, "application/json", reader)
+	if _, err := decodeResponse[wxError.WXError](resp, err); err != nil {
+		m.logger(ctx).Errorf("InviteKFWorker error: %s", err.Error())
+		return err
 	}
 
 	return nil
@@ -296,7 +283,7 @@ func (m *MPProxyUsecase) InviteKFWorker(ctx context.Context, token string, accou
 // SendKFTextMsg
 func (m *MPProxyUsecase) SendKFTextMsg(ctx context.Context, req *v1.SendKFTextMsgRequest) error {
 	url := fmt.Sprintf("https://%s%s?access_token=%s", domain.GetWXAPIDomain(), paths.Path_KF_Send_Message, req.AccessToken)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	body := &KfTextMessage{
 		KfMessageComm: KfMessageComm{
@@ -317,20 +304,14 @@ func (m *MPProxyUsecase) SendKFTextMsg(ctx context.Context, req *v1.SendKFTextMs
 
 	reader, err := helpers.BuildRequestBody[*KfTextMessage](body)
 	if err != nil {
-		Errorf("build request body error: %s", err.Error())
+		m.logger(ctx).Errorf("build request body error: %s", err.Error())
 		return err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", reader)
-	rt, wxErr := helpers.BuildHttpResponse[wxError.WXError](resp, err)
-	if wxErr != nil {
-		Errorf("SendKFTextMsg error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return fmt.Errorf("SendKFTextMsg error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if rt.ErrCode != 0 {
-		Errorf("SendKFTextMsg error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return fmt.Errorf("SendKFTextMsg error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Post(ctx, url, "application/json", reader)
+	if _, err := decodeResponse[wxError.WXError](resp, err); err != nil {
+		m.logger(ctx).Errorf("SendKFTextMsg error: %s", err.Error())
+		return err
 	}
 
 	return nil
@@ -339,18 +320,13 @@ func (m *MPProxyUsecase) SendKFTextMsg(ctx context.Context, req *v1.SendKFTextMs
 // GetKFSessionList
 func (m *MPProxyUsecase) GetKFSessionList(ctx context.Context, token string, kf string) (*GetKFSessionListRes, error) {
 	url := fmt.Sprintf("https://%s%s?access_token=%s&kf_account=%s", domain.GetWXAPIDomain(), paths.Path_Get_KFSessionList, token, kf)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
-	resp, err := m.hc.Get(url)
-	rt, wxErr := helpers.BuildHttpResponse[GetKFSessionListRes](resp, err)
-	if wxErr != nil {
-		Errorf("GetKFSessionList error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return nil, fmt.Errorf("GetKFSessionList error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if rt.ErrCode != 0 {
-		Errorf("GetKFSessionList error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return nil, fmt.Errorf("GetKFSessionList error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Get(ctx, url)
+	rt, err := decodeResponse[GetKFSessionListRes](resp, err)
+	if err != nil {
+		m.logger(ctx).Errorf("GetKFSessionList error: %s", err.Error())
+		return nil, err
 	}
 
 	return rt, nil
@@ -359,7 +335,7 @@ func (m *MPProxyUsecase) GetKFSessionList(ctx context.Context, token string, kf
 // NewKFSession
 func (m *MPProxyUsecase) NewKFSession(ctx context.Context, token string, kf string, openid string) error {
 	url := fmt.Sprintf("https://%s%s?access_token=%s", domain.GetWXAPIDomain(), paths.Path_Create_Session, token)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	type NewKFSessionReq struct {
 		OpenID    string `json:"openid"`
@@ -371,19 +347,14 @@ func (m *MPProxyUsecase) NewKFSession(ctx context.Context, token string, kf stri
 	}
 	reader, err := helpers.BuildRequestBody[*NewKFSessionReq](req)
 	if err != nil {
-		Errorf("build request body error: %s", err.Error())
+		m.logger(ctx).Errorf("build request body error: %s", err.Error())
 		return err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", reader)
-	rt, wxErr := helpers.BuildHttpResponse[wxError.WXError](resp, err)
-	if wxErr != nil {
-		Errorf("NewKFSession error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return fmt.Errorf("NewKFSession error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-	if rt.ErrCode != 0 {
-		Errorf("NewKFSession error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return fmt.Errorf("NewKFSession error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Post(ctx, url, "application/json", reader)
+	if _, err := decodeResponse[wxError.WXError](resp, err); err != nil {
+		m.logger(ctx).Errorf("NewKFSession error: %s", err.Error())
+		return err
 	}
 
 	return nil
@@ -392,7 +363,7 @@ func (m *MPProxyUsecase) NewKFSession(ctx context.Context, token string, kf stri
 // UpdateKFAccount
 func (m *MPProxyUsecase) UpdateKFAccount(ctx context.Context, token string, account string, nick string) error {
 	url := fmt.Sprintf("https://%s%s?access_token=%s", domain.GetWXAPIDomain(), paths.Path_Update_KfAccount, token)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	type UpdateKFAccountReq struct {
 		Account string `json:"kf_account"`
@@ -405,19 +376,14 @@ func (m *MPProxyUsecase) UpdateKFAccount(ctx context.Context, token string, acco
 
 	reader, err := helpers.BuildRequestBody[*UpdateKFAccountReq](req)
 	if err != nil {
-		Errorf("build request body error: %s", err.Error())
+		m.logger(ctx).Errorf("build request body error: %s", err.Error())
 		return err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", reader)
-	rt, wxErr := helpers.BuildHttpResponse[wxError.WXError](resp, err)
-	if wxErr != nil {
-		Errorf("UpdateKFAccount error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return fmt.Errorf("UpdateKFAccount error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-	if rt.ErrCode != 0 {
-		Errorf("UpdateKFAccount error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return fmt.Errorf("UpdateKFAccount error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Post(ctx, url, "application/json", reader)
+	if _, err := decodeResponse[wxError.WXError](resp, err); err != nil {
+		m.logger(ctx).Errorf("UpdateKFAccount error: %s", err.Error())
+		return err
 	}
 
 	return nil
@@ -426,18 +392,12 @@ func (m *MPProxyUsecase) UpdateKFAccount(ctx context.Context, token string, acco
 // DelKFAccount
 func (m *MPProxyUsecase) DelKFAccount(ctx context.Context, token string, account string) error {
 	url := fmt.Sprintf("https://%s%s?access_token=%s&kf_account=%s", domain.GetWXAPIDomain(), paths.Path_Del_KfAccount, token, account)
-	Debugf("url: %s", url)
-
-	resp, err := m.hc.Get(url)
-	rt, wxErr := helpers.BuildHttpResponse[wxError.WXError](resp, err)
-	if wxErr != nil {
-		Errorf("DelKFAccount error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return fmt.Errorf("DelKFAccount error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
+	m.logger(ctx).Debugf("url: %s", url)
 
-	if rt.ErrCode != 0 {
-		Errorf("DelKFAccount error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return fmt.Errorf("DelKFAccount error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Get(ctx, url)
+	if _, err := decodeResponse[wxError.WXError](resp, err); err != nil {
+		m.logger(ctx).Errorf("DelKFAccount error: %s", err.Error())
+		return err
 	}
 	return nil
 }
@@ -445,7 +405,7 @@ func (m *MPProxyUsecase) DelKFAccount(ctx context.Context, token string, account
 // UpdateKFTyping
 func (m *MPProxyUsecase) UpdateKFTyping(ctx context.Context, token string, touser string, command string) error {
 	url := fmt.Sprintf("https://%s%s?access_token=%s", domain.GetWXAPIDomain(), paths.Path_Update_KfStatus, token)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	type UpdateKFTypingReq struct {
 		ToUser  string `json:"touser"`
@@ -458,20 +418,14 @@ func (m *MPProxyUsecase) UpdateKFTyping(ctx context.Context, token string, touse
 
 	reader, err := helpers.BuildRequestBody[*UpdateKFTypingReq](req)
 	if err != nil {
-		Errorf("build request body error: %s", err.Error())
+		m.logger(ctx).Errorf("build request body error: %s", err.Error())
 		return err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", reader)
-	rt, wxErr := helpers.BuildHttpResponse[wxError.WXError](resp, err)
-	if wxErr != nil {
-		Errorf("UpdateKFTyping error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return fmt.Errorf("UpdateKFTyping error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if rt.ErrCode != 0 {
-		Errorf("UpdateKFTyping error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return fmt.Errorf("UpdateKFTyping error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Post(ctx, url, "application/json", reader)
+	if _, err := decodeResponse[wxError.WXError](resp, err); err != nil {
+		m.logger(ctx).Errorf("UpdateKFTyping error: %s", err.Error())
+		return err
 	}
 
 	return nil
@@ -480,18 +434,13 @@ func (m *MPProxyUsecase) UpdateKFTyping(ctx context.Context, token string, touse
 // GetKFSessionStatus
 func (m *MPProxyUsecase) GetKFSessionStatus(ctx context.Context, token string, openid string) (*GetKFSessionStatusRes, error) {
 	url := fmt.Sprintf("https://%s%s?access_token=%s&openid=%s", domain.GetWXAPIDomain(), paths.Path_Get_SessionStatus, token, openid)
-	Debugf("url: %s", url)
-
-	resp, err := m.hc.Get(url)
-	rt, wxErr := helpers.BuildHttpResponse[GetKFSessionStatusRes](resp, err)
-	if wxErr != nil {
-		Errorf("GetKFSessionStatus error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return nil, fmt.Errorf("GetKFSessionStatus error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
+	m.logger(ctx).Debugf("url: %s", url)
 
-	if rt.ErrCode != 0 {
-		Errorf("GetKFSessionStatus error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return nil, fmt.Errorf("GetKFSessionStatus error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Get(ctx, url)
+	rt, err := decodeResponse[GetKFSessionStatusRes](resp, err)
+	if err != nil {
+		m.logger(ctx).Errorf("GetKFSessionStatus error: %s", err.Error())
+		return nil, err
 	}
 
 	return rt, nil
@@ -500,18 +449,13 @@ func (m *MPProxyUsecase) GetKFSessionStatus(ctx context.Context, token string, o
 // GetKFSessionUnaccepted
 func (m *MPProxyUsecase) GetKFSessionUnaccepted(ctx context.Context, token string) (*GetUnacceptedSessionListRes, error) {
 	url := fmt.Sprintf("https://%s%s?access_token=%s", domain.GetWXAPIDomain(), paths.Path_Get_UnacceptedSessionList, token)
-	Debugf("url: %s", url)
-
-	resp, err := m.hc.Get(url)
-	rt, wxErr := helpers.BuildHttpResponse[GetUnacceptedSessionListRes](resp, err)
-	if wxErr != nil {
-		Errorf("GetKFSessionUnaccepted error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return nil, fmt.Errorf("GetKFSessionUnaccepted error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
+	m.logger(ctx).Debugf("url: %s", url)
 
-	if rt.ErrCode != 0 {
-		Errorf("GetKFSessionUnaccepted error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return nil, fmt.Errorf("GetKFSessionUnaccepted error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Get(ctx, url)
+	rt, err := decodeResponse[GetUnacceptedSessionListRes](resp, err)
+	if err != nil {
+		m.logger(ctx).Errorf("GetKFSessionUnaccepted error: %s", err.Error())
+		return nil, err
 	}
 
 	return rt, nil
@@ -520,7 +464,7 @@ func (m *MPProxyUsecase) GetKFSessionUnaccepted(ctx context.Context, token strin
 // CloseKFSession
 func (m *MPProxyUsecase) CloseKFSession(ctx context.Context, token string, kf string, openid string) error {
 	url := fmt.Sprintf("https://%s%s?access_token=%s", domain.GetWXAPIDomain(), paths.Path_Close_Session, token)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	type CloseKFSessionReq struct {
 		KfAccount string `json:"kf_account"`
@@ -533,20 +477,14 @@ func (m *MPProxyUsecase) CloseKFSession(ctx context.Context, token string, kf st
 
 	reader, err := helpers.BuildRequestBody[*CloseKFSessionReq](req)
 	if err != nil {
-		Errorf("build request body error: %s", err.Error())
+		m.logger(ctx).Errorf("build request body error: %s", err.Error())
 		return err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", reader)
-	rt, wxErr := helpers.BuildHttpResponse[wxError.WXError](resp, err)
-	if wxErr != nil {
-		Errorf("CloseKFSession error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return fmt.Errorf("CloseKFSession error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if rt.ErrCode != 0 {
-		Errorf("CloseKFSession error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return fmt.Errorf("CloseKFSession error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Post(ctx, url, "application/json", reader)
+	if _, err := decodeResponse[wxError.WXError](resp, err); err != nil {
+		m.logger(ctx).Errorf("CloseKFSession error: %s", err.Error())
+		return err
 	}
 
 	return nil
@@ -555,7 +493,7 @@ func (m *MPProxyUsecase) CloseKFSession(ctx context.Context, token string, kf st
 // SendKFImageMsg
 func (m *MPProxyUsecase) SendKFImageMsg(ctx context.Context, req *v1.SendKFImageMsgRequest) error {
 	url := fmt.Sprintf("https://%s%s?access_token=%s", domain.GetWXAPIDomain(), paths.Path_KF_Send_Message, req.AccessToken)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	body := &KfImageMessage{
 		KfMessageComm: KfMessageComm{
@@ -576,19 +514,14 @@ func (m *MPProxyUsecase) SendKFImageMsg(ctx context.Context, req *v1.SendKFImage
 
 	reader, err := helpers.BuildRequestBody[*KfImageMessage](body)
 	if err != nil {
-		Errorf("build request body error: %s", err.Error())
+		m.logger(ctx).Errorf("build request body error: %s", err.Error())
 		return err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", reader)
-	rt, wxErr := helpers.BuildHttpResponse[wxError.WXError](resp, err)
-	if wxErr != nil {
-		Errorf("SendKFImageMsg error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return fmt.Errorf("SendKFImageMsg error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-	if rt.ErrCode != 0 {
-		Errorf("SendKFImageMsg error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return fmt.Errorf("SendKFImageMsg error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Post(ctx, url, "application/json", reader)
+	if _, err := decodeResponse[wxError.WXError](resp, err); err != nil {
+		m.logger(ctx).Errorf("SendKFImageMsg error: %s", err.Error())
+		return err
 	}
 
 	return nil
@@ -597,7 +530,7 @@ func (m *MPProxyUsecase) SendKFImageMsg(ctx context.Context, req *v1.SendKFImage
 // SendKFVoiceMsg
 func (m *MPProxyUsecase) SendKFVoiceMsg(ctx context.Context, req *v1.SendKFVoiceMsgRequest) error {
 	url := fmt.Sprintf("https://%s%s?access_token=%s", domain.GetWXAPIDomain(), paths.Path_KF_Send_Message, req.AccessToken)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	body := &KfVoiceMessage{
 		KfMessageComm: KfMessageComm{
@@ -618,20 +551,14 @@ func (m *MPProxyUsecase) SendKFVoiceMsg(ctx context.Context, req *v1.SendKFVoice
 
 	reader, err := helpers.BuildRequestBody[*KfVoiceMessage](body)
 	if err != nil {
-		Errorf("build request body error: %s", err.Error())
+		m.logger(ctx).Errorf("build request body error: %s", err.Error())
 		return err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", reader)
-	rt, wxErr := helpers.BuildHttpResponse[wxError.WXError](resp, err)
-	if wxErr != nil {
-		Errorf("SendKFVoiceMsg error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return fmt.Errorf("SendKFVoiceMsg error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if rt.ErrCode != 0 {
-		Errorf("SendKFVoiceMsg error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return fmt.Errorf("SendKFVoiceMsg error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Post(ctx, url, "application/json", reader)
+	if _, err := decodeResponse[wxError.WXError](resp, err); err != nil {
+		m.logger(ctx).Errorf("SendKFVoiceMsg error: %s", err.Error())
+		return err
 	}
 
 	return nil
@@ -640,7 +567,7 @@ func (m *MPProxyUsecase) SendKFVoiceMsg(ctx context.Context, req *v1.SendKFVoice
 // SendKFVideoMsg
 func (m *MPProxyUsecase) SendKFVideoMsg(ctx context.Context, req *v1.SendKFVideoMsgRequest) error {
 	url := fmt.Sprintf("https://%s%s?access_token=%s", domain.GetWXAPIDomain(), paths.Path_KF_Send_Message, req.AccessToken)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	body := &KfVideoMessage{
 		KfMessageComm: KfMessageComm{
@@ -667,20 +594,14 @@ func (m *MPProxyUsecase) SendKFVideoMsg(ctx context.Context, req *v1.SendKFVideo
 
 	reader, err := helpers.BuildRequestBody[*KfVideoMessage](body)
 	if err != nil {
-		Errorf("build request body error: %s", err.Error())
+		m.logger(ctx).Errorf("build request body error: %s", err.Error())
 		return err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", reader)
-	rt, wxErr := helpers.BuildHttpResponse[wxError.WXError](resp, err)
-	if wxErr != nil {
-		Errorf("SendKFVideoMsg error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return fmt.Errorf("SendKFVideoMsg error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if rt.ErrCode != 0 {
-		Errorf("SendKFVideoMsg error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return fmt.Errorf("SendKFVideoMsg error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Post(ctx, url, "application/json", reader)
+	if _, err := decodeResponse[wxError.WXError](resp, err); err != nil {
+		m.logger(ctx).Errorf("SendKFVideoMsg error: %s", err.Error())
+		return err
 	}
 
 	return nil
@@ -689,7 +610,7 @@ func (m *MPProxyUsecase) SendKFVideoMsg(ctx context.Context, req *v1.SendKFVideo
 // SendKFMusicMsg
 func (m *MPProxyUsecase) SendKFMusicMsg(ctx context.Context, req *v1.SendKFMusicMsgRequest) error {
 	url := fmt.Sprintf("https://%s%s?access_token=%s", domain.GetWXAPIDomain(), paths.Path_KF_Send_Message, req.AccessToken)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	body := &KfMusicMessage{
 		KfMessageComm: KfMessageComm{
@@ -718,20 +639,14 @@ func (m *MPProxyUsecase) SendKFMusicMsg(ctx context.Context, req *v1.SendKFMusic
 
 	reader, err := helpers.BuildRequestBody[*KfMusicMessage](body)
 	if err != nil {
-		Errorf("build request body error: %s", err.Error())
+		m.logger(ctx).Errorf("build request body error: %s", err.Error())
 		return err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", reader)
-	rt, wxErr := helpers.BuildHttpResponse[wxError.WXError](resp, err)
-	if wxErr != nil {
-		Errorf("SendKFMusicMsg error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return fmt.Errorf("SendKFMusicMsg error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if rt.ErrCode != 0 {
-		Errorf("SendKFMusicMsg error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return fmt.Errorf("SendKFMusicMsg error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Post(ctx, url, "application/json", reader)
+	if _, err := decodeResponse[wxError.WXError](resp, err); err != nil {
+		m.logger(ctx).Errorf("SendKFMusicMsg error: %s", err.Error())
+		return err
 	}
 
 	return nil
@@ -740,7 +655,7 @@ func (m *MPProxyUsecase) SendKFMusicMsg(ctx context.Context, req *v1.SendKFMusic
 // SendKFNewsCardMsg
 func (m *MPProxyUsecase) SendKFNewsCardMsg(ctx context.Context, req *v1.SendKFNewsCardMsgRequest) error {
 	url := fmt.Sprintf("https://%s%s?access_token=%s", domain.GetWXAPIDomain(), paths.Path_KF_Send_Message, req.AccessToken)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	body := &KfNewsLinkToURLMessage{
 		KfMessageComm: KfMessageComm{
@@ -776,20 +691,14 @@ func (m *MPProxyUsecase) SendKFNewsCardMsg(ctx context.Context, req *v1.SendKFNe
 
 	reader, err := helpers.BuildRequestBody[*KfNewsLinkToURLMessage](body)
 	if err != nil {
-		Errorf("build request body error: %s", err.Error())
+		m.logger(ctx).Errorf("build request body error: %s", err.Error())
 		return err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", reader)
-	rt, wxErr := helpers.BuildHttpResponse[wxError.WXError](resp, err)
-	if wxErr != nil {
-		Errorf("SendKFNewsCardMsg error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return fmt.Errorf("SendKFNewsCardMsg error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if rt.ErrCode != 0 {
-		Errorf("SendKFNewsCardMsg error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return fmt.Errorf("SendKFNewsCardMsg error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Post(ctx, url, "application/json", reader)
+	if _, err := decodeResponse[wxError.WXError](resp, err); err != nil {
+		m.logger(ctx).Errorf("SendKFNewsCardMsg error: %s", err.Error())
+		return err
 	}
 
 	return nil
@@ -798,7 +707,7 @@ func (m *MPProxyUsecase) SendKFNewsCardMsg(ctx context.Context, req *v1.SendKFNe
 // SendKFNewsPageMsg
 func (m *MPProxyUsecase) SendKFNewsPageMsg(ctx context.Context, req *v1.SendKFNewsPageMsgRequest) error {
 	url := fmt.Sprintf("https://%s%s?access_token=%s", domain.GetWXAPIDomain(), paths.Path_KF_Send_Message, req.AccessToken)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	body := &KfNewsLinkToPageMessage{}
 	body.KfMessageComm.KF.KFAccount = req.Common.CustomerService.KfAccount
@@ -808,20 +717,14 @@ func (m *MPProxyUsecase) SendKFNewsPageMsg(ctx context.Context, req *v1.SendKFNe
 
 	reader, err := helpers.BuildRequestBody[*KfNewsLinkToPageMessage](body)
 	if err != nil {
-		Errorf("build request body error: %s", err.Error())
+		m.logger(ctx).Errorf("build request body error: %s", err.Error())
 		return err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", reader)
-	rt, wxErr := helpers.BuildHttpResponse[wxError.WXError](resp, err)
-	if wxErr != nil {
-		Errorf("SendKFNewsPageMsg error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return fmt.Errorf("SendKFNewsPageMsg error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if rt.ErrCode != 0 {
-		Errorf("SendKFNewsPageMsg error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return fmt.Errorf("SendKFNewsPageMsg error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Post(ctx, url, "application/json", reader)
+	if _, err := decodeResponse[wxError.WXError](resp, err); err != nil {
+		m.logger(ctx).Errorf("SendKFNewsPageMsg error: %s", err.Error())
+		return err
 	}
 
 	return nil
@@ -830,7 +733,7 @@ func (m *MPProxyUsecase) SendKFNewsPageMsg(ctx context.Context, req *v1.SendKFNe
 // SendKFToArticleMsg
 func (m *MPProxyUsecase) SendKFToArticleMsg(ctx context.Context, req *v1.SendKFToArticleMsgRequest) error {
 	url := fmt.Sprintf("https://%s%s?access_token=%s", domain.GetWXAPIDomain(), paths.Path_KF_Send_Message, req.AccessToken)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	body := &KfNewsLinkToArticleMessage{}
 	body.KfMessageComm.KF.KFAccount = req.Common.CustomerService.KfAccount
@@ -840,20 +743,14 @@ func (m *MPProxyUsecase) SendKFToArticleMsg(ctx context.Context, req *v1.SendKFT
 
 	reader, err := helpers.BuildRequestBody[*KfNewsLinkToArticleMessage](body)
 	if err != nil {
-		Errorf("build request body error: %s", err.Error())
+		m.logger(ctx).Errorf("build request body error: %s", err.Error())
 		return err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", reader)
-	rt, wxErr := helpers.BuildHttpResponse[wxError.WXError](resp, err)
-	if wxErr != nil {
-		Errorf("SendKFToArticleMsg error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return fmt.Errorf("SendKFToArticleMsg error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if rt.ErrCode != 0 {
-		Errorf("SendKFToArticleMsg error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return fmt.Errorf("SendKFToArticleMsg error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Post(ctx, url, "application/json", reader)
+	if _, err := decodeResponse[wxError.WXError](resp, err); err != nil {
+		m.logger(ctx).Errorf("SendKFToArticleMsg error: %s", err.Error())
+		return err
 	}
 
 	return nil
@@ -862,7 +759,7 @@ func (m *MPProxyUsecase) SendKFToArticleMsg(ctx context.Context, req *v1.SendKFT
 // SendKFMenuMsg
 func (m *MPProxyUsecase) SendKFMenuMsg(ctx context.Context, req *v1.SendKFMenuMsgRequest) error {
 	url := fmt.Sprintf("https://%s%s?access_token=%s", domain.GetWXAPIDomain(), paths.Path_KF_Send_Message, req.AccessToken)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	body := &KfMenuMessage{}
 	body.KfMessageComm.KF.KFAccount = req.Common.CustomerService.KfAccount
@@ -882,20 +779,14 @@ func (m *MPProxyUsecase) SendKFMenuMsg(ctx context.Context, req *v1.SendKFMenuMs
 
 	reader, err := helpers.BuildRequestBody[*KfMenuMessage](body)
 	if err != nil {
-		Errorf("build request body error: %s", err.Error())
+		m.logger(ctx).Errorf("build request body error: %s", err.Error())
 		return err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", reader)
-	rt, wxErr := helpers.BuildHttpResponse[wxError.WXError](resp, err)
-	if wxErr != nil {
-		Errorf("SendKFMenuMsg error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return fmt.Errorf("SendKFMenuMsg error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if rt.ErrCode != 0 {
-		Errorf("SendKFMenuMsg error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return fmt.Errorf("SendKFMenuMsg error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Post(ctx, url, "application/json", reader)
+	if _, err := decodeResponse[wxError.WXError](resp, err); err != nil {
+		m.logger(ctx).Errorf("SendKFMenuMsg error: %s", err.Error())
+		return err
 	}
 
 	return nil
@@ -904,7 +795,7 @@ func (m *MPProxyUsecase) SendKFMenuMsg(ctx context.Context, req *v1.SendKFMenuMs
 // SendKFCardMsg
 func (m *MPProxyUsecase) SendKFCardMsg(ctx context.Context, req *v1.SendKFCardMsgRequest) error {
 	url := fmt.Sprintf("https://%s%s?access_token=%s", domain.GetWXAPIDomain(), paths.Path_KF_Send_Message, req.AccessToken)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	body := &KfCardMessage{}
 	body.KfMessageComm.KF.KFAccount = req.Common.CustomerService.KfAccount
@@ -914,20 +805,14 @@ func (m *MPProxyUsecase) SendKFCardMsg(ctx context.Context, req *v1.SendKFCardMs
 
 	reader, err := helpers.BuildRequestBody[*KfCardMessage](body)
 	if err != nil {
-		Errorf("build request body error: %s", err.Error())
+		m.logger(ctx).Errorf("build request body error: %s", err.Error())
 		return err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", reader)
-	rt, wxErr := helpers.BuildHttpResponse[wxError.WXError](resp, err)
-	if wxErr != nil {
-		Errorf("SendKFCardMsg error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return fmt.Errorf("SendKFCardMsg error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if rt.ErrCode != 0 {
-		Errorf("SendKFCardMsg error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return fmt.Errorf("SendKFCardMsg error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Post(ctx, url, "application/json", reader)
+	if _, err := decodeResponse[wxError.WXError](resp, err); err != nil {
+		m.logger(ctx).Errorf("SendKFCardMsg error: %s", err.Error())
+		return err
 	}
 
 	return nil
@@ -936,7 +821,7 @@ func (m *MPProxyUsecase) SendKFCardMsg(ctx context.Context, req *v1.SendKFCardMs
 // SendKFMiniProgramMsg
 func (m *MPProxyUsecase) SendKFMiniProgramMsg(ctx context.Context, req *v1.SendKFMiniProgramMsgRequest) error {
 	url := fmt.Sprintf("https://%s%s?access_token=%s", domain.GetWXAPIDomain(), paths.Path_KF_Send_Message, req.AccessToken)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	body := &KfMiniProgramMessage{}
 	body.KfMessageComm.KF.KFAccount = req.Common.CustomerService.KfAccount
@@ -949,20 +834,14 @@ func (m *MPProxyUsecase) SendKFMiniProgramMsg(ctx context.Context, req *v1.SendK
 
 	reader, err := helpers.BuildRequestBody[*KfMiniProgramMessage](body)
 	if err != nil {
-		Errorf("build request body error: %s", err.Error())
+		m.logger(ctx).Errorf("build request body error: %s", err.Error())
 		return err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", reader)
-	rt, wxErr := helpers.BuildHttpResponse[wxError.WXError](resp, err)
-	if wxErr != nil {
-		Errorf("SendKFMiniProgramMsg error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return fmt.Errorf("SendKFMiniProgramMsg error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if rt.ErrCode != 0 {
-		Errorf("SendKFMiniProgramMsg error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return fmt.Errorf("SendKFMiniProgramMsg error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Post(ctx, url, "application/json", reader)
+	if _, err := decodeResponse[wxError.WXError](resp, err); err != nil {
+		m.logger(ctx).Errorf("SendKFMiniProgramMsg error: %s", err.Error())
+		return err
 	}
 
 	return nil
@@ -975,18 +854,13 @@ func (m *MPProxyUsecase) GetKFList(ctx context.Context, token string) (*KeFuInfo
 		paths.Path_Get_KfList,
 		token,
 	)
-	Debugf("url: %s", url)
-
-	resp, err := m.hc.Get(url)
-	rt, wxErr := helpers.BuildHttpResponse[KeFuInfoListRes](resp, err)
-	if wxErr != nil {
-		Errorf("get kf list error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return nil, fmt.Errorf("get kf list error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
+	m.logger(ctx).Debugf("url: %s", url)
 
-	if rt.ErrCode != 0 {
-		Errorf("get kf list error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return nil, fmt.Errorf("get kf list error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Get(ctx, url)
+	rt, err := decodeResponse[KeFuInfoListRes](resp, err)
+	if err != nil {
+		m.logger(ctx).Errorf("GetKFList error: %s", err.Error())
+		return nil, err
 	}
 
 	return rt, nil
@@ -999,18 +873,13 @@ func (m *MPProxyUsecase) GetKFOnlineList(ctx context.Context, token string) (*Ke
 		paths.Path_Get_OnlineKfList,
 		token,
 	)
-	Debugf("url: %s", url)
-
-	resp, err := m.hc.Get(url)
-	rt, wxErr := helpers.BuildHttpResponse[KeFuOnlineListRes](resp, err)
-	if wxErr != nil {
-		Errorf("get kf online list error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return nil, fmt.Errorf("get kf online list error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
+	m.logger(ctx).Debugf("url: %s", url)
 
-	if rt.ErrCode != 0 {
-		Errorf("get kf online list error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return nil, fmt.Errorf("get kf online list error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Get(ctx, url)
+	rt, err := decodeResponse[KeFuOnlineListRes](resp, err)
+	if err != nil {
+		m.logger(ctx).Errorf("GetKFOnlineList error: %s", err.Error())
+		return nil, err
 	}
 	return rt, nil
 }
@@ -1019,7 +888,7 @@ func (m *MPProxyUsecase) GetKFOnlineList(ctx context.Context, token string) (*Ke
 func (m *MPProxyUsecase) GetKFMsgHistory(ctx context.Context, token string, start int64,
 	end int64, msgId int64, number int64) (*KeFuMsgRecordRes, error) {
 	url := fmt.Sprintf("https://%s%s?access_token=%s", domain.GetWXAPIDomain(), paths.Path_Get_MsgRecord, token)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	type GetKFMsgHistoryReq struct {
 		StartTime int64 `json:"starttime"`
@@ -1035,20 +904,15 @@ func (m *MPProxyUsecase) GetKFMsgHistory(ctx context.Context, token string, star
 	}
 	bodyReader, err := helpers.BuildRequestBody[*GetKFMsgHistoryReq](body)
 	if err != nil {
-		Errorf("build request body error: %s", err.Error())
+		m.logger(ctx).Errorf("build request body error: %s", err.Error())
 		return nil, err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", bodyReader)
-	rt, wxErr := helpers.BuildHttpResponse[KeFuMsgRecordRes](resp, err)
-	if wxErr != nil {
-		Errorf("get kf msg history error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return nil, fmt.Errorf("get kf msg history error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if rt.ErrCode != 0 {
-		Errorf("get kf msg history error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return nil, fmt.Errorf("get kf msg history error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Post(ctx, url, "application/json", bodyReader)
+	rt, err := decodeResponse[KeFuMsgRecordRes](resp, err)
+	if err != nil {
+		m.logger(ctx).Errorf("GetKFMsgHistory error: %s", err.Error())
+		return nil, err
 	}
 
 	return rt, nil
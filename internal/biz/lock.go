@@ -0,0 +1,140 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	// ErrLockNotObtained 锁已被其他持有者占用
+	ErrLockNotObtained = errors.New("lock not obtained")
+	// ErrLockLost 锁已过期或被其他持有者获取
+	ErrLockLost = errors.New("lock lost")
+)
+
+// unlockScript 仅删除自己持有的锁
+var unlockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// refreshScript 仅延长自己持有的锁
+var refreshScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// fencedSetScript 仍持有锁(KEYS[1]的值为fencing值)时才写入KEYS[2]
+var fencedSetScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("SET", KEYS[2], ARGV[2], "PX", ARGV[3])
+end
+return false
+`)
+
+// RedisLocker Redis分布式锁
+//
+// 每次加锁从{key}:fence递增得到fencing值作为锁的值, 持锁者的写操作通过Lock.Set校验fencing值,
+// 锁过期后旧持有者的写入会被拒绝.
+type RedisLocker struct {
+	rdb redis.UniversalClient
+}
+
+func NewRedisLocker(rdb redis.UniversalClient) *RedisLocker {
+	return &RedisLocker{rdb: rdb}
+}
+
+// Lock 已获得的锁
+type Lock struct {
+	rdb   redis.UniversalClient
+	key   string
+	fence string
+}
+
+// Obtain 尝试获得锁, 锁被占用时返回ErrLockNotObtained
+func (l *RedisLocker) Obtain(ctx context.Context, key string, ttl time.Duration) (*Lock, error) {
+	fence, err := l.rdb.Incr(ctx, key+":fence").Result()
+	if err != nil {
+		return nil, err
+	}
+
+	value := strconv.FormatInt(fence, 10)
+	ok, err := l.rdb.SetNX(ctx, key, value, ttl).Result()
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrLockNotObtained
+	}
+
+	return &Lock{rdb: l.rdb, key: key, fence: value}, nil
+}
+
+// WaitRelease 等待锁被释放, 每隔interval检查一次
+func (l *RedisLocker) WaitRelease(ctx context.Context, key string, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		n, err := l.rdb.Exists(ctx, key).Result()
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Fence 锁的fencing值
+func (lk *Lock) Fence() string {
+	return lk.fence
+}
+
+// Set 仍持有锁时写入key, 否则返回ErrLockLost
+func (lk *Lock) Set(ctx context.Context, key string, value any, ttl time.Duration) error {
+	err := fencedSetScript.Run(ctx, lk.rdb, []string{lk.key, key},
+		lk.fence, value, ttl.Milliseconds()).Err()
+	if errors.Is(err, redis.Nil) {
+		return ErrLockLost
+	}
+	return err
+}
+
+// Refresh 延长锁的过期时间, 锁已丢失时返回ErrLockLost
+func (lk *Lock) Refresh(ctx context.Context, ttl time.Duration) error {
+	n, err := refreshScript.Run(ctx, lk.rdb, []string{lk.key}, lk.fence, ttl.Milliseconds()).Int()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrLockLost
+	}
+	return nil
+}
+
+// Release 释放锁
+func (lk *Lock) Release(ctx context.Context) error {
+	n, err := unlockScript.Run(ctx, lk.rdb, []string{lk.key}, lk.fence).Int()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrLockLost
+	}
+	return nil
+}
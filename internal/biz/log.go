@@ -0,0 +1,21 @@
+package biz
+
+import (
+	"context"
+
+	"github.com/seth16888/wxproxy/internal/consts"
+	"go.uber.org/zap"
+)
+
+// ctxLogger 返回附加了上下文中RequestID的日志
+func ctxLogger(ctx context.Context, log *zap.Logger) *zap.Logger {
+	if requestId, ok := ctx.Value(consts.RequestIdKey).(string); ok && requestId != "" {
+		return log.With(zap.String("requestId", requestId))
+	}
+	return log
+}
+
+// logger 本次请求的日志
+func (m *MPProxyUsecase) logger(ctx context.Context) *zap.SugaredLogger {
+	return ctxLogger(ctx, m.log).Sugar()
+}
@@ -8,7 +8,6 @@ import (
 
 	"github.com/seth16888/wxcommon/domain"
 	"github.com/seth16888/wxcommon/helpers"
-	. "github.com/seth16888/wxcommon/logger"
 	"github.com/seth16888/wxcommon/paths"
 
 	v1 "github.com/seth16888/wxproxy/api/v1"
@@ -129,13 +128,13 @@ func (m *MPProxyUsecase) GetIndustry(ctx context.Context, token string) (*GetTem
 		paths.Path_Get_Industry,
 		token,
 	)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
-	resp, err := m.hc.Get(url)
-	rt, wxErr := helpers.BuildHttpResponse[GetTemplateIndustryResp](resp, err)
-	if wxErr != nil {
-		Errorf("GetIndustry error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return nil, fmt.Errorf("GetIndustry error: %d %s", wxErr.ErrCode, wxErr.Error())
+	resp, err := m.wx.Get(ctx, url)
+	rt, err := decodeResponse[GetTemplateIndustryResp](resp, err)
+	if err != nil {
+		m.logger(ctx).Errorf("GetIndustry error: %s", err.Error())
+		return nil, err
 	}
 
 	return rt, nil
@@ -148,25 +147,20 @@ func (m *MPProxyUsecase) SetIndustry(ctx context.Context, token string, id1 stri
 		paths.Path_Set_Industry,
 		token,
 	)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	req := SetTemplateIndustryReq{IndustryId1: id1, IndustryId2: id2}
 	body, err := helpers.BuildRequestBody[SetTemplateIndustryReq](req)
 	if err != nil {
-		Errorf("build request body error: %s", err.Error())
+		m.logger(ctx).Errorf("build request body error: %s", err.Error())
 		return nil, err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", body)
-	rt, wxErr := helpers.BuildHttpResponse[wxError.WXError](resp, err)
-	if wxErr != nil {
-		Errorf("SetIndustry error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return nil, fmt.Errorf("SetIndustry error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if rt.ErrCode != 0 {
-		Errorf("SendSubscribeMsg error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return nil, fmt.Errorf("SendSubscribeMsg error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Post(ctx, url, "application/json", body)
+	rt, err := decodeResponse[wxError.WXError](resp, err)
+	if err != nil {
+		m.logger(ctx).Errorf("SetIndustry error: %s", err.Error())
+		return nil, err
 	}
 
 	return rt, nil
@@ -179,13 +173,13 @@ func (m *MPProxyUsecase) GetAllPrivateTpl(ctx context.Context, token string) (*G
 		paths.Path_Get_AllPrivateTmpl,
 		token,
 	)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
-	resp, err := m.hc.Get(url)
-	rt, wxErr := helpers.BuildHttpResponse[GetAllPrivateTemplateRes](resp, err)
-	if wxErr != nil {
-		Errorf("GetAllPrivateTpl error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return nil, fmt.Errorf("GetAllPrivateTpl error: %d %s", wxErr.ErrCode, wxErr.Error())
+	resp, err := m.wx.Get(ctx, url)
+	rt, err := decodeResponse[GetAllPrivateTemplateRes](resp, err)
+	if err != nil {
+		m.logger(ctx).Errorf("GetAllPrivateTpl error: %s", err.Error())
+		return nil, err
 	}
 
 	return rt, nil
@@ -198,7 +192,7 @@ func (m *MPProxyUsecase) GetMessageTplId(ctx context.Context, token string, tmpI
 		paths.Path_Get_TemplateId,
 		token,
 	)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 	type GetTemplateIdReq struct {
 		TemplateIDShort string   `json:"template_id_short"`
 		KeywordList     []string `json:"keyword_name_list"`
@@ -207,15 +201,15 @@ func (m *MPProxyUsecase) GetMessageTplId(ctx context.Context, token string, tmpI
 
 	body, err := helpers.BuildRequestBody[GetTemplateIdReq](req)
 	if err != nil {
-		Errorf("build request body error: %s", err.Error())
+		m.logger(ctx).Errorf("build request body error: %s", err.Error())
 		return nil, err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", body)
-	rt, wxErr := helpers.BuildHttpResponse[GetTemplateIdRes](resp, err)
-	if wxErr != nil {
-		Errorf("GetMessageTplId error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return nil, fmt.Errorf("GetMessageTplId error: %d %s", wxErr.ErrCode, wxErr.Error())
+	resp, err := m.wx.Post(ctx, url, "application/json", body)
+	rt, err := decodeResponse[GetTemplateIdRes](resp, err)
+	if err != nil {
+		m.logger(ctx).Errorf("GetMessageTplId error: %s", err.Error())
+		return nil, err
 	}
 
 	return rt, nil
@@ -228,7 +222,7 @@ func (m *MPProxyUsecase) DeleteMessageTpl(ctx context.Context, token string, tmp
 		paths.Path_Del_Template,
 		token,
 	)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	type DelTemplateReq struct {
 		TemplateID string `json:"template_id"`
@@ -236,20 +230,15 @@ func (m *MPProxyUsecase) DeleteMessageTpl(ctx context.Context, token string, tmp
 	req := DelTemplateReq{TemplateID: tmpId}
 	body, err := helpers.BuildRequestBody[DelTemplateReq](req)
 	if err != nil {
-		Errorf("build request body error: %s", err.Error())
+		m.logger(ctx).Errorf("build request body error: %s", err.Error())
 		return nil, err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", body)
-	rt, wxErr := helpers.BuildHttpResponse[wxError.WXError](resp, err)
-	if wxErr != nil {
-		Errorf("DeleteMessageTpl error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return nil, fmt.Errorf("DeleteMessageTpl error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if rt.ErrCode != 0 {
-		Errorf("SendSubscribeMsg error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return nil, fmt.Errorf("SendSubscribeMsg error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Post(ctx, url, "application/json", body)
+	rt, err := decodeResponse[wxError.WXError](resp, err)
+	if err != nil {
+		m.logger(ctx).Errorf("DeleteMessageTpl error: %s", err.Error())
+		return nil, err
 	}
 
 	return rt, nil
@@ -262,7 +251,7 @@ func (m *MPProxyUsecase) SendTplMsg(ctx context.Context, token string, req *v1.S
 		paths.Path_Send_TemplateMessage,
 		token,
 	)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	var body = &TemplateMessage{
 		ToUser:      req.Touser,
@@ -284,24 +273,19 @@ func (m *MPProxyUsecase) SendTplMsg(ctx context.Context, token string, req *v1.S
 			Color: item.Color,
 		}
 	}
-	Debugf("body: %+v", body)
+	m.logger(ctx).Debugf("body: %+v", body)
 
 	bodyReader, err := helpers.BuildRequestBody[*TemplateMessage](body)
 	if err != nil {
-		Errorf("build request body error: %s", err.Error())
+		m.logger(ctx).Errorf("build request body error: %s", err.Error())
 		return nil, err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", bodyReader)
-	rt, wxErr := helpers.BuildHttpResponse[SendTemplateMessageRes](resp, err)
-	if wxErr != nil {
-		Errorf("SendTplMsg error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return nil, fmt.Errorf("SendTplMsg error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if rt.ErrCode != 0 {
-		Errorf("SendSubscribeMsg error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return nil, fmt.Errorf("SendSubscribeMsg error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Post(ctx, url, "application/json", bodyReader)
+	rt, err := decodeResponse[SendTemplateMessageRes](resp, err)
+	if err != nil {
+		m.logger(ctx).Errorf("SendTplMsg error: %s", err.Error())
+		return nil, err
 	}
 
 	return rt, nil
@@ -314,7 +298,7 @@ func (m *MPProxyUsecase) SendSubscribeMsg(ctx context.Context, token string, req
 		paths.Path_Send_SubscribeMessage,
 		token,
 	)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	var body = &TemplateMessage{
 		ToUser:      req.Touser,
@@ -336,24 +320,19 @@ func (m *MPProxyUsecase) SendSubscribeMsg(ctx context.Context, token string, req
 			Color: item.Color,
 		}
 	}
-	Debugf("body: %+v", body)
+	m.logger(ctx).Debugf("body: %+v", body)
 
 	bodyReader, err := helpers.BuildRequestBody[*TemplateMessage](body)
 	if err != nil {
-		Errorf("build request body error: %s", err.Error())
+		m.logger(ctx).Errorf("build request body error: %s", err.Error())
 		return nil, err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", bodyReader)
-	rt, wxErr := helpers.BuildHttpResponse[wxError.WXError](resp, err)
-	if wxErr != nil {
-		Errorf("SendSubscribeMsg error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return nil, fmt.Errorf("SendSubscribeMsg error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if rt.ErrCode != 0 {
-		Errorf("SendSubscribeMsg error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return nil, fmt.Errorf("SendSubscribeMsg error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Post(ctx, url, "application/json", bodyReader)
+	rt, err := decodeResponse[wxError.WXError](resp, err)
+	if err != nil {
+		m.logger(ctx).Errorf("SendSubscribeMsg error: %s", err.Error())
+		return nil, err
 	}
 
 	return rt, nil
@@ -367,25 +346,20 @@ func (m *MPProxyUsecase) GetBlockedTplMsg(ctx context.Context, token string,
 		paths.Path_Get_BlockedMsg,
 		token,
 	)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	body := &GetBlockedMessagesReq{TmplMsgId: msgId, LargestId: largest, Limit: limit}
 	bodyReader, err := helpers.BuildRequestBody[*GetBlockedMessagesReq](body)
 	if err != nil {
-		Errorf("build request body error: %s", err.Error())
+		m.logger(ctx).Errorf("build request body error: %s", err.Error())
 		return nil, err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", bodyReader)
-	rt, wxErr := helpers.BuildHttpResponse[GetBlockedMessagesRes](resp, err)
-	if wxErr != nil {
-		Errorf("GetBlockedTplMsg error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return nil, fmt.Errorf("GetBlockedTplMsg error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if rt.ErrCode != 0 {
-		Errorf("SendSubscribeMsg error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return nil, fmt.Errorf("SendSubscribeMsg error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Post(ctx, url, "application/json", bodyReader)
+	rt, err := decodeResponse[GetBlockedMessagesRes](resp, err)
+	if err != nil {
+		m.logger(ctx).Errorf("GetBlockedTplMsg error: %s", err.Error())
+		return nil, err
 	}
 
 	return rt, nil
@@ -11,10 +11,10 @@ import (
 	wxError "github.com/seth16888/wxcommon/error"
 	"github.com/seth16888/wxcommon/hc"
 	"github.com/seth16888/wxcommon/helpers"
-	. "github.com/seth16888/wxcommon/logger"
 	"github.com/seth16888/wxcommon/mp"
 	"github.com/seth16888/wxcommon/paths"
 	v1 "github.com/seth16888/wxproxy/api/v1"
+	"github.com/seth16888/wxproxy/internal/metrics"
 	"go.uber.org/zap"
 )
 
@@ -177,8 +177,9 @@ type GetMemberInfoRes struct {
 }
 
 type MPProxyUsecase struct {
-	log *zap.Logger
-	hc  *hc.Client
+	log   *zap.Logger
+	wx    *wxClient
+	quota *QuotaCounter
 }
 
 func (m *MPProxyUsecase) TryMatchMenu(ctx context.Context, token string, userId string) (*v1.TryMatchMenuReply, error) {
@@ -187,21 +188,21 @@ func (m *MPProxyUsecase) TryMatchMenu(ctx context.Context, token string, userId
 		paths.Path_Try_MatchMenu,
 		token,
 	)
-	m.log.Debug("TryMatchMenu", zap.String("url", url))
+	ctxLogger(ctx, m.log).Debug("TryMatchMenu", zap.String("url", url))
 
 	type TryMatchMenuReq struct {
 		UserID string `json:"user_id"`
 	}
 	reader, err := helpers.BuildRequestBody(TryMatchMenuReq{UserID: userId})
 	if err != nil {
-		m.log.Error("build request body error", zap.Error(err))
+		ctxLogger(ctx, m.log).Error("build request body error", zap.Error(err))
 		return nil, err
 	}
-	resp, err := m.hc.Post(url, "application/json", reader)
-	rt, wxErr := helpers.BuildHttpResponse[mp.MenuTryMatchRes](resp, err)
-	if wxErr != nil {
-		m.log.Error("TryMatchMenu error", zap.Error(err))
-		return nil, wxErr
+	resp, err := m.wx.Post(ctx, url, "application/json", reader)
+	rt, err := decodeResponse[mp.MenuTryMatchRes](resp, err)
+	if err != nil {
+		ctxLogger(ctx, m.log).Error("TryMatchMenu error", zap.Error(err))
+		return nil, err
 	}
 
 	reply := &v1.TryMatchMenuReply{
@@ -233,13 +234,13 @@ func convertToMenuButton(mpBtns []*mp.Button) []*v1.MenuButton {
 
 func (m *MPProxyUsecase) CreateConditionalMenu(ctx context.Context, token string,
 	button []*v1.MenuButton, matchrule *v1.ConditionalMatchRule,
-) *v1.WXErrorReply {
+) error {
 	url := fmt.Sprintf("https://%s%s?access_token=%s",
 		domain.GetWXAPIDomain(),
 		paths.Path_Create_ConditionalMenu,
 		token,
 	)
-	m.log.Debug("CreateConditionalMenu", zap.String("url", url))
+	ctxLogger(ctx, m.log).Debug("CreateConditionalMenu", zap.String("url", url))
 
 	menu := mp.CreateMenuReq{
 		Button: []*mp.Button{},
@@ -254,16 +255,16 @@ func (m *MPProxyUsecase) CreateConditionalMenu(ctx context.Context, token string
 
 	reader, err := helpers.BuildRequestBody(menu)
 	if err != nil {
-		m.log.Error("build request body error", zap.Error(err))
-		return &v1.WXErrorReply{Errcode: 500, Errmsg: err.Error()}
+		ctxLogger(ctx, m.log).Error("build request body error", zap.Error(err))
+		return err
 	}
-	resp, err := m.hc.Post(url, "application/json", reader)
-	rt, wxErr := helpers.BuildHttpResponse[wxError.WXError](resp, err)
-	if wxErr != nil {
-		m.log.Error("CreateConditionalMenu error", zap.Error(err))
-		return &v1.WXErrorReply{Errcode: 500, Errmsg: wxErr.ErrMsg}
+	resp, err := m.wx.Post(ctx, url, "application/json", reader)
+	if _, err := decodeResponse[wxError.WXError](resp, err); err != nil {
+		ctxLogger(ctx, m.log).Error("CreateConditionalMenu error", zap.Error(err))
+		return err
 	}
-	return &v1.WXErrorReply{Errcode: rt.ErrCode, Errmsg: rt.ErrMsg}
+
+	return nil
 }
 
 func buttonToMPButtons(buttons []*v1.MenuButton) []*mp.Button {
@@ -286,13 +287,13 @@ func buttonToMPButtons(buttons []*v1.MenuButton) []*mp.Button {
 	return btns
 }
 
-func (m *MPProxyUsecase) DeleteConditionalMenu(ctx context.Context, token string, menuid int64) *v1.WXErrorReply {
+func (m *MPProxyUsecase) DeleteConditionalMenu(ctx context.Context, token string, menuid int64) error {
 	url := fmt.Sprintf("https://%s%s?access_token=%s",
 		domain.GetWXAPIDomain(),
 		paths.Path_Del_ConditionalMenu,
 		token,
 	)
-	m.log.Debug("DeleteConditionalMenu", zap.String("url", url))
+	ctxLogger(ctx, m.log).Debug("DeleteConditionalMenu", zap.String("url", url))
 
 	type req struct {
 		Menuid int64 `json:"menuid"`
@@ -302,27 +303,26 @@ func (m *MPProxyUsecase) DeleteConditionalMenu(ctx context.Context, token string
 	}
 	reader, err := helpers.BuildRequestBody(params)
 	if err != nil {
-		m.log.Error("build request body error", zap.Error(err))
-		return &v1.WXErrorReply{Errcode: 500, Errmsg: err.Error()}
+		ctxLogger(ctx, m.log).Error("build request body error", zap.Error(err))
+		return err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", reader)
-	rt, wxErr := helpers.BuildHttpResponse[wxError.WXError](resp, err)
-	if wxErr != nil {
-		m.log.Error("DeleteConditionalMenu error", zap.Error(err))
-		return &v1.WXErrorReply{Errcode: 500, Errmsg: wxErr.ErrMsg}
+	resp, err := m.wx.Post(ctx, url, "application/json", reader)
+	if _, err := decodeResponse[wxError.WXError](resp, err); err != nil {
+		ctxLogger(ctx, m.log).Error("DeleteConditionalMenu error", zap.Error(err))
+		return err
 	}
 
-	return &v1.WXErrorReply{Errcode: rt.ErrCode, Errmsg: rt.ErrMsg}
+	return nil
 }
 
-func (m *MPProxyUsecase) DeleteMaterial(ctx context.Context, token string, mediaId string) *v1.WXErrorReply {
+func (m *MPProxyUsecase) DeleteMaterial(ctx context.Context, token string, mediaId string) error {
 	url := fmt.Sprintf("https://%s%s?access_token=%s",
 		domain.GetWXAPIDomain(),
 		paths.Path_Del_Material,
 		token,
 	)
-	m.log.Debug("DeleteMaterial", zap.String("url", url))
+	ctxLogger(ctx, m.log).Debug("DeleteMaterial", zap.String("url", url))
 
 	type req struct {
 		MediaId string `json:"media_id"`
@@ -332,18 +332,17 @@ func (m *MPProxyUsecase) DeleteMaterial(ctx context.Context, token string, media
 	}
 	reader, err := helpers.BuildRequestBody(params)
 	if err != nil {
-		m.log.Error("build request body error", zap.Error(err))
-		return &v1.WXErrorReply{Errcode: 500, Errmsg: "build request body error"}
+		ctxLogger(ctx, m.log).Error("build request body error", zap.Error(err))
+		return err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", reader)
-	rt, wxErr := helpers.BuildHttpResponse[wxError.WXError](resp, err)
-	if wxErr != nil {
-		m.log.Error("DeleteMaterial error", zap.Error(err))
-		return &v1.WXErrorReply{Errcode: 500, Errmsg: "DeleteMaterial error"}
+	resp, err := m.wx.Post(ctx, url, "application/json", reader)
+	if _, err := decodeResponse[wxError.WXError](resp, err); err != nil {
+		ctxLogger(ctx, m.log).Error("DeleteMaterial error", zap.Error(err))
+		return err
 	}
 
-	return &v1.WXErrorReply{Errcode: rt.ErrCode, Errmsg: rt.ErrMsg}
+	return nil
 }
 
 func (m *MPProxyUsecase) GetBlacklist(ctx context.Context, token string, nextId string) (*v1.GetBlacklistReply, error) {
@@ -352,7 +351,7 @@ func (m *MPProxyUsecase) GetBlacklist(ctx context.Context, token string, nextId
 		paths.Path_Get_Black_List,
 		token,
 	)
-	m.log.Debug("url", zap.String("url", url))
+	ctxLogger(ctx, m.log).Debug("url", zap.String("url", url))
 
 	type req struct {
 		NextOpenid string `json:"next_openid"`
@@ -362,14 +361,14 @@ func (m *MPProxyUsecase) GetBlacklist(ctx context.Context, token string, nextId
 	}
 	reader, err := helpers.BuildRequestBody(params)
 	if err != nil {
-		m.log.Error("build request body error", zap.Error(err))
+		ctxLogger(ctx, m.log).Error("build request body error", zap.Error(err))
 		return nil, err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", reader)
-	rt, wxErr := helpers.BuildHttpResponse[GetBlackListRes](resp, err)
-	if wxErr != nil {
-		m.log.Error("GetBlacklist error", zap.Error(err))
+	resp, err := m.wx.Post(ctx, url, "application/json", reader)
+	rt, err := decodeResponse[GetBlackListRes](resp, err)
+	if err != nil {
+		ctxLogger(ctx, m.log).Error("GetBlacklist error", zap.Error(err))
 		return nil, err
 	}
 
@@ -381,13 +380,13 @@ func (m *MPProxyUsecase) GetBlacklist(ctx context.Context, token string, nextId
 	}, nil
 }
 
-func (m *MPProxyUsecase) UnBlockMember(ctx context.Context, token string, ids []string) *v1.WXErrorReply {
+func (m *MPProxyUsecase) UnBlockMember(ctx context.Context, token string, ids []string) error {
 	url := fmt.Sprintf("https://%s%s?access_token=%s",
 		domain.GetWXAPIDomain(),
 		paths.Path_Batch_Remove_Black_List,
 		token,
 	)
-	m.log.Debug("url", zap.String("url", url))
+	ctxLogger(ctx, m.log).Debug("url", zap.String("url", url))
 
 	type blockMemberReq struct {
 		OpenIds []string `json:"openid_list"`
@@ -398,27 +397,26 @@ func (m *MPProxyUsecase) UnBlockMember(ctx context.Context, token string, ids []
 
 	reader, err := helpers.BuildRequestBody(req)
 	if err != nil {
-		m.log.Error("build request body error", zap.Error(err))
-		return &v1.WXErrorReply{Errcode: 500, Errmsg: "build request body error"}
+		ctxLogger(ctx, m.log).Error("build request body error", zap.Error(err))
+		return err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", reader)
-	rt, wxErr := helpers.BuildHttpResponse[wxError.WXError](resp, err)
-	if wxErr != nil {
-		m.log.Error("UpdateKFTyping error", zap.Error(err))
-		return &v1.WXErrorReply{Errcode: 500, Errmsg: "UpdateKFTyping error"}
+	resp, err := m.wx.Post(ctx, url, "application/json", reader)
+	if _, err := decodeResponse[wxError.WXError](resp, err); err != nil {
+		ctxLogger(ctx, m.log).Error("UnBlockMember error", zap.Error(err))
+		return err
 	}
 
-	return &v1.WXErrorReply{Errcode: int64(rt.ErrCode), Errmsg: rt.ErrMsg}
+	return nil
 }
 
-func (m *MPProxyUsecase) BlockMember(ctx context.Context, token string, ids []string) *v1.WXErrorReply {
+func (m *MPProxyUsecase) BlockMember(ctx context.Context, token string, ids []string) error {
 	url := fmt.Sprintf("https://%s%s?access_token=%s",
 		domain.GetWXAPIDomain(),
 		paths.Path_Batch_Add_Black_List,
 		token,
 	)
-	m.log.Debug("url", zap.String("url", url))
+	ctxLogger(ctx, m.log).Debug("url", zap.String("url", url))
 
 	type blockMemberReq struct {
 		OpenIds []string `json:"openid_list"`
@@ -429,24 +427,26 @@ func (m *MPProxyUsecase) BlockMember(ctx context.Context, token string, ids []st
 
 	reader, err := helpers.BuildRequestBody(req)
 	if err != nil {
-		m.log.Error("build request body error", zap.Error(err))
-		return &v1.WXErrorReply{Errcode: 500, Errmsg: "build request body error"}
+		ctxLogger(ctx, m.log).Error("build request body error", zap.Error(err))
+		return err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", reader)
-	rt, wxErr := helpers.BuildHttpResponse[wxError.WXError](resp, err)
-	if wxErr != nil {
-		m.log.Error("UpdateKFTyping error", zap.Error(err))
-		return &v1.WXErrorReply{Errcode: 500, Errmsg: "UpdateKFTyping error"}
+	resp, err := m.wx.Post(ctx, url, "application/json", reader)
+	if _, err := decodeResponse[wxError.WXError](resp, err); err != nil {
+		ctxLogger(ctx, m.log).Error("BlockMember error", zap.Error(err))
+		return err
 	}
 
-	return &v1.WXErrorReply{Errcode: int64(rt.ErrCode), Errmsg: rt.ErrMsg}
+	return nil
 }
 
-func NewMPProxyUsecase(hc *hc.Client, logger *zap.Logger) *MPProxyUsecase {
+func NewMPProxyUsecase(hc *hc.Client, token *TokenUsecase, quota *QuotaCounter, limiter *RateLimiter,
+	breakers *BreakerGroup, domains *DomainPool, m *metrics.Metrics, logger *zap.Logger,
+) *MPProxyUsecase {
 	return &MPProxyUsecase{
-		hc:  hc,
-		log: logger,
+		wx:    newWXClient(hc, token, quota, limiter, breakers, domains, m, logger),
+		log:   logger,
+		quota: quota,
 	}
 }
 
@@ -458,18 +458,18 @@ func (m *MPProxyUsecase) GetMaterialCoount(ctx context.Context, token string) (*
 		paths.Path_Get_Material_Count,
 		token,
 	)
-	m.log.Debug("url", zap.String("url", url))
+	ctxLogger(ctx, m.log).Debug("url", zap.String("url", url))
 
-	resp, err := m.hc.Get(url)
+	resp, err := m.wx.Get(ctx, url)
 	if err != nil {
-		m.log.Error("GetMaterialCoount error", zap.Error(err))
+		ctxLogger(ctx, m.log).Error("GetMaterialCoount error", zap.Error(err))
 		return nil, err
 	}
 
-	result, wxErr := helpers.BuildHttpResponse[GetMaterialCountReply](resp, err)
-	if wxErr != nil {
-		m.log.Error("GetMaterialCoount error", zap.Error(err))
-		return nil, fmt.Errorf("GetMaterialCoount error: %d %s", wxErr.ErrCode, wxErr.Error())
+	result, err := decodeResponse[GetMaterialCountReply](resp, err)
+	if err != nil {
+		ctxLogger(ctx, m.log).Error("GetMaterialCoount error", zap.Error(err))
+		return nil, err
 	}
 
 	return result, nil
@@ -481,23 +481,23 @@ func (m *MPProxyUsecase) GetMaterialNewsList(ctx context.Context, token string,
 		paths.Path_Batch_Get_Material,
 		token,
 	)
-	m.log.Debug("GetMaterialNewsList", zap.String("url", url))
+	ctxLogger(ctx, m.log).Debug("GetMaterialNewsList", zap.String("url", url))
 
 	body := map[string]interface{}{
 		"type":   mediaType,
 		"offset": offset,
 		"count":  count,
 	}
-	m.log.Debug("body", zap.Any("body", body))
+	ctxLogger(ctx, m.log).Debug("body", zap.Any("body", body))
 	bodyJson, err := json.Marshal(body)
 	if err != nil {
-		m.log.Error("GetMaterialNewsList error", zap.Error(err))
+		ctxLogger(ctx, m.log).Error("GetMaterialNewsList error", zap.Error(err))
 		return nil, err
 	}
 	bodyReader := bytes.NewReader(bodyJson)
-	resp, err := m.hc.Post(url, "application/json", bodyReader)
+	resp, err := m.wx.Post(ctx, url, "application/json", bodyReader)
 	if err != nil {
-		m.log.Error("GetMaterialNewsList error", zap.Error(err))
+		ctxLogger(ctx, m.log).Error("GetMaterialNewsList error", zap.Error(err))
 		return nil, err
 	}
 
@@ -505,15 +505,10 @@ func (m *MPProxyUsecase) GetMaterialNewsList(ctx context.Context, token string,
 		wxError.WXError
 		GetMaterialNewsListRes
 	}
-	result, wxErr := helpers.BuildHttpResponse[resultT](resp, err)
-	if wxErr != nil {
-		m.log.Error("GetMaterialNewsList error", zap.Error(err))
-		return nil, fmt.Errorf("GetMaterialNewsList error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if result.ErrCode != 0 {
-		m.log.Error("GetMaterialNewsList error", zap.Error(err))
-		return nil, fmt.Errorf("GetMaterialNewsList error: %d %s", result.ErrCode, result.ErrMsg)
+	result, err := decodeResponse[resultT](resp, err)
+	if err != nil {
+		ctxLogger(ctx, m.log).Error("GetMaterialNewsList error", zap.Error(err))
+		return nil, err
 	}
 
 	return &result.GetMaterialNewsListRes, nil
@@ -527,24 +522,24 @@ func (m *MPProxyUsecase) GetMaterialList(ctx context.Context, token string, medi
 		paths.Path_Batch_Get_Material,
 		token,
 	)
-	m.log.Debug("GetMaterialList", zap.String("url", url))
+	ctxLogger(ctx, m.log).Debug("GetMaterialList", zap.String("url", url))
 
 	body := map[string]interface{}{
 		"type":   mediaType,
 		"offset": offset,
 		"count":  count,
 	}
-	m.log.Debug("body", zap.Any("body", body))
+	ctxLogger(ctx, m.log).Debug("body", zap.Any("body", body))
 	bodyJson, err := json.Marshal(body)
 	if err != nil {
-		m.log.Error("GetMaterialList error", zap.Error(err))
+		ctxLogger(ctx, m.log).Error("GetMaterialList error", zap.Error(err))
 		return nil, err
 	}
 	bodyReader := bytes.NewReader(bodyJson)
 
-	resp, err := m.hc.Post(url, "application/json", bodyReader)
+	resp, err := m.wx.Post(ctx, url, "application/json", bodyReader)
 	if err != nil {
-		m.log.Error("GetMaterialList error", zap.Error(err))
+		ctxLogger(ctx, m.log).Error("GetMaterialList error", zap.Error(err))
 		return nil, err
 	}
 
@@ -553,15 +548,10 @@ func (m *MPProxyUsecase) GetMaterialList(ctx context.Context, token string, medi
 		GetMaterialListRes
 	}
 
-	result, wxErr := helpers.BuildHttpResponse[resultT](resp, err)
-	if wxErr != nil {
-		m.log.Error("GetMaterialList error", zap.Error(err))
-		return nil, fmt.Errorf("GetMaterialList error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if result.ErrCode != 0 {
-		m.log.Error("GetMaterialList error", zap.Error(err))
-		return nil, fmt.Errorf("GetMaterialList error: %d %s", result.ErrCode, result.ErrMsg)
+	result, err := decodeResponse[resultT](resp, err)
+	if err != nil {
+		ctxLogger(ctx, m.log).Error("GetMaterialList error", zap.Error(err))
+		return nil, err
 	}
 
 	return &result.GetMaterialListRes, nil
@@ -574,11 +564,11 @@ func (m *MPProxyUsecase) GetMemberList(ctx context.Context, token string, openid
 		token,
 		openid,
 	)
-	m.log.Debug("url", zap.String("url", url))
+	ctxLogger(ctx, m.log).Debug("url", zap.String("url", url))
 
-	resp, err := m.hc.Get(url)
+	resp, err := m.wx.Get(ctx, url)
 	if err != nil {
-		m.log.Error("GetMemberList error", zap.Error(err))
+		ctxLogger(ctx, m.log).Error("GetMemberList error", zap.Error(err))
 		return nil, err
 	}
 
@@ -586,15 +576,10 @@ func (m *MPProxyUsecase) GetMemberList(ctx context.Context, token string, openid
 		wxError.WXError
 		GetMemberListRes
 	}
-	result, wxErr := helpers.BuildHttpResponse[resultT](resp, err)
-	if wxErr != nil {
-		m.log.Error("GetMemberList error:", zap.Error(err))
-		return nil, fmt.Errorf("GetMemberList error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if result.ErrCode != 0 {
-		m.log.Error("GetMemberList error", zap.Error(err))
-		return nil, fmt.Errorf("GetMemberList error: %d %s", result.ErrCode, result.ErrMsg)
+	result, err := decodeResponse[resultT](resp, err)
+	if err != nil {
+		ctxLogger(ctx, m.log).Error("GetMemberList error", zap.Error(err))
+		return nil, err
 	}
 
 	return &result.GetMemberListRes, nil
@@ -608,11 +593,11 @@ func (m *MPProxyUsecase) GetMemberInfo(ctx context.Context, token string, openid
 		openid,
 		lang,
 	)
-	m.log.Debug("url", zap.String("url", url))
+	ctxLogger(ctx, m.log).Debug("url", zap.String("url", url))
 
-	resp, err := m.hc.Get(url)
+	resp, err := m.wx.Get(ctx, url)
 	if err != nil {
-		m.log.Error("GetMemberInfo error", zap.Error(err))
+		ctxLogger(ctx, m.log).Error("GetMemberInfo error", zap.Error(err))
 		return nil, err
 	}
 
@@ -620,15 +605,10 @@ func (m *MPProxyUsecase) GetMemberInfo(ctx context.Context, token string, openid
 		wxError.WXError
 		GetMemberInfoRes
 	}
-	result, wxErr := helpers.BuildHttpResponse[resultT](resp, err)
-	if wxErr != nil {
-		m.log.Error("GetMemberInfo error", zap.Error(err))
-		return nil, fmt.Errorf("GetMemberInfo error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if result.ErrCode != 0 {
-		m.log.Error("GetMemberInfo error", zap.Error(err))
-		return nil, fmt.Errorf("GetMemberInfo error: %d %s", result.ErrCode, result.ErrMsg)
+	result, err := decodeResponse[resultT](resp, err)
+	if err != nil {
+		ctxLogger(ctx, m.log).Error("GetMemberInfo error", zap.Error(err))
+		return nil, err
 	}
 
 	return &result.GetMemberInfoRes, nil
@@ -640,7 +620,7 @@ func (m *MPProxyUsecase) BatchGetMemberInfo(ctx context.Context, token string, o
 		paths.Path_Batch_Get_Member_Info,
 		token,
 	)
-	m.log.Debug("url", zap.String("url", url))
+	ctxLogger(ctx, m.log).Debug("url", zap.String("url", url))
 
 	type batchGetMemberInfoReq struct {
 		UserList []struct {
@@ -657,14 +637,14 @@ func (m *MPProxyUsecase) BatchGetMemberInfo(ctx context.Context, token string, o
 	}
 	bodyJson, err := json.Marshal(body)
 	if err != nil {
-		m.log.Error("BatchGetMemberInfo error", zap.Error(err))
+		ctxLogger(ctx, m.log).Error("BatchGetMemberInfo error", zap.Error(err))
 		return nil, err
 	}
 	bodyReader := bytes.NewReader(bodyJson)
 
-	resp, err := m.hc.Post(url, "application/json", bodyReader)
+	resp, err := m.wx.Post(ctx, url, "application/json", bodyReader)
 	if err != nil {
-		m.log.Error("BatchGetMemberInfo error", zap.Error(err))
+		ctxLogger(ctx, m.log).Error("BatchGetMemberInfo error", zap.Error(err))
 		return nil, err
 	}
 
@@ -672,15 +652,10 @@ func (m *MPProxyUsecase) BatchGetMemberInfo(ctx context.Context, token string, o
 		wxError.WXError
 		UserInfoList []GetMemberInfoRes `json:"user_info_list"`
 	}
-	result, wxErr := helpers.BuildHttpResponse[resultT](resp, err)
-	if wxErr != nil {
-		m.log.Error("BatchGetMemberInfo error", zap.Error(wxErr))
-		return nil, fmt.Errorf("BatchGetMemberInfo error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if result.ErrCode != 0 {
-		m.log.Error("BatchGetMemberInfo error", zap.Any("result", result))
-		return nil, fmt.Errorf("BatchGetMemberInfo error: %d %s", result.ErrCode, result.ErrMsg)
+	result, err := decodeResponse[resultT](resp, err)
+	if err != nil {
+		ctxLogger(ctx, m.log).Error("BatchGetMemberInfo error", zap.Error(err))
+		return nil, err
 	}
 
 	return &result.UserInfoList, nil
@@ -692,21 +667,21 @@ func (m *MPProxyUsecase) GetMemberTags(ctx context.Context, token string, openid
 		paths.Path_Get_Member_Tags,
 		token,
 	)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	body := map[string]string{
 		"openid": openid,
 	}
 	bodyJson, err := json.Marshal(body) // TODO: helpers.BuildRequestBody
 	if err != nil {
-		Errorf("GetMemberTags error: %s", err.Error())
+		m.logger(ctx).Errorf("GetMemberTags error: %s", err.Error())
 		return nil, err
 	}
 	bodyReader := bytes.NewReader(bodyJson)
 
-	resp, err := m.hc.Post(url, "application/json", bodyReader)
+	resp, err := m.wx.Post(ctx, url, "application/json", bodyReader)
 	if err != nil {
-		Errorf("GetMemberTags error: %s", err.Error())
+		m.logger(ctx).Errorf("GetMemberTags error: %s", err.Error())
 		return nil, err
 	}
 
@@ -714,15 +689,10 @@ func (m *MPProxyUsecase) GetMemberTags(ctx context.Context, token string, openid
 		wxError.WXError
 		TagidList []int64 `json:"tagid_list"`
 	}
-	result, wxErr := helpers.BuildHttpResponse[resultT](resp, err)
-	if wxErr != nil {
-		Errorf("GetMemberTags error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return nil, fmt.Errorf("GetMemberTags error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if result.ErrCode != 0 {
-		Errorf("GetMemberTags error: %d %s", result.ErrCode, result.ErrMsg)
-		return nil, fmt.Errorf("GetMemberTags error: %d %s", result.ErrCode, result.ErrMsg)
+	result, err := decodeResponse[resultT](resp, err)
+	if err != nil {
+		m.logger(ctx).Errorf("GetMemberTags error: %s", err.Error())
+		return nil, err
 	}
 
 	return result.TagidList, nil
@@ -734,7 +704,7 @@ func (m *MPProxyUsecase) UpdateMemberRemark(ctx context.Context, token string, o
 		paths.Path_Update_Member_Remark,
 		token,
 	)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	body := map[string]string{
 		"openid": openid,
@@ -742,29 +712,23 @@ func (m *MPProxyUsecase) UpdateMemberRemark(ctx context.Context, token string, o
 	}
 	bodyJson, err := json.Marshal(body)
 	if err != nil {
-		Errorf("UpdateMemberRemark error: %s", err.Error())
+		m.logger(ctx).Errorf("UpdateMemberRemark error: %s", err.Error())
 		return err
 	}
 	bodyReader := bytes.NewReader(bodyJson)
 
-	resp, err := m.hc.Post(url, "application/json", bodyReader)
+	resp, err := m.wx.Post(ctx, url, "application/json", bodyReader)
 	if err != nil {
-		Errorf("UpdateMemberRemark error: %s", err.Error())
+		m.logger(ctx).Errorf("UpdateMemberRemark error: %s", err.Error())
 		return err
 	}
 
 	type resultT struct {
 		wxError.WXError
 	}
-	result, wxErr := helpers.BuildHttpResponse[resultT](resp, err)
-	if wxErr != nil {
-		Errorf("UpdateMemberRemark error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return fmt.Errorf("UpdateMemberRemark error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if result.ErrCode != 0 {
-		Errorf("UpdateMemberRemark error: %d %s", result.ErrCode, result.ErrMsg)
-		return fmt.Errorf("UpdateMemberRemark error: %d %s", result.ErrCode, result.ErrMsg)
+	if _, err := decodeResponse[resultT](resp, err); err != nil {
+		m.logger(ctx).Errorf("UpdateMemberRemark error: %s", err.Error())
+		return err
 	}
 
 	return nil
@@ -776,11 +740,11 @@ func (m *MPProxyUsecase) GetTagList(ctx context.Context, token string) ([]Tag, e
 		paths.Path_Get_Tags,
 		token,
 	)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
-	resp, err := m.hc.Get(url)
+	resp, err := m.wx.Get(ctx, url)
 	if err != nil {
-		Errorf("GetTagList error: %s", err.Error())
+		m.logger(ctx).Errorf("GetTagList error: %s", err.Error())
 		return nil, err
 	}
 
@@ -788,15 +752,10 @@ func (m *MPProxyUsecase) GetTagList(ctx context.Context, token string) ([]Tag, e
 		wxError.WXError
 		Tags []Tag `json:"tags"`
 	}
-	result, wxErr := helpers.BuildHttpResponse[resultT](resp, err)
-	if wxErr != nil {
-		Errorf("GetTagList error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return nil, fmt.Errorf("GetTagList error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if result.ErrCode != 0 {
-		Errorf("GetTagList error: %d %s", result.ErrCode, result.ErrMsg)
-		return nil, fmt.Errorf("GetTagList error: %d %s", result.ErrCode, result.ErrMsg)
+	result, err := decodeResponse[resultT](resp, err)
+	if err != nil {
+		m.logger(ctx).Errorf("GetTagList error: %s", err.Error())
+		return nil, err
 	}
 
 	return result.Tags, nil
@@ -808,7 +767,7 @@ func (m *MPProxyUsecase) CreateTag(ctx context.Context, token string, name strin
 		paths.Path_Create_Tag,
 		token,
 	)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	body := map[string]interface{}{
 		"tag": map[string]interface{}{
@@ -817,14 +776,14 @@ func (m *MPProxyUsecase) CreateTag(ctx context.Context, token string, name strin
 	}
 	bodyJson, err := json.Marshal(body)
 	if err != nil {
-		Errorf("CreateTag error: %s", err.Error())
+		m.logger(ctx).Errorf("CreateTag error: %s", err.Error())
 		return nil, err
 	}
 	bodyReader := bytes.NewReader(bodyJson)
 
-	resp, err := m.hc.Post(url, "application/json", bodyReader)
+	resp, err := m.wx.Post(ctx, url, "application/json", bodyReader)
 	if err != nil {
-		Errorf("CreateTag error: %s", err.Error())
+		m.logger(ctx).Errorf("CreateTag error: %s", err.Error())
 		return nil, err
 	}
 
@@ -832,15 +791,10 @@ func (m *MPProxyUsecase) CreateTag(ctx context.Context, token string, name strin
 		wxError.WXError
 		Tag Tag
 	}
-	result, wxErr := helpers.BuildHttpResponse[resultT](resp, err)
-	if wxErr != nil {
-		Errorf("CreateTag error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return nil, fmt.Errorf("CreateTag error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if result.ErrCode != 0 {
-		Errorf("CreateTag error: %d %s", result.ErrCode, result.ErrMsg)
-		return nil, fmt.Errorf("CreateTag error: %d %s", result.ErrCode, result.ErrMsg)
+	result, err := decodeResponse[resultT](resp, err)
+	if err != nil {
+		m.logger(ctx).Errorf("CreateTag error: %s", err.Error())
+		return nil, err
 	}
 	return &result.Tag, nil
 }
@@ -860,14 +814,14 @@ func (m *MPProxyUsecase) UpdateTag(ctx context.Context, token string, id int64,
 	}
 	bodyJson, err := json.Marshal(body)
 	if err != nil {
-		Errorf("UpdateTag error: %s", err.Error())
+		m.logger(ctx).Errorf("UpdateTag error: %s", err.Error())
 		return err
 	}
 	bodyReader := bytes.NewReader(bodyJson)
 
-	resp, err := m.hc.Post(url, "application/json", bodyReader)
+	resp, err := m.wx.Post(ctx, url, "application/json", bodyReader)
 	if err != nil {
-		Errorf("UpdateTag error: %s", err.Error())
+		m.logger(ctx).Errorf("UpdateTag error: %s", err.Error())
 		return err
 	}
 
@@ -875,15 +829,9 @@ func (m *MPProxyUsecase) UpdateTag(ctx context.Context, token string, id int64,
 		wxError.WXError
 	}
 
-	result, wxErr := helpers.BuildHttpResponse[resultT](resp, err)
-	if wxErr != nil {
-		Errorf("UpdateTag error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return fmt.Errorf("UpdateTag error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if result.ErrCode != 0 {
-		Errorf("UpdateTag error: %d %s", result.ErrCode, result.ErrMsg)
-		return fmt.Errorf("UpdateTag error: %d %s", result.ErrCode, result.ErrMsg)
+	if _, err := decodeResponse[resultT](resp, err); err != nil {
+		m.logger(ctx).Errorf("UpdateTag error: %s", err.Error())
+		return err
 	}
 
 	return nil
@@ -908,23 +856,18 @@ func (m *MPProxyUsecase) DeleteTag(ctx context.Context, token string, id int64)
 	}
 
 	bodyReader := bytes.NewReader(bodyJson)
-	resp, err := m.hc.Post(url, "application/json", bodyReader)
+	resp, err := m.wx.Post(ctx, url, "application/json", bodyReader)
 	if err != nil {
-		Errorf("DeleteTag error: %s", err.Error())
+		m.logger(ctx).Errorf("DeleteTag error: %s", err.Error())
 		return err
 	}
 
 	type resultT struct {
 		wxError.WXError
 	}
-	result, wxErr := helpers.BuildHttpResponse[resultT](resp, err)
-	if wxErr != nil {
-		Errorf("DeleteTag error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return fmt.Errorf("DeleteTag error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-	if result.ErrCode != 0 {
-		Errorf("DeleteTag error: %d %s", result.ErrCode, result.ErrMsg)
-		return fmt.Errorf("DeleteTag error: %d %s", result.ErrCode, result.ErrMsg)
+	if _, err := decodeResponse[resultT](resp, err); err != nil {
+		m.logger(ctx).Errorf("DeleteTag error: %s", err.Error())
+		return err
 	}
 
 	return nil
@@ -936,22 +879,22 @@ func (m *MPProxyUsecase) GetTagMembers(ctx context.Context, token string, id int
 		paths.Path_Get_Tag_Members,
 		token,
 	)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	body := map[string]interface{}{
 		"tagid":       id,
 		"next_openid": nextOpenid,
 	}
-	Debugf("body: %+v", body)
+	m.logger(ctx).Debugf("body: %+v", body)
 	bodyJson, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 
 	bodyReader := bytes.NewReader(bodyJson)
-	resp, err := m.hc.Post(url, "application/json", bodyReader)
+	resp, err := m.wx.Post(ctx, url, "application/json", bodyReader)
 	if err != nil {
-		Errorf("GetTagMembers error: %s", err.Error())
+		m.logger(ctx).Errorf("GetTagMembers error: %s", err.Error())
 		return nil, err
 	}
 
@@ -959,14 +902,10 @@ func (m *MPProxyUsecase) GetTagMembers(ctx context.Context, token string, id int
 		wxError.WXError
 		TagMembersRes
 	}
-	result, wxErr := helpers.BuildHttpResponse[resultT](resp, err)
-	if wxErr != nil {
-		Errorf("GetTagMembers error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return nil, fmt.Errorf("GetTagMembers error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-	if result.ErrCode != 0 {
-		Errorf("GetTagMembers error: %d %s", result.ErrCode, result.ErrMsg)
-		return nil, fmt.Errorf("GetTagMembers error: %d %s", result.ErrCode, result.ErrMsg)
+	result, err := decodeResponse[resultT](resp, err)
+	if err != nil {
+		m.logger(ctx).Errorf("GetTagMembers error: %s", err.Error())
+		return nil, err
 	}
 
 	return &result.TagMembersRes, nil
@@ -979,37 +918,31 @@ func (m *MPProxyUsecase) BatchTaggingMembers(ctx context.Context, token string,
 		paths.Path_Batch_Tagging,
 		token,
 	)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	body := map[string]interface{}{
 		"tagid":       tagid,
 		"openid_list": openids,
 	}
-	Debugf("body: %+v", body)
+	m.logger(ctx).Debugf("body: %+v", body)
 	bodyJson, err := json.Marshal(body)
 	if err != nil {
 		return err
 	}
 
 	bodyReader := bytes.NewReader(bodyJson)
-	resp, err := m.hc.Post(url, "application/json", bodyReader)
+	resp, err := m.wx.Post(ctx, url, "application/json", bodyReader)
 	if err != nil {
-		Errorf("BatchTaggingMembers error: %s", err.Error())
+		m.logger(ctx).Errorf("BatchTaggingMembers error: %s", err.Error())
 		return err
 	}
 
 	type resultT struct {
 		wxError.WXError
 	}
-	result, wxErr := helpers.BuildHttpResponse[resultT](resp, err)
-	if wxErr != nil {
-		Errorf("BatchTaggingMembers error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return fmt.Errorf("BatchTaggingMembers error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if result.ErrCode != 0 {
-		Errorf("BatchTaggingMembers error: %d %s", result.ErrCode, result.ErrMsg)
-		return fmt.Errorf("BatchTaggingMembers error: %d %s", result.ErrCode, result.ErrMsg)
+	if _, err := decodeResponse[resultT](resp, err); err != nil {
+		m.logger(ctx).Errorf("BatchTaggingMembers error: %s", err.Error())
+		return err
 	}
 
 	return nil
@@ -1022,22 +955,22 @@ func (m *MPProxyUsecase) BatchUntaggingMembers(ctx context.Context, token string
 		paths.Path_Batch_Untagging,
 		token,
 	)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	body := map[string]interface{}{
 		"tagid":       tagid,
 		"openid_list": openids,
 	}
-	Debugf("body: %+v", body)
+	m.logger(ctx).Debugf("body: %+v", body)
 	bodyJson, err := json.Marshal(body)
 	if err != nil {
 		return err
 	}
 
 	bodyReader := bytes.NewReader(bodyJson)
-	resp, err := m.hc.Post(url, "application/json", bodyReader)
+	resp, err := m.wx.Post(ctx, url, "application/json", bodyReader)
 	if err != nil {
-		Errorf("BatchUntaggingMembers error: %s", err.Error())
+		m.logger(ctx).Errorf("BatchUntaggingMembers error: %s", err.Error())
 		return err
 	}
 
@@ -1045,14 +978,9 @@ func (m *MPProxyUsecase) BatchUntaggingMembers(ctx context.Context, token string
 		wxError.WXError
 	}
 
-	result, wxErr := helpers.BuildHttpResponse[resultT](resp, err)
-	if wxErr != nil {
-		Errorf("BatchUntaggingMembers error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return fmt.Errorf("BatchUntaggingMembers error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-	if result.ErrCode != 0 {
-		Errorf("BatchUntaggingMembers error: %d %s", result.ErrCode, result.ErrMsg)
-		return fmt.Errorf("BatchUntaggingMembers error: %d %s", result.ErrCode, result.ErrMsg)
+	if _, err := decodeResponse[resultT](resp, err); err != nil {
+		m.logger(ctx).Errorf("BatchUntaggingMembers error: %s", err.Error())
+		return err
 	}
 
 	return nil
@@ -1067,38 +995,38 @@ func (m *MPProxyUsecase) CreateLimitQRCode(ctx context.Context, token string,
 		paths.Path_Create_QRCode,
 		token,
 	)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	tq := CreateQRCodeReq{
 		ExpireSeconds: expireSeconds,
 	}
 	switch reflect.ValueOf(scene).Kind() {
 	case reflect.String:
-		Debugf("create qrcode scene: %s", scene)
+		m.logger(ctx).Debugf("create qrcode scene: %s", scene)
 		tq.ActionName = ActionStr
 		tq.ActionInfo.Scene.SceneStr = scene.(string)
 	case reflect.Int, reflect.Int8, reflect.Int16,
 		reflect.Int32, reflect.Int64,
 		reflect.Uint, reflect.Uint8, reflect.Uint16,
 		reflect.Uint32, reflect.Uint64:
-		Debugf("create qrcode scene: %d", scene)
+		m.logger(ctx).Debugf("create qrcode scene: %d", scene)
 		tq.ActionName = ActionId
 		tq.ActionInfo.Scene.SceneId = scene.(int64)
 	default:
-		Errorf("scene not supported: %v", reflect.ValueOf(scene).Kind())
-		return nil, fmt.Errorf("scene not supported: %v", reflect.ValueOf(scene).Kind())
+		m.logger(ctx).Errorf("scene not supported: %v", reflect.ValueOf(scene).Kind())
+		return nil, v1.ErrorInvalidArgument("scene not supported: %v", reflect.ValueOf(scene).Kind())
 	}
-	Debugf("body: %+v", tq)
+	m.logger(ctx).Debugf("body: %+v", tq)
 
 	body, err := helpers.BuildRequestBody[CreateQRCodeReq](tq)
 	if err != nil {
-		Errorf("build request body error: %s", err.Error())
+		m.logger(ctx).Errorf("build request body error: %s", err.Error())
 		return nil, err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", body)
+	resp, err := m.wx.Post(ctx, url, "application/json", body)
 	if err != nil {
-		Errorf("create limit qrcode error: %s", err.Error())
+		m.logger(ctx).Errorf("create limit qrcode error: %s", err.Error())
 		return nil, err
 	}
 
@@ -1106,14 +1034,10 @@ func (m *MPProxyUsecase) CreateLimitQRCode(ctx context.Context, token string,
 		wxError.WXError
 		Ticket
 	}
-	result, wxErr := helpers.BuildHttpResponse[resultT](resp, err)
-	if wxErr != nil {
-		Errorf("create limit qrcode error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return nil, fmt.Errorf("create limit qrcode error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-	if result.ErrCode != 0 {
-		Errorf("create limit qrcode error: %d %s", result.ErrCode, result.ErrMsg)
-		return nil, fmt.Errorf("create limit qrcode error: %d %s", result.ErrCode, result.ErrMsg)
+	result, err := decodeResponse[resultT](resp, err)
+	if err != nil {
+		m.logger(ctx).Errorf("CreateLimitQRCode error: %s", err.Error())
+		return nil, err
 	}
 
 	return &result.Ticket, nil
@@ -1128,38 +1052,38 @@ func (m *MPProxyUsecase) CreateTemporaryQRCode(ctx context.Context, token string
 		paths.Path_Create_QRCode,
 		token,
 	)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	tq := CreateQRCodeReq{
 		ExpireSeconds: expireSeconds,
 	}
 	switch reflect.ValueOf(scene).Kind() {
 	case reflect.String:
-		Debugf("create qrcode scene: %s", scene)
+		m.logger(ctx).Debugf("create qrcode scene: %s", scene)
 		tq.ActionName = ActionStr
 		tq.ActionInfo.Scene.SceneStr = scene.(string)
 	case reflect.Int, reflect.Int8, reflect.Int16,
 		reflect.Int32, reflect.Int64,
 		reflect.Uint, reflect.Uint8, reflect.Uint16,
 		reflect.Uint32, reflect.Uint64:
-		Debugf("create qrcode scene: %d", scene)
+		m.logger(ctx).Debugf("create qrcode scene: %d", scene)
 		tq.ActionName = ActionId
 		tq.ActionInfo.Scene.SceneId = scene.(int64)
 	default:
-		Errorf("scene not supported: %v", reflect.ValueOf(scene).Kind())
-		return nil, fmt.Errorf("scene not supported: %v", reflect.ValueOf(scene).Kind())
+		m.logger(ctx).Errorf("scene not supported: %v", reflect.ValueOf(scene).Kind())
+		return nil, v1.ErrorInvalidArgument("scene not supported: %v", reflect.ValueOf(scene).Kind())
 	}
-	Debugf("body: %+v", tq)
+	m.logger(ctx).Debugf("body: %+v", tq)
 
 	body, err := helpers.BuildRequestBody[CreateQRCodeReq](tq)
 	if err != nil {
-		Errorf("build request body error: %s", err.Error())
+		m.logger(ctx).Errorf("build request body error: %s", err.Error())
 		return nil, err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", body)
+	resp, err := m.wx.Post(ctx, url, "application/json", body)
 	if err != nil {
-		Errorf("create temporary qrcode error: %s", err.Error())
+		m.logger(ctx).Errorf("create temporary qrcode error: %s", err.Error())
 		return nil, err
 	}
 
@@ -1167,15 +1091,10 @@ func (m *MPProxyUsecase) CreateTemporaryQRCode(ctx context.Context, token string
 		wxError.WXError
 		Ticket
 	}
-	result, wxErr := helpers.BuildHttpResponse[resultT](resp, err)
-	if wxErr != nil {
-		Errorf("create temporary qrcode error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return nil, fmt.Errorf("create temporary qrcode error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if result.ErrCode != 0 {
-		Errorf("create temporary qrcode error: %d %s", result.ErrCode, result.ErrMsg)
-		return nil, fmt.Errorf("create temporary qrcode error: %d %s", result.ErrCode, result.ErrMsg)
+	result, err := decodeResponse[resultT](resp, err)
+	if err != nil {
+		m.logger(ctx).Errorf("CreateTemporaryQRCode error: %s", err.Error())
+		return nil, err
 	}
 
 	return &result.Ticket, nil
@@ -1190,23 +1109,23 @@ func (m *MPProxyUsecase) GenShorten(ctx context.Context, token string, longData
 		paths.Path_Gen_Shorten,
 		token,
 	)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	body := map[string]interface{}{
 		"long_data":      longData,
 		"expire_seconds": expireSeconds,
 	}
-	Debugf("body: %+v", body)
+	m.logger(ctx).Debugf("body: %+v", body)
 
 	bodyReader, err := helpers.BuildRequestBody[map[string]interface{}](body)
 	if err != nil {
-		Errorf("build request body error: %s", err.Error())
+		m.logger(ctx).Errorf("build request body error: %s", err.Error())
 		return nil, err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", bodyReader)
+	resp, err := m.wx.Post(ctx, url, "application/json", bodyReader)
 	if err != nil {
-		Errorf("gen shorten error: %s", err.Error())
+		m.logger(ctx).Errorf("gen shorten error: %s", err.Error())
 		return nil, err
 	}
 
@@ -1214,15 +1133,10 @@ func (m *MPProxyUsecase) GenShorten(ctx context.Context, token string, longData
 		wxError.WXError
 		ShortKey string `json:"short_key"`
 	}
-	result, wxErr := helpers.BuildHttpResponse[resultT](resp, err)
-	if wxErr != nil {
-		Errorf("gen shorten error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return nil, fmt.Errorf("gen shorten error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if result.ErrCode != 0 {
-		Errorf("gen shorten error: %d %s", result.ErrCode, result.ErrMsg)
-		return nil, fmt.Errorf("gen shorten error: %d %s", result.ErrCode, result.ErrMsg)
+	result, err := decodeResponse[resultT](resp, err)
+	if err != nil {
+		m.logger(ctx).Errorf("GenShorten error: %s", err.Error())
+		return nil, err
 	}
 
 	return &result.ShortKey, nil
@@ -1235,21 +1149,21 @@ func (m *MPProxyUsecase) FetchShorten(ctx context.Context, token string, shortKe
 		paths.Path_Fetch_Shorten,
 		token,
 	)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	body := map[string]interface{}{
 		"short_key": shortKey,
 	}
-	Debugf("body: %+v", body)
+	m.logger(ctx).Debugf("body: %+v", body)
 	bodyReader, err := helpers.BuildRequestBody[map[string]interface{}](body)
 	if err != nil {
-		Errorf("build request body error: %s", err.Error())
+		m.logger(ctx).Errorf("build request body error: %s", err.Error())
 		return nil, err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", bodyReader)
+	resp, err := m.wx.Post(ctx, url, "application/json", bodyReader)
 	if err != nil {
-		Errorf("fetch shorten error: %s", err.Error())
+		m.logger(ctx).Errorf("fetch shorten error: %s", err.Error())
 		return nil, err
 	}
 
@@ -1258,15 +1172,10 @@ func (m *MPProxyUsecase) FetchShorten(ctx context.Context, token string, shortKe
 		FetchShortenRes
 	}
 
-	result, wxErr := helpers.BuildHttpResponse[resultT](resp, err)
-	if wxErr != nil {
-		Errorf("fetch shorten error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return nil, fmt.Errorf("fetch shorten error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if result.ErrCode != 0 {
-		Errorf("fetch shorten error: %d %s", result.ErrCode, result.ErrMsg)
-		return nil, fmt.Errorf("fetch shorten error: %d %s", result.ErrCode, result.ErrMsg)
+	result, err := decodeResponse[resultT](resp, err)
+	if err != nil {
+		m.logger(ctx).Errorf("FetchShorten error: %s", err.Error())
+		return nil, err
 	}
 
 	return &result.FetchShortenRes, nil
@@ -1279,11 +1188,11 @@ func (m *MPProxyUsecase) GetMenuInfo(ctx context.Context, token string) (*MenuRe
 		paths.Path_Get_Menu,
 		token,
 	)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
-	resp, err := m.hc.Get(url)
+	resp, err := m.wx.Get(ctx, url)
 	if err != nil {
-		Errorf("get menu info error: %s", err.Error())
+		m.logger(ctx).Errorf("get menu info error: %s", err.Error())
 		return nil, err
 	}
 
@@ -1291,15 +1200,10 @@ func (m *MPProxyUsecase) GetMenuInfo(ctx context.Context, token string) (*MenuRe
 		wxError.WXError
 		MenuRes
 	}
-	result, wxErr := helpers.BuildHttpResponse[resultT](resp, err)
-	if wxErr != nil {
-		Errorf("get menu info error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return nil, fmt.Errorf("get menu info error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if result.ErrCode != 0 {
-		Errorf("get menu info error: %d %s", result.ErrCode, result.ErrMsg)
-		return nil, fmt.Errorf("get menu info error: %d %s", result.ErrCode, result.ErrMsg)
+	result, err := decodeResponse[resultT](resp, err)
+	if err != nil {
+		m.logger(ctx).Errorf("GetMenuInfo error: %s", err.Error())
+		return nil, err
 	}
 
 	return &result.MenuRes, nil
@@ -1314,8 +1218,8 @@ func (m *MPProxyUsecase) CreateMenu(ctx context.Context, token string,
 		paths.Path_Create_Menu,
 		token,
 	)
-	Debugf("url: %s", url)
-	Debugf("buttons: %+v", buttons)
+	m.logger(ctx).Debugf("url: %s", url)
+	m.logger(ctx).Debugf("buttons: %+v", buttons)
 
 	type CreateMenuReq struct {
 		Button []Button `json:"button"`
@@ -1351,32 +1255,26 @@ func (m *MPProxyUsecase) CreateMenu(ctx context.Context, token string,
 			SubButtons: subBtns,
 		})
 	}
-	Debugf("body: %+v", body)
+	m.logger(ctx).Debugf("body: %+v", body)
 
 	bodyReader, err := helpers.BuildRequestBody[CreateMenuReq](body)
 	if err != nil {
-		Errorf("build request body error: %s", err.Error())
+		m.logger(ctx).Errorf("build request body error: %s", err.Error())
 		return err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", bodyReader)
+	resp, err := m.wx.Post(ctx, url, "application/json", bodyReader)
 	if err != nil {
-		Errorf("create menu error: %s", err.Error())
+		m.logger(ctx).Errorf("create menu error: %s", err.Error())
 		return err
 	}
 
 	type resultT struct {
 		wxError.WXError
 	}
-	result, wxErr := helpers.BuildHttpResponse[resultT](resp, err)
-	if wxErr != nil {
-		Errorf("create menu error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return fmt.Errorf("create menu error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if result.ErrCode != 0 {
-		Errorf("create menu error: %d %s", result.ErrCode, result.ErrMsg)
-		return fmt.Errorf("create menu error: %d %s", result.ErrCode, result.ErrMsg)
+	if _, err := decodeResponse[resultT](resp, err); err != nil {
+		m.logger(ctx).Errorf("CreateMenu error: %s", err.Error())
+		return err
 	}
 
 	return nil
@@ -1389,23 +1287,17 @@ func (m *MPProxyUsecase) DeleteMenu(ctx context.Context, token string) error {
 		paths.Path_Del_Menu,
 		token,
 	)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
-	resp, err := m.hc.Get(url)
+	resp, err := m.wx.Get(ctx, url)
 	if err != nil {
-		Errorf("delete menu error: %s", err.Error())
+		m.logger(ctx).Errorf("delete menu error: %s", err.Error())
 		return err
 	}
 
-	rt, wxErr := helpers.BuildHttpResponse[wxError.WXError](resp, err)
-	if wxErr != nil {
-		Errorf("delete menu error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return fmt.Errorf("delete menu error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if rt.ErrCode != 0 {
-		Errorf("delete menu error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return fmt.Errorf("delete menu error: %d %s", rt.ErrCode, rt.ErrMsg)
+	if _, err := decodeResponse[wxError.WXError](resp, err); err != nil {
+		m.logger(ctx).Errorf("DeleteMenu error: %s", err.Error())
+		return err
 	}
 
 	return nil
@@ -1421,19 +1313,17 @@ func (m *MPProxyUsecase) PullMenu(ctx context.Context, token string) (*v1.SelfMe
 		paths.Path_Get_Current_SelfMenu,
 		token,
 	)
-	m.log.Debug("PullMenu", zap.String("url", url))
+	ctxLogger(ctx, m.log).Debug("PullMenu", zap.String("url", url))
 
-	resp, err := m.hc.Get(url)
+	resp, err := m.wx.Get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
 
-	rt, wxErr := helpers.BuildHttpResponse[mp.SelfMenuInfoRes](resp, err)
-	if wxErr != nil {
-		return nil, fmt.Errorf("PullMenu error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-	if rt.ErrCode != 0 {
-		return nil, fmt.Errorf("PullMenu error: %d %s", rt.ErrCode, rt.ErrMsg)
+	rt, err := decodeResponse[mp.SelfMenuInfoRes](resp, err)
+	if err != nil {
+		m.logger(ctx).Errorf("PullMenu error: %s", err.Error())
+		return nil, err
 	}
 
 	reply := m.convertToReply(rt)
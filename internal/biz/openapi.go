@@ -0,0 +1,177 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/seth16888/wxcommon/domain"
+	wxError "github.com/seth16888/wxcommon/error"
+	"github.com/seth16888/wxcommon/helpers"
+	"github.com/seth16888/wxproxy/internal/redact"
+	"go.uber.org/zap"
+)
+
+const (
+	// pathRidGet 查询rid信息
+	pathRidGet = "/cgi-bin/openapi/rid/get"
+	// pathQuotaGet 查询接口调用额度
+	pathQuotaGet = "/cgi-bin/openapi/quota/get"
+	// pathClearQuota 使用AccessToken重置接口调用次数
+	pathClearQuota = "/cgi-bin/clear_quota"
+	// pathClearQuotaV2 使用AppSecret重置接口调用次数
+	pathClearQuotaV2 = "/cgi-bin/clear_quota/v2"
+)
+
+// ApiQuota 接口的调用额度
+type ApiQuota struct {
+	Quota struct {
+		DailyLimit int64 `json:"daily_limit"` // 当天该账号可调用该接口的次数
+		Used       int64 `json:"used"`        // 当天已经调用的次数
+		Remain     int64 `json:"remain"`      // 当天剩余调用次数
+	} `json:"quota"`
+	RateLimit struct {
+		CallCount     int64 `json:"call_count"`     // 周期内可调用数量
+		RefreshSecond int64 `json:"refresh_second"` // 更新周期, 单位秒
+	} `json:"rate_limit"`
+}
+
+// secretRedactor 脱敏返回给调用方的AccessToken、AppSecret
+var secretRedactor = redact.NewSecrets()
+
+// RidInfo rid对应的请求信息
+type RidInfo struct {
+	InvokeTime   int64  `json:"invoke_time"`   // 发起请求的时间戳
+	CostInMs     int64  `json:"cost_in_ms"`    // 请求毫秒级耗时
+	RequestUrl   string `json:"request_url"`   // 请求的URL参数
+	RequestBody  string `json:"request_body"`  // post请求的请求参数
+	ResponseBody string `json:"response_body"` // 接口请求返回参数
+	ClientIp     string `json:"client_ip"`     // 调用方ip
+}
+
+// GetRidInfo 查询rid信息, rid取自微信接口返回的errmsg
+//
+// 微信返回的请求URL、请求和响应中带有AccessToken或AppSecret(如/cgi-bin/token), 返回前脱敏.
+func (m *MPProxyUsecase) GetRidInfo(ctx context.Context, token string, rid string) (*RidInfo, error) {
+	url := fmt.Sprintf("https://%s%s?access_token=%s", domain.GetWXAPIDomain(), pathRidGet, token)
+
+	reader, err := helpers.BuildRequestBody(map[string]string{"rid": rid})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := m.wx.Post(ctx, url, "application/json", reader)
+	type resultT struct {
+		wxError.WXError
+		Request RidInfo `json:"request"`
+	}
+	rt, err := decodeResponse[resultT](resp, err)
+	if err != nil {
+		ctxLogger(ctx, m.log).Error("GetRidInfo error", zap.String("rid", rid), zap.Error(err))
+		return nil, err
+	}
+
+	info := &rt.Request
+	info.redact()
+	return info, nil
+}
+
+// redact 脱敏请求URL、请求和响应中的AccessToken、AppSecret等密钥
+func (r *RidInfo) redact() {
+	r.RequestUrl = secretRedactor.String(r.RequestUrl)
+	r.RequestBody = secretRedactor.String(r.RequestBody)
+	r.ResponseBody = secretRedactor.String(r.ResponseBody)
+}
+
+// GetApiQuota 查询接口的每日调用额度
+func (m *MPProxyUsecase) GetApiQuota(ctx context.Context, token string, cgiPath string) (*ApiQuota, error) {
+	url := fmt.Sprintf("https://%s%s?access_token=%s", domain.GetWXAPIDomain(), pathQuotaGet, token)
+
+	reader, err := helpers.BuildRequestBody(map[string]string{"cgi_path": cgiPath})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := m.wx.Post(ctx, url, "application/json", reader)
+	type resultT struct {
+		wxError.WXError
+		ApiQuota
+	}
+	rt, err := decodeResponse[resultT](resp, err)
+	if err != nil {
+		ctxLogger(ctx, m.log).Error("GetApiQuota error", zap.String("cgiPath", cgiPath), zap.Error(err))
+		return nil, err
+	}
+
+	return &rt.ApiQuota, nil
+}
+
+// ClearQuota 重置公众号所有接口的调用次数, 每月10次
+func (m *MPProxyUsecase) ClearQuota(ctx context.Context, token string, appId string) error {
+	url := fmt.Sprintf("https://%s%s?access_token=%s", domain.GetWXAPIDomain(), pathClearQuota, token)
+
+	reader, err := helpers.BuildRequestBody(map[string]string{"appid": appId})
+	if err != nil {
+		return err
+	}
+
+	resp, err := m.wx.Post(ctx, url, "application/json", reader)
+	if _, err := decodeResponse[wxError.WXError](resp, err); err != nil {
+		ctxLogger(ctx, m.log).Error("ClearQuota error", zap.String("appId", appId), zap.Error(err))
+		return err
+	}
+	ctxLogger(ctx, m.log).Info("quota cleared", zap.String("appId", appId))
+
+	return nil
+}
+
+// GetQuotaUsage 查询账号某日(yyyymmdd, 为空时为当日)各接口的调用次数
+//
+// withLimit为true时, 对每个接口调用GetApiQuota补充微信返回的当日额度.
+func (m *MPProxyUsecase) GetQuotaUsage(ctx context.Context, token string, appId string,
+	date string, withLimit bool,
+) (string, []*QuotaUsage, error) {
+	date, usages, err := m.quota.Usage(ctx, appId, date)
+	if err != nil {
+		ctxLogger(ctx, m.log).Error("GetQuotaUsage error", zap.String("appId", appId), zap.Error(err))
+		return "", nil, err
+	}
+	if !withLimit || token == "" {
+		return date, usages, nil
+	}
+
+	for _, usage := range usages {
+		quota, err := m.GetApiQuota(ctx, token, usage.CgiPath)
+		if err != nil {
+			// 部分接口不支持查询额度
+			continue
+		}
+		usage.DailyLimit = quota.Quota.DailyLimit
+		usage.Used = quota.Quota.Used
+		usage.Remain = quota.Quota.Remain
+	}
+
+	return date, usages, nil
+}
+
+// ClearQuotaV2 使用AppSecret重置公众号所有接口的调用次数, 无需AccessToken
+func (t *TokenUsecase) ClearQuotaV2(ctx context.Context, appId string) error {
+	account, err := t.accounts.GetAccount(ctx, appId)
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("https://%s%s?appid=%s&appsecret=%s",
+		domain.GetWXAPIDomain(),
+		pathClearQuotaV2,
+		account.AppId,
+		account.AppSecret,
+	)
+	resp, err := t.wx.Post(upstreamCtx(ctx, account.AppId), url, "application/json", nil)
+	if _, err := decodeResponse[wxError.WXError](resp, err); err != nil {
+		ctxLogger(ctx, t.log).Error("ClearQuotaV2 error", zap.String("appId", appId), zap.Error(err))
+		return err
+	}
+	ctxLogger(ctx, t.log).Info("quota cleared", zap.String("appId", appId))
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package biz
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRidInfoRedact(t *testing.T) {
+	const (
+		token  = "tok-7d1e5a"
+		secret = "appsecret-2c9f4b"
+	)
+	info := &RidInfo{
+		RequestUrl:   "access_token=" + token + "&grant_type=client_credential&appid=wx1&secret=" + secret,
+		RequestBody:  `{"grant_type":"client_credential","appid":"wx1","secret":"` + secret + `","appsecret":"` + secret + `"}`,
+		ResponseBody: `{"access_token":"` + token + `","expires_in":7200}`,
+	}
+	info.redact()
+
+	for _, s := range []string{info.RequestUrl, info.RequestBody, info.ResponseBody} {
+		if strings.Contains(s, token) || strings.Contains(s, secret) {
+			t.Fatalf("rid info contains secrets: %s", s)
+		}
+	}
+	if !strings.Contains(info.RequestUrl, "grant_type=client_credential") ||
+		!strings.Contains(info.ResponseBody, `"expires_in":7200`) {
+		t.Fatalf("rid info over-redacted: %+v", info)
+	}
+}
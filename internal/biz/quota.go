@@ -0,0 +1,94 @@
+package biz
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	v1 "github.com/seth16888/wxproxy/api/v1"
+	"github.com/seth16888/wxproxy/internal/config"
+	"go.uber.org/zap"
+)
+
+// quotaUsageTTL 调用次数的保留时间
+const quotaUsageTTL = 7 * 24 * time.Hour
+
+// QuotaUsage 接口当日的调用次数
+type QuotaUsage struct {
+	CgiPath string
+	// Count WXProxy记录的调用次数
+	Count int64
+	// DailyLimit、Used、Remain 微信返回的当日额度, 未查询时为0
+	DailyLimit int64
+	Used       int64
+	Remain     int64
+}
+
+// QuotaCounter 按账号、接口路径统计每日调用次数
+//
+// 调用次数记录在Redis Hash({prefix}:quota:{appId}:{yyyymmdd})中, field为接口路径,
+// 日期按北京时间计算, 与微信每日额度的重置时间一致. 只统计已注册的AppId.
+type QuotaCounter struct {
+	log      *zap.Logger
+	rdb      redis.UniversalClient
+	accounts *AccountUsecase
+	prefix   string
+}
+
+func NewQuotaCounter(rdb redis.UniversalClient, accounts *AccountUsecase, conf *config.Token,
+	logger *zap.Logger,
+) *QuotaCounter {
+	return &QuotaCounter{
+		log:      logger,
+		rdb:      rdb,
+		accounts: accounts,
+		prefix:   keyPrefix(conf),
+	}
+}
+
+// Incr 接口调用次数加一, 未注册的AppId不统计, 避免调用方传入任意AppId导致Redis key无限增长
+func (q *QuotaCounter) Incr(ctx context.Context, appId, path string) {
+	if !q.accounts.Registered(ctx, appId) {
+		return
+	}
+	key := q.usageKey(appId, time.Now().In(chinaTZ).Format("20060102"))
+
+	pipe := q.rdb.Pipeline()
+	pipe.HIncrBy(ctx, key, path, 1)
+	pipe.Expire(ctx, key, quotaUsageTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		ctxLogger(ctx, q.log).Warn("incr quota usage error", zap.String("appId", appId),
+			zap.String("path", path), zap.Error(err))
+	}
+}
+
+// Usage 查询账号某日(yyyymmdd, 为空时为当日)各接口的调用次数, 按接口路径排序
+func (q *QuotaCounter) Usage(ctx context.Context, appId, date string) (string, []*QuotaUsage, error) {
+	if date == "" {
+		date = time.Now().In(chinaTZ).Format("20060102")
+	} else if _, err := time.Parse("20060102", date); err != nil {
+		return "", nil, v1.ErrorInvalidArgument("invalid date %q, want yyyymmdd", date)
+	}
+
+	counts, err := q.rdb.HGetAll(ctx, q.usageKey(appId, date)).Result()
+	if err != nil {
+		return "", nil, err
+	}
+
+	usages := make([]*QuotaUsage, 0, len(counts))
+	for path, count := range counts {
+		n, _ := strconv.ParseInt(count, 10, 64)
+		usages = append(usages, &QuotaUsage{CgiPath: path, Count: n})
+	}
+	sort.Slice(usages, func(i, j int) bool {
+		return usages[i].CgiPath < usages[j].CgiPath
+	})
+
+	return date, usages, nil
+}
+
+func (q *QuotaCounter) usageKey(appId, date string) string {
+	return q.prefix + ":quota:" + appId + ":" + date
+}
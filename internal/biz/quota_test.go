@@ -0,0 +1,98 @@
+package biz
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/seth16888/wxproxy/internal/config"
+	"github.com/seth16888/wxproxy/internal/consts"
+	"go.uber.org/zap"
+)
+
+// quotaCount 当日接口的调用次数
+func quotaCount(t *testing.T, q *QuotaCounter, appId, path string) int64 {
+	t.Helper()
+	_, usages, err := q.Usage(context.Background(), appId, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, u := range usages {
+		if u.CgiPath == path {
+			return u.Count
+		}
+	}
+	return 0
+}
+
+func TestQuotaCountsSentRequests(t *testing.T) {
+	mr, rdb := newTestRedis(t)
+	accounts := NewAccountUsecase([]*config.Account{{AppId: testAppId}}, nil, rdb, zap.NewNop())
+	quota := NewQuotaCounter(rdb, accounts, nil, zap.NewNop())
+	limiter := NewRateLimiter(rdb, &config.RateLimit{Enabled: true, Rules: []*config.RateLimitRule{
+		{AppId: "*", Path: "*", Rate: 0.001, Burst: 1},
+	}}, nil, zap.NewNop())
+	srv := okServer(t)
+	c := newWXClient(NewHttpClient(), nil, quota, limiter, nil, nil, nil, zap.NewNop())
+
+	const path = "/cgi-bin/menu/get"
+	get := func(appId string) error {
+		ctx := context.WithValue(context.Background(), consts.AppIdKey, appId)
+		resp, err := c.Get(ctx, srv.URL+path+"?access_token=token")
+		if resp != nil {
+			resp.Body.Close()
+		}
+		return err
+	}
+
+	if err := get(testAppId); err != nil {
+		t.Fatal(err)
+	}
+	// 被频率限制拒绝的请求不计数
+	if err := get(testAppId); !isRateLimited(err) {
+		t.Fatalf("err = %v, want RATE_LIMITED", err)
+	}
+	if n := quotaCount(t, quota, testAppId, path); n != 1 {
+		t.Fatalf("count = %d, want 1", n)
+	}
+
+	// 未注册的AppId不统计
+	if err := get("wx-not-registered"); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range mr.Keys() {
+		if strings.Contains(key, ":quota:wx-not-registered") {
+			t.Fatalf("quota key created for unregistered AppId: %s", key)
+		}
+	}
+}
+
+func TestQuotaCountsFailover(t *testing.T) {
+	_, rdb := newTestRedis(t)
+	accounts := NewAccountUsecase([]*config.Account{{AppId: testAppId}}, nil, rdb, zap.NewNop())
+	quota := NewQuotaCounter(rdb, accounts, nil, zap.NewNop())
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	t.Cleanup(failing.Close)
+	ok := okServer(t)
+	domains := NewDomainPool(&config.Upstream{Domains: []string{hostOf(t, failing), hostOf(t, ok)}},
+		nil, zap.NewNop())
+	c := newWXClient(NewHttpClient(), nil, quota, nil, nil, domains, nil, zap.NewNop())
+
+	const path = "/cgi-bin/menu/get"
+	ctx := context.WithValue(context.Background(), consts.AppIdKey, testAppId)
+	resp, err := c.Get(ctx, failing.URL+path+"?access_token=token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	// 换域名后的请求另计一次
+	if n := quotaCount(t, quota, testAppId, path); n != 2 {
+		t.Fatalf("count = %d, want 2", n)
+	}
+}
@@ -0,0 +1,212 @@
+package biz
+
+import (
+	"context"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	v1 "github.com/seth16888/wxproxy/api/v1"
+	"github.com/seth16888/wxproxy/internal/config"
+	"github.com/seth16888/wxproxy/internal/consts"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// tokenBucketScript 令牌桶, 使用Redis服务器时间, 多副本共享
+//
+// KEYS[i] 第i个令牌桶, ARGV[2i-1] 每秒生成的令牌数, ARGV[2i] 容量.
+// 所有令牌桶都有令牌时才各取一个令牌, 任一令牌桶不足时都不扣减.
+// 返回{是否通过, 需要等待的毫秒数, 令牌不足的令牌桶序号(从1开始)}.
+var tokenBucketScript = redis.NewScript(`
+local t = redis.call("TIME")
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+
+local tokens = {}
+local wait = 0
+local denied = 0
+for i, key in ipairs(KEYS) do
+	local rate = tonumber(ARGV[2 * i - 1])
+	local burst = tonumber(ARGV[2 * i])
+	local bucket = redis.call("HMGET", key, "tokens", "ts")
+	local n = tonumber(bucket[1])
+	local ts = tonumber(bucket[2])
+	if n == nil then
+		n = burst
+		ts = now
+	end
+	n = math.min(burst, n + math.max(0, now - ts) * rate / 1000)
+	tokens[i] = n
+	if n < 1 then
+		local w = math.ceil((1 - n) * 1000 / rate)
+		if w > wait then
+			wait = w
+			denied = i
+		end
+	end
+end
+if denied > 0 then
+	return {0, wait, denied}
+end
+
+for i, key in ipairs(KEYS) do
+	local rate = tonumber(ARGV[2 * i - 1])
+	local burst = tonumber(ARGV[2 * i])
+	redis.call("HSET", key, "tokens", tostring(tokens[i] - 1), "ts", now)
+	redis.call("PEXPIRE", key, math.ceil(burst * 1000 / rate) + 1000)
+end
+return {1, 0, 0}
+`)
+
+// RateLimiter 按AppId、gRPC方法、微信接口路径和调用方限制调用频率
+//
+// 每条规则对应一组令牌桶({prefix}:rate_limit:{rule}:{appId}:{method}:{client}[:{path}]).
+// 未配置path的规则在gRPC拦截器中按请求检查(Allow); 配置了path的规则在每次请求微信接口时检查(AllowPath),
+// 流式RPC分页请求微信接口时每页都会检查. 请求须通过所有匹配的规则, 所有规则在一次Lua调用中检查,
+// 任一规则不通过时不消耗其他规则的令牌. Redis不可用时放行请求.
+type RateLimiter struct {
+	log    *zap.Logger
+	rdb    redis.UniversalClient
+	prefix string
+	rules  []*config.RateLimitRule
+}
+
+// NewRateLimiter 未启用或没有规则时返回nil
+func NewRateLimiter(rdb redis.UniversalClient, conf *config.RateLimit, token *config.Token,
+	logger *zap.Logger,
+) *RateLimiter {
+	if conf == nil || !conf.Enabled {
+		return nil
+	}
+
+	var rules []*config.RateLimitRule
+	for _, rule := range conf.Rules {
+		if rule.Rate <= 0 {
+			logger.Warn("ignore rate limit rule without rate", zap.Any("rule", rule))
+			continue
+		}
+		if rule.Burst <= 0 {
+			rule.Burst = max(int(rule.Rate), 1)
+		}
+		rules = append(rules, rule)
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+
+	return &RateLimiter{
+		log:    logger,
+		rdb:    rdb,
+		prefix: keyPrefix(token),
+		rules:  rules,
+	}
+}
+
+// Allow 检查gRPC请求是否超过未配置path的规则, 超过时返回RATE_LIMITED错误, 详情中携带RetryInfo
+func (l *RateLimiter) Allow(ctx context.Context, appId, method, clientId string) error {
+	return l.check(ctx, appId, method, "", clientId)
+}
+
+// AllowPath 检查一次微信接口请求是否超过配置了path的规则, 调用方和gRPC方法取自上下文; l为nil时放行
+func (l *RateLimiter) AllowPath(ctx context.Context, appId, apiPath string) error {
+	if l == nil || apiPath == "" {
+		return nil
+	}
+	clientId, _ := ctx.Value(consts.ClientIdKey).(string)
+	method, _ := grpc.Method(ctx)
+	return l.check(ctx, appId, method, apiPath, clientId)
+}
+
+// check 在一次Lua调用中检查所有匹配的规则, apiPath为空时检查未配置path的规则, 否则检查配置了path的规则
+func (l *RateLimiter) check(ctx context.Context, appId, method, apiPath, clientId string) error {
+	var (
+		keys []string
+		args []any
+	)
+	for i, rule := range l.rules {
+		if (rule.Path != "") != (apiPath != "") {
+			continue
+		}
+		if !matchLimitValue(rule.AppId, appId) || !matchLimitMethod(rule.Method, method) ||
+			!matchLimitValue(rule.Client, clientId) || !matchLimitValue(rule.Path, apiPath) {
+			continue
+		}
+
+		parts := []string{
+			l.prefix, "rate_limit", strconv.Itoa(i),
+			limitKeyPart(rule.AppId, appId),
+			limitKeyPart(rule.Method, path.Base(method)),
+			limitKeyPart(rule.Client, clientId),
+		}
+		if rule.Path != "" {
+			parts = append(parts, limitKeyPart(rule.Path, apiPath))
+		}
+		keys = append(keys, strings.Join(parts, ":"))
+		args = append(args, rule.Rate, rule.Burst)
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+
+	res, err := tokenBucketScript.Run(ctx, l.rdb, keys, args...).Int64Slice()
+	if err != nil {
+		ctxLogger(ctx, l.log).Warn("rate limit error", zap.Strings("keys", keys), zap.Error(err))
+		return nil
+	}
+	if res[0] == 1 {
+		return nil
+	}
+
+	key := keys[res[2]-1]
+	retryAfter := time.Duration(res[1]) * time.Millisecond
+	ctxLogger(ctx, l.log).Warn("rate limited", zap.String("appId", appId), zap.String("method", method),
+		zap.String("path", apiPath), zap.String("clientId", clientId), zap.Duration("retryAfter", retryAfter))
+	return rateLimitedError(key, retryAfter)
+}
+
+// isRateLimited 请求被频率限制拒绝, 不重试也不换域名
+func isRateLimited(err error) bool {
+	return err != nil && v1.IsRateLimited(err)
+}
+
+// rateLimitedError RATE_LIMITED错误, ErrorInfo的metadata中携带retry_after_ms, 并附带RetryInfo
+func rateLimitedError(key string, retryAfter time.Duration) error {
+	st := v1.ErrorRateLimited("rate limit exceeded, retry after %s", retryAfter).
+		WithMetadata(map[string]string{
+			"limit":          key,
+			"retry_after_ms": strconv.FormatInt(retryAfter.Milliseconds(), 10),
+		}).GRPCStatus()
+
+	if withRetry, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)}); err == nil {
+		st = withRetry
+	}
+	return st.Err()
+}
+
+// matchLimitValue 规则的取值为空时匹配任意值, 为"*"时匹配任意非空值
+func matchLimitValue(rule, value string) bool {
+	switch rule {
+	case "":
+		return true
+	case "*":
+		return value != ""
+	}
+	return rule == value
+}
+
+// matchLimitMethod 规则可以是完整方法名或方法名
+func matchLimitMethod(rule, fullMethod string) bool {
+	return matchLimitValue(rule, fullMethod) || rule == path.Base(fullMethod)
+}
+
+// limitKeyPart 令牌桶key中的维度, 规则为空时不区分该维度
+func limitKeyPart(rule, value string) string {
+	if rule == "" {
+		return "-"
+	}
+	return value
+}
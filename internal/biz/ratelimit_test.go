@@ -0,0 +1,67 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/seth16888/wxproxy/api/v1"
+	"github.com/seth16888/wxproxy/internal/config"
+	"go.uber.org/zap"
+)
+
+func TestRateLimitAllRulesAtomic(t *testing.T) {
+	mr, rdb := newTestRedis(t)
+	ctx := context.Background()
+
+	// 第一条规则容量较大, 第二条规则只允许1次
+	l := NewRateLimiter(rdb, &config.RateLimit{Enabled: true, Rules: []*config.RateLimitRule{
+		{AppId: "*", Rate: 0.001, Burst: 3},
+		{AppId: "*", Method: "SendCustomMessage", Rate: 0.001, Burst: 1},
+	}}, nil, zap.NewNop())
+
+	const method = "/api.v1.MPProxy/SendCustomMessage"
+	if err := l.Allow(ctx, testAppId, method, ""); err != nil {
+		t.Fatal(err)
+	}
+	for range 3 {
+		if err := l.Allow(ctx, testAppId, method, ""); !v1.IsRateLimited(err) {
+			t.Fatalf("err = %v, want RATE_LIMITED", err)
+		}
+	}
+
+	// 第二条规则拒绝的请求不消耗第一条规则的令牌
+	key := "wxproxy:rate_limit:0:" + testAppId + ":-:-"
+	if tokens := mr.HGet(key, "tokens"); tokens == "" || tokens[0] != '2' {
+		t.Fatalf("tokens of %s = %q, want 2", key, tokens)
+	}
+	if err := l.Allow(ctx, testAppId, "/api.v1.MPProxy/GetMenu", ""); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRateLimitPerPath(t *testing.T) {
+	_, rdb := newTestRedis(t)
+	ctx := context.Background()
+
+	l := NewRateLimiter(rdb, &config.RateLimit{Enabled: true, Rules: []*config.RateLimitRule{
+		{AppId: "*", Path: "*", Rate: 0.001, Burst: 1},
+	}}, nil, zap.NewNop())
+
+	// 只配置了path的规则不在gRPC请求时检查
+	for range 2 {
+		if err := l.Allow(ctx, testAppId, "/api.v1.MPProxy/GetMenu", ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := l.AllowPath(ctx, testAppId, "/cgi-bin/menu/get"); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.AllowPath(ctx, testAppId, "/cgi-bin/menu/get"); !v1.IsRateLimited(err) {
+		t.Fatalf("err = %v, want RATE_LIMITED", err)
+	}
+	// 每个接口路径单独限流
+	if err := l.AllowPath(ctx, testAppId, "/cgi-bin/user/get"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,214 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/seth16888/wxproxy/internal/config"
+	"go.uber.org/zap"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	// defaultRenewInterval 默认后台续期扫描间隔
+	defaultRenewInterval = 60 * time.Second
+	// defaultRenewBefore 默认在AccessToken过期前多久续期
+	defaultRenewBefore = 900 * time.Second
+	// maxRenewBackoff 续期失败后的最大退避时间
+	maxRenewBackoff = 30 * time.Minute
+	// renewJitter 扫描间隔的随机抖动比例
+	renewJitter = 0.2
+)
+
+// HealthReporter 健康状态上报, 由grpc health.Server实现
+type HealthReporter interface {
+	SetServingStatus(service string, servingStatus healthpb.HealthCheckResponse_ServingStatus)
+}
+
+// TokenHealthService 账号AccessToken续期状态在健康检查中的服务名
+func TokenHealthService(appId string) string {
+	return "wxproxy.token/" + appId
+}
+
+// renewState 账号的续期失败状态
+type renewState struct {
+	failures int
+	next     time.Time
+}
+
+// TokenRenewer 后台AccessToken续期
+//
+// 定期扫描所有已注册账号, 在AccessToken过期前续期. 多副本部署时通过Redis锁({prefix}:token_renewer)选主,
+// 只有主副本执行续期. 续期失败的账号按指数退避重试, 并在健康检查中标记为NOT_SERVING.
+type TokenRenewer struct {
+	log      *zap.Logger
+	token    *TokenUsecase
+	health   HealthReporter
+	interval time.Duration
+	before   time.Duration
+
+	mu     sync.Mutex
+	states map[string]*renewState
+}
+
+func NewTokenRenewer(token *TokenUsecase, health HealthReporter,
+	conf *config.Token, logger *zap.Logger,
+) *TokenRenewer {
+	interval, before := defaultRenewInterval, defaultRenewBefore
+	if conf != nil && conf.RenewInterval != 0 {
+		interval = time.Duration(conf.RenewInterval) * time.Second
+	}
+	if conf != nil && conf.RenewBefore > 0 {
+		before = time.Duration(conf.RenewBefore) * time.Second
+	}
+
+	return &TokenRenewer{
+		log:      logger,
+		token:    token,
+		health:   health,
+		interval: interval,
+		before:   before,
+		states:   make(map[string]*renewState),
+	}
+}
+
+// Run 运行续期任务, 直到ctx取消
+func (r *TokenRenewer) Run(ctx context.Context) {
+	if r.interval < 0 {
+		r.log.Info("token renewer disabled")
+		return
+	}
+	r.log.Info("token renewer started", zap.Duration("interval", r.interval),
+		zap.Duration("before", r.before))
+
+	var leader *Lock
+	defer func() {
+		if leader != nil {
+			if err := leader.Release(context.WithoutCancel(ctx)); err != nil {
+				r.log.Warn("release renewer leader lock error", zap.Error(err))
+			}
+		}
+		r.log.Info("token renewer stopped")
+	}()
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		leader = r.elect(ctx, leader)
+		if leader != nil {
+			r.renewAll(ctx)
+		}
+		timer.Reset(r.jitter())
+	}
+}
+
+// elect 获得或保持主副本身份, 返回nil表示当前副本不是主副本
+func (r *TokenRenewer) elect(ctx context.Context, leader *Lock) *Lock {
+	ttl := 3 * r.interval
+	key := r.token.prefix + ":token_renewer"
+
+	if leader != nil {
+		err := leader.Refresh(ctx, ttl)
+		if err == nil {
+			return leader
+		}
+		r.log.Warn("token renewer lost leadership", zap.Error(err))
+	}
+
+	lock, err := r.token.locker.Obtain(ctx, key, ttl)
+	if err != nil {
+		if !errors.Is(err, ErrLockNotObtained) {
+			r.log.Error("token renewer election error", zap.Error(err))
+		}
+		return nil
+	}
+	r.log.Info("token renewer became leader", zap.String("fence", lock.Fence()))
+
+	return lock
+}
+
+// renewAll 续期所有即将过期的AccessToken
+func (r *TokenRenewer) renewAll(ctx context.Context) {
+	accounts, err := r.token.accounts.ListAccounts(ctx)
+	if err != nil {
+		r.log.Error("token renewer list accounts error", zap.Error(err))
+	}
+
+	now := time.Now()
+	for _, account := range accounts {
+		if ctx.Err() != nil {
+			return
+		}
+		if !r.due(ctx, account.AppId, now) {
+			continue
+		}
+		r.renew(ctx, account.AppId)
+	}
+}
+
+// due 账号是否需要续期: AccessToken缺失或即将过期, 且不在失败退避期内
+func (r *TokenRenewer) due(ctx context.Context, appId string, now time.Time) bool {
+	r.mu.Lock()
+	state := r.states[appId]
+	r.mu.Unlock()
+	if state != nil && now.Before(state.next) {
+		return false
+	}
+
+	at, err := r.token.loadToken(ctx, appId)
+	if err != nil || at == nil {
+		return true
+	}
+	return time.Unix(at.ExpiresAt, 0).Sub(now) < r.before
+}
+
+func (r *TokenRenewer) renew(ctx context.Context, appId string) {
+	_, err := r.token.RefreshAccessToken(ctx, appId, false)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err != nil {
+		state := r.states[appId]
+		if state == nil {
+			state = &renewState{}
+			r.states[appId] = state
+		}
+		state.failures++
+		backoff := min(r.interval<<min(state.failures-1, 16), maxRenewBackoff)
+		state.next = time.Now().Add(backoff)
+
+		r.log.Error("token renew failed", zap.String("appId", appId),
+			zap.Int("failures", state.failures), zap.Duration("backoff", backoff), zap.Error(err))
+		r.setStatus(appId, healthpb.HealthCheckResponse_NOT_SERVING)
+		return
+	}
+
+	if state := r.states[appId]; state != nil {
+		r.log.Info("token renew recovered", zap.String("appId", appId), zap.Int("failures", state.failures))
+		delete(r.states, appId)
+	}
+	r.setStatus(appId, healthpb.HealthCheckResponse_SERVING)
+}
+
+func (r *TokenRenewer) setStatus(appId string, status healthpb.HealthCheckResponse_ServingStatus) {
+	if r.health != nil {
+		r.health.SetServingStatus(TokenHealthService(appId), status)
+	}
+}
+
+// jitter 带随机抖动的扫描间隔, 避免多个副本同时扫描
+func (r *TokenRenewer) jitter() time.Duration {
+	delta := (rand.Float64()*2 - 1) * renewJitter * float64(r.interval)
+	return r.interval + time.Duration(delta)
+}
@@ -0,0 +1,174 @@
+package biz
+
+import (
+	"context"
+	"math/rand/v2"
+	"path"
+	"sync/atomic"
+	"time"
+
+	"github.com/seth16888/wxproxy/internal/config"
+)
+
+const (
+	// defaultRetryAttempts 默认最多尝试次数(含首次)
+	defaultRetryAttempts = 3
+	// defaultRetryInitialBackoff 默认首次重试前的最大等待时间
+	defaultRetryInitialBackoff = 100 * time.Millisecond
+	// defaultRetryMaxBackoff 默认重试等待时间上限
+	defaultRetryMaxBackoff = 2 * time.Second
+	// defaultRetryMultiplier 默认重试等待时间的增长倍数
+	defaultRetryMultiplier = 2.0
+)
+
+// readMethods 默认视为幂等的gRPC方法, 只调用微信的查询接口
+//
+// 按方法逐个列出而不按方法名前缀判断: 如GetMessageTplId调用api_add_template添加模板, 并非只读.
+var readMethods = map[string]bool{
+	"GetMaterialCount":        true,
+	"GetMaterialNewsList":     true,
+	"GetMaterialList":         true,
+	"GetMemberList":           true,
+	"GetMemberInfo":           true,
+	"BatchGetMemberInfo":      true,
+	"GetMemberTags":           true,
+	"GetTagList":              true,
+	"GetTagMembers":           true,
+	"FetchShorten":            true,
+	"GetMenuInfo":             true,
+	"TryMatchMenu":            true,
+	"PullMenu":                true,
+	"GetIndustry":             true,
+	"GetAllPrivateTpl":        true,
+	"GetBlockedTplMsg":        true,
+	"GetSubscribeCategory":    true,
+	"GetSubscribeTplKeywords": true,
+	"GetSubscribeTplTitles":   true,
+	"GetSubscribePrivateTpl":  true,
+	"GetKFList":               true,
+	"GetKFOnlineList":         true,
+	"GetKFMsgHistory":         true,
+	"GetKFSessionList":        true,
+	"GetKFSessionStatus":      true,
+	"GetKFSessionUnaccepted":  true,
+	"GetBlacklist":            true,
+	"GetAccessToken":          true,
+	"DescribeErrorCode":       true,
+	"GetRidInfo":              true,
+	"GetApiQuota":             true,
+	"GetQuotaUsage":           true,
+}
+
+// retryKey 上下文中重试状态的key
+type retryKey struct{}
+
+// retryState 一次gRPC调用的重试策略和尝试次数
+type retryState struct {
+	policy *RetryPolicy
+	// repeatable 请求可以重复发送: 方法幂等或携带幂等键, 失败时可以重试和换域名
+	repeatable bool
+	attempts   atomic.Int32
+}
+
+// RetryPolicy 重试策略, 微信接口网络错误、HTTP 5xx和系统繁忙(-1)时按指数退避和随机抖动重试
+type RetryPolicy struct {
+	MaxAttempts int
+	Initial     time.Duration
+	Max         time.Duration
+	Multiplier  float64
+}
+
+// Backoff 第n次重试(从1开始)前的等待时间, 在[0, min(Max, Initial*Multiplier^(n-1))]中随机
+func (p *RetryPolicy) Backoff(n int) time.Duration {
+	backoff := float64(p.Initial)
+	for i := 1; i < n && backoff < float64(p.Max); i++ {
+		backoff *= p.Multiplier
+	}
+	backoff = min(backoff, float64(p.Max))
+	return time.Duration(rand.Float64() * backoff)
+}
+
+// RetryPolicies 按gRPC方法配置的重试策略
+//
+// 幂等的方法按策略重试; 非幂等的方法(如发送消息)只在请求携带幂等键时重试.
+// 幂等键只表示调用方允许重复发送, WXProxy不按幂等键去重, 重试可能导致微信重复执行.
+type RetryPolicies struct {
+	defaults RetryPolicy
+	methods  map[string]*config.RetryMethod
+}
+
+func NewRetryPolicies(conf *config.Retry) *RetryPolicies {
+	p := &RetryPolicies{
+		defaults: RetryPolicy{
+			MaxAttempts: defaultRetryAttempts,
+			Initial:     defaultRetryInitialBackoff,
+			Max:         defaultRetryMaxBackoff,
+			Multiplier:  defaultRetryMultiplier,
+		},
+		methods: make(map[string]*config.RetryMethod),
+	}
+	if conf == nil {
+		return p
+	}
+
+	if conf.MaxAttempts > 0 {
+		p.defaults.MaxAttempts = conf.MaxAttempts
+	}
+	if conf.InitialBackoff > 0 {
+		p.defaults.Initial = time.Duration(conf.InitialBackoff) * time.Millisecond
+	}
+	if conf.MaxBackoff > 0 {
+		p.defaults.Max = time.Duration(conf.MaxBackoff) * time.Millisecond
+	}
+	if conf.Multiplier >= 1 {
+		p.defaults.Multiplier = conf.Multiplier
+	}
+	for _, m := range conf.Methods {
+		p.methods[path.Base(m.Method)] = m
+	}
+	return p
+}
+
+// Policy 方法的重试策略, 不重试时MaxAttempts为1
+func (p *RetryPolicies) Policy(fullMethod string, hasIdempotencyKey bool) *RetryPolicy {
+	name := path.Base(fullMethod)
+	policy := p.defaults
+
+	if m, ok := p.methods[name]; ok && m.MaxAttempts > 0 {
+		policy.MaxAttempts = m.MaxAttempts
+	}
+	if !p.idempotent(name) && !hasIdempotencyKey {
+		policy.MaxAttempts = 1
+	}
+	return &policy
+}
+
+// WithRetry 将方法的重试策略写入上下文, 供调用微信接口时使用
+func (p *RetryPolicies) WithRetry(ctx context.Context, fullMethod string, idempotencyKey string) context.Context {
+	return context.WithValue(ctx, retryKey{}, &retryState{
+		policy:     p.Policy(fullMethod, idempotencyKey != ""),
+		repeatable: p.idempotent(path.Base(fullMethod)) || idempotencyKey != "",
+	})
+}
+
+// idempotent 方法是否幂等, 配置优先, 未配置时见readMethods
+func (p *RetryPolicies) idempotent(name string) bool {
+	if m, ok := p.methods[name]; ok && m.Idempotent != nil {
+		return *m.Idempotent
+	}
+	return readMethods[name]
+}
+
+// Attempts 本次gRPC调用中微信接口的尝试次数
+func (p *RetryPolicies) Attempts(ctx context.Context) int {
+	if st, ok := ctx.Value(retryKey{}).(*retryState); ok {
+		return int(st.attempts.Load())
+	}
+	return 0
+}
+
+// retryFromContext 上下文中的重试状态, 没有时返回nil
+func retryFromContext(ctx context.Context) *retryState {
+	st, _ := ctx.Value(retryKey{}).(*retryState)
+	return st
+}
@@ -0,0 +1,58 @@
+package biz
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestCanFailover(t *testing.T) {
+	p := NewRetryPolicies(nil)
+	tests := []struct {
+		name   string
+		method string
+		key    string
+		http   string
+		sent   bool
+		want   bool
+	}{
+		{"read post", "/api.v1.MPProxy/GetMaterialList", "", http.MethodPost, true, true},
+		{"batch get post", "/api.v1.MPProxy/BatchGetMemberInfo", "", http.MethodPost, true, true},
+		{"blacklist post", "/api.v1.MPProxy/GetBlacklist", "", http.MethodPost, true, true},
+		{"send post", "/api.v1.MPProxy/SendTplMsg", "", http.MethodPost, true, false},
+		{"send post with key", "/api.v1.MPProxy/SendTplMsg", "k1", http.MethodPost, true, true},
+		{"send post not sent", "/api.v1.MPProxy/SendTplMsg", "", http.MethodPost, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := p.WithRetry(context.Background(), tt.method, tt.key)
+			if got := canFailover(ctx, tt.http, tt.sent); got != tt.want {
+				t.Fatalf("canFailover = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if !canFailover(context.Background(), http.MethodGet, true) ||
+		canFailover(context.Background(), http.MethodPost, true) {
+		t.Fatal("without retry state only GET requests fail over")
+	}
+}
+
+func TestPolicyIdempotentMethods(t *testing.T) {
+	p := NewRetryPolicies(nil)
+	tests := []struct {
+		method string
+		want   int
+	}{
+		{"/api.v1.MPProxy/GetMemberInfo", defaultRetryAttempts},
+		{"/api.v1.MPProxy/TryMatchMenu", defaultRetryAttempts},
+		// 调用api_add_template添加模板, 不是只读
+		{"/api.v1.MPProxy/GetMessageTplId", 1},
+		{"/api.v1.MPProxy/SendTplMsg", 1},
+	}
+	for _, tt := range tests {
+		if got := p.Policy(tt.method, false).MaxAttempts; got != tt.want {
+			t.Errorf("%s: MaxAttempts = %d, want %d", tt.method, got, tt.want)
+		}
+	}
+}
@@ -10,7 +10,6 @@ import (
 	"github.com/seth16888/wxcommon/paths"
 	v1 "github.com/seth16888/wxproxy/api/v1"
 
-	. "github.com/seth16888/wxcommon/logger"
 )
 
 // SendSubscriptionMessageReq 发送订阅消息
@@ -75,7 +74,7 @@ type GetSubscribeCategoryRes struct {
 func (m *MPProxyUsecase) AddSubscribeTpl(ctx context.Context, token string,
 	tid string, sceneDesc string, kidList []int64) (string, error) {
 	url := fmt.Sprintf("https://%s%s?access_token=%s", domain.GetWXAPIDomain(), paths.Path_Add_Template, token)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	type AddSubscribeTplReq struct {
 		Tid       string  `json:"tid"`
@@ -90,25 +89,20 @@ func (m *MPProxyUsecase) AddSubscribeTpl(ctx context.Context, token string,
 
 	reader, err := helpers.BuildRequestBody[*AddSubscribeTplReq](req)
 	if err != nil {
-		Errorf("build request body error: %s", err.Error())
+		m.logger(ctx).Errorf("build request body error: %s", err.Error())
 		return "", err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", reader)
+	resp, err := m.wx.Post(ctx, url, "application/json", reader)
 
 	type resultT struct {
 		wxError.WXError
 		TmplID string `json:"priTmplId"`
 	}
-	rt, wxErr := helpers.BuildHttpResponse[resultT](resp, err)
-	if wxErr != nil {
-		Errorf("AddSubscribeTpl error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return "", fmt.Errorf("AddSubscribeTpl error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if rt.ErrCode != 0 {
-		Errorf("AddSubscribeTpl error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return "", fmt.Errorf("AddSubscribeTpl error: %d %s", rt.ErrCode, rt.ErrMsg)
+	rt, err := decodeResponse[resultT](resp, err)
+	if err != nil {
+		m.logger(ctx).Errorf("AddSubscribeTpl error: %s", err.Error())
+		return "", err
 	}
 
 	return rt.TmplID, nil
@@ -117,13 +111,13 @@ func (m *MPProxyUsecase) AddSubscribeTpl(ctx context.Context, token string,
 // GetSubscribeCategory
 func (m *MPProxyUsecase) GetSubscribeCategory(ctx context.Context, token string) (*GetSubscribeCategoryRes, error) {
 	url := fmt.Sprintf("https://%s%s?access_token=%s", domain.GetWXAPIDomain(), paths.Path_Get_Category, token)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
-	resp, err := m.hc.Get(url)
-	rt, wxErr := helpers.BuildHttpResponse[GetSubscribeCategoryRes](resp, err)
-	if wxErr != nil {
-		Errorf("GetSubscribeCategory error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return nil, fmt.Errorf("GetSubscribeCategory error: %d %s", wxErr.ErrCode, wxErr.Error())
+	resp, err := m.wx.Get(ctx, url)
+	rt, err := decodeResponse[GetSubscribeCategoryRes](resp, err)
+	if err != nil {
+		m.logger(ctx).Errorf("GetSubscribeCategory error: %s", err.Error())
+		return nil, err
 	}
 
 	return rt, nil
@@ -131,7 +125,7 @@ func (m *MPProxyUsecase) GetSubscribeCategory(ctx context.Context, token string)
 
 func (m *MPProxyUsecase) DelSubscribeTpl(ctx context.Context, token string, tplId string) error {
 	url := fmt.Sprintf("https://%s%s?access_token=%s", domain.GetWXAPIDomain(), paths.Path_Del_Subscription_Template, token)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	type DelSubscribeTplReq struct {
 		PriTmplId string `json:"priTmplId"`
@@ -142,37 +136,27 @@ func (m *MPProxyUsecase) DelSubscribeTpl(ctx context.Context, token string, tplI
 
 	reader, err := helpers.BuildRequestBody[*DelSubscribeTplReq](req)
 	if err != nil {
-		Errorf("build request body error: %s", err.Error())
+		m.logger(ctx).Errorf("build request body error: %s", err.Error())
 		return err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", reader)
-	rt, wxErr := helpers.BuildHttpResponse[wxError.WXError](resp, err)
-	if wxErr != nil {
-		Errorf("DelSubscribeTpl error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return fmt.Errorf("DelSubscribeTpl error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if rt.ErrCode != 0 {
-		Errorf("DelSubscribeTpl error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return fmt.Errorf("DelSubscribeTpl error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Post(ctx, url, "application/json", reader)
+	if _, err := decodeResponse[wxError.WXError](resp, err); err != nil {
+		m.logger(ctx).Errorf("DelSubscribeTpl error: %s", err.Error())
+		return err
 	}
 
 	return nil
 }
 func (m *MPProxyUsecase) GetSubscribeTplKeywords(ctx context.Context, token string, tplId string) (*GetPubTemplateKeyWordsRes, error) {
 	url := fmt.Sprintf("https://%s%s?access_token=%s&tid=%s", domain.GetWXAPIDomain(), paths.Path_Get_PubTpl_KeyWorks, token, tplId)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
-	resp, err := m.hc.Get(url)
-	rt, wxErr := helpers.BuildHttpResponse[GetPubTemplateKeyWordsRes](resp, err)
-	if wxErr != nil {
-		Errorf("GetSubscribeTplKeywords error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return nil, fmt.Errorf("GetSubscribeTplKeywords error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-	if rt.ErrCode != 0 {
-		Errorf("GetSubscribeTplKeywords error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return nil, fmt.Errorf("GetSubscribeTplKeywords error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Get(ctx, url)
+	rt, err := decodeResponse[GetPubTemplateKeyWordsRes](resp, err)
+	if err != nil {
+		m.logger(ctx).Errorf("GetSubscribeTplKeywords error: %s", err.Error())
+		return nil, err
 	}
 
 	return rt, nil
@@ -181,43 +165,33 @@ func (m *MPProxyUsecase) GetSubscribeTplTitles(ctx context.Context, token string
   ids string, start int64, limit int64) (*GetPubTemplateTitlesRes, error) {
 	url := fmt.Sprintf("https://%s%s?access_token=%s&ids=%s&start=%d&limit=%d",
     domain.GetWXAPIDomain(), paths.Path_Get_PubTpl_Titles, token, ids, start, limit)
-	Debugf("url: %s", url)
-
-	resp, err := m.hc.Get(url)
-	rt, wxErr := helpers.BuildHttpResponse[GetPubTemplateTitlesRes](resp, err)
-	if wxErr != nil {
-		Errorf("GetSubscribeTplTitles error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return nil, fmt.Errorf("GetSubscribeTplTitles error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
+	m.logger(ctx).Debugf("url: %s", url)
 
-	if rt.ErrCode != 0 {
-		Errorf("GetSubscribeTplTitles error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return nil, fmt.Errorf("GetSubscribeTplTitles error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Get(ctx, url)
+	rt, err := decodeResponse[GetPubTemplateTitlesRes](resp, err)
+	if err != nil {
+		m.logger(ctx).Errorf("GetSubscribeTplTitles error: %s", err.Error())
+		return nil, err
 	}
 
 	return rt, nil
 }
 func (m *MPProxyUsecase) GetSubscribePrivateTpl(ctx context.Context, token string) (*GetPrivateTemplateListRes, error) {
 	url := fmt.Sprintf("https://%s%s?access_token=%s", domain.GetWXAPIDomain(), paths.Path_Get_AllPrivateTmpl, token)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
-	resp, err := m.hc.Get(url)
-	rt, wxErr := helpers.BuildHttpResponse[GetPrivateTemplateListRes](resp, err)
-	if wxErr != nil {
-		Errorf("GetSubscribePrivateTpl error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return nil, fmt.Errorf("GetSubscribePrivateTpl error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-
-	if rt.ErrCode != 0 {
-		Errorf("GetSubscribePrivateTpl error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return nil, fmt.Errorf("GetSubscribePrivateTpl error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Get(ctx, url)
+	rt, err := decodeResponse[GetPrivateTemplateListRes](resp, err)
+	if err != nil {
+		m.logger(ctx).Errorf("GetSubscribePrivateTpl error: %s", err.Error())
+		return nil, err
 	}
 
 	return rt, nil
 }
 func (m *MPProxyUsecase) SendSubscribeMessage(ctx context.Context, req *v1.SendSubscribeMessageRequest) error {
 	url := fmt.Sprintf("https://%s%s?access_token=%s", domain.GetWXAPIDomain(), paths.Path_Send_SubscribeMessage, req.AccessToken)
-	Debugf("url: %s", url)
+	m.logger(ctx).Debugf("url: %s", url)
 
 	var params = &SendSubscriptionMessageReq{
 		Touser:     req.Touser,
@@ -237,19 +211,14 @@ func (m *MPProxyUsecase) SendSubscribeMessage(ctx context.Context, req *v1.SendS
 
 	reader, err := helpers.BuildRequestBody[*SendSubscriptionMessageReq](params)
 	if err != nil {
-		Errorf("build request body error: %s", err.Error())
+		m.logger(ctx).Errorf("build request body error: %s", err.Error())
 		return err
 	}
 
-	resp, err := m.hc.Post(url, "application/json", reader)
-	rt, wxErr := helpers.BuildHttpResponse[wxError.WXError](resp, err)
-	if wxErr != nil {
-		Errorf("SendSubscribeMessage error: %d %s", wxErr.ErrCode, wxErr.Error())
-		return fmt.Errorf("SendSubscribeMessage error: %d %s", wxErr.ErrCode, wxErr.Error())
-	}
-	if rt.ErrCode != 0 {
-		Errorf("SendSubscribeMessage error: %d %s", rt.ErrCode, rt.ErrMsg)
-		return fmt.Errorf("SendSubscribeMessage error: %d %s", rt.ErrCode, rt.ErrMsg)
+	resp, err := m.wx.Post(ctx, url, "application/json", reader)
+	if _, err := decodeResponse[wxError.WXError](resp, err); err != nil {
+		m.logger(ctx).Errorf("SendSubscribeMessage error: %s", err.Error())
+		return err
 	}
 
 	return nil
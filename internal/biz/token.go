@@ -0,0 +1,461 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/seth16888/wxcommon/domain"
+	wxError "github.com/seth16888/wxcommon/error"
+	"github.com/seth16888/wxcommon/hc"
+	"github.com/seth16888/wxcommon/helpers"
+	"github.com/seth16888/wxproxy/internal/config"
+	"github.com/seth16888/wxproxy/internal/consts"
+	"github.com/seth16888/wxproxy/internal/metrics"
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// pathAccessToken 获取AccessToken
+	pathAccessToken = "/cgi-bin/token"
+	// pathStableToken 获取稳定版AccessToken
+	pathStableToken = "/cgi-bin/stable_token"
+
+	// defaultRefreshAhead 默认提前过期时间
+	defaultRefreshAhead = 300 * time.Second
+
+	// tokenLockTTL 刷新锁的过期时间, 需大于获取AccessToken的HTTP超时
+	tokenLockTTL = 15 * time.Second
+	// tokenLockPollInterval 等待刷新锁释放的检查间隔
+	tokenLockPollInterval = 100 * time.Millisecond
+
+	// defaultForceRefreshLimit stable_token每日强制刷新次数上限
+	defaultForceRefreshLimit = 20
+)
+
+// ErrForceRefreshExhausted 今日stable_token强制刷新次数已用完
+var ErrForceRefreshExhausted = status.Error(codes.ResourceExhausted, "stable token force refresh limit reached today")
+
+// forceRefreshScript 强制刷新计数加一, 超过上限(ARGV[1])时回退并返回-1
+var forceRefreshScript = redis.NewScript(`
+local n = redis.call("INCR", KEYS[1])
+if n == 1 then
+	redis.call("EXPIRE", KEYS[1], ARGV[2])
+end
+if n > tonumber(ARGV[1]) then
+	redis.call("DECR", KEYS[1])
+	return -1
+end
+return n
+`)
+
+// refreshMode 刷新方式
+type refreshMode int
+
+const (
+	// refreshIfMissing 缓存中没有AccessToken时才向微信获取
+	refreshIfMissing refreshMode = iota
+	// refreshAlways 忽略缓存, 向微信获取
+	refreshAlways
+	// refreshForce 忽略缓存, stable_token使用force_refresh模式
+	refreshForce
+)
+
+// chinaTZ 微信接口调用次数按北京时间每日重置
+var chinaTZ = time.FixedZone("CST", 8*3600)
+
+// AccessToken 公众号调用凭证
+type AccessToken struct {
+	AppId       string `json:"app_id"`
+	AccessToken string `json:"access_token"`
+	// ExpiresAt 过期时间, unix时间戳(秒)
+	ExpiresAt int64 `json:"expires_at"`
+}
+
+// TokenUsecase AccessToken托管
+//
+// token缓存在Redis({prefix}:access_token:{appId}), 缓存过期时间比微信返回的expires_in提前RefreshAhead秒.
+// 刷新由Redis分布式锁({prefix}:access_token_lock:{appId})协调, 多副本部署时只有一个副本调用微信接口.
+type TokenUsecase struct {
+	log *zap.Logger
+	// wx 请求token和clear_quota接口, 与代理请求共用熔断器、域名池和指标, 不经频率限制
+	wx       *wxClient
+	rdb      redis.UniversalClient
+	accounts *AccountUsecase
+	locker   *RedisLocker
+	prefix   string
+	ahead    time.Duration
+	// forceLimit stable_token每日强制刷新次数上限
+	forceLimit int64
+	sf         singleflight.Group
+	metrics    *metrics.Metrics
+	// fetch 向微信获取AccessToken, 默认为fetchToken
+	fetch func(ctx context.Context, account *Account, forceRefresh bool) (*AccessToken, error)
+}
+
+func NewTokenUsecase(hc *hc.Client, rdb redis.UniversalClient, accounts *AccountUsecase, quota *QuotaCounter,
+	breakers *BreakerGroup, domains *DomainPool, conf *config.Token, m *metrics.Metrics, logger *zap.Logger,
+) *TokenUsecase {
+	ahead := defaultRefreshAhead
+	if conf != nil && conf.RefreshAhead > 0 {
+		ahead = time.Duration(conf.RefreshAhead) * time.Second
+	}
+	forceLimit := int64(defaultForceRefreshLimit)
+	if conf != nil && conf.ForceRefreshLimit > 0 {
+		forceLimit = int64(conf.ForceRefreshLimit)
+	}
+
+	t := &TokenUsecase{
+		log:        logger,
+		wx:         newWXClient(hc, nil, quota, nil, breakers, domains, m, logger),
+		rdb:        rdb,
+		accounts:   accounts,
+		locker:     NewRedisLocker(rdb),
+		prefix:     keyPrefix(conf),
+		ahead:      ahead,
+		forceLimit: forceLimit,
+		metrics:    m,
+	}
+	t.fetch = t.fetchToken
+	return t
+}
+
+// metricAppId 指标中的app_id标签, 未注册的AppId统一为unknown, 避免调用方传入任意AppId导致标签无限增长
+func (t *TokenUsecase) metricAppId(ctx context.Context, appId string) string {
+	if t == nil || t.metrics == nil || t.accounts.Registered(ctx, appId) {
+		return appId
+	}
+	return metrics.UnknownAppId
+}
+
+// AccessToken 获取AppId对应的AccessToken字符串
+func (t *TokenUsecase) AccessToken(ctx context.Context, appId string) (string, error) {
+	at, err := t.GetAccessToken(ctx, appId)
+	if err != nil {
+		return "", err
+	}
+	return at.AccessToken, nil
+}
+
+// GetAccessToken 获取AppId对应的AccessToken, 优先读取缓存, 缓存未命中时向微信获取
+func (t *TokenUsecase) GetAccessToken(ctx context.Context, appId string) (*AccessToken, error) {
+	at, err := t.loadToken(ctx, appId)
+	if err != nil {
+		ctxLogger(ctx, t.log).Warn("load access token error", zap.String("appId", appId), zap.Error(err))
+	}
+	t.metrics.TokenCache(t.metricAppId(ctx, appId), at != nil)
+	if at != nil {
+		return at, nil
+	}
+
+	// 同一进程内的并发请求只向微信获取一次; 共享的刷新不受单个调用方取消影响,
+	// 调用方取消或超时后直接返回
+	ch := t.sf.DoChan(appId, func() (any, error) {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 2*tokenLockTTL)
+		defer cancel()
+		return t.refresh(ctx, appId, refreshIfMissing)
+	})
+	select {
+	case <-ctx.Done():
+		return nil, status.FromContextError(ctx.Err()).Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.(*AccessToken), nil
+	}
+}
+
+// GetClientAccessToken 调用方获取AppId对应的AccessToken, 调用方须在账号的Clients中
+func (t *TokenUsecase) GetClientAccessToken(ctx context.Context, appId string, clientId string) (*AccessToken, error) {
+	account, err := t.accounts.GetAccount(ctx, appId)
+	if err != nil {
+		return nil, err
+	}
+	if !account.AllowClient(clientId) {
+		ctxLogger(ctx, t.log).Warn("client not allowed to get access token", zap.String("appId", appId),
+			zap.String("clientId", clientId))
+		return nil, ErrClientNotAllowed
+	}
+
+	return t.GetAccessToken(ctx, appId)
+}
+
+// RefreshAccessToken 向微信获取新的AccessToken并写入缓存
+//
+// forceRefresh仅对使用stable_token的账号生效, 每日次数受ForceRefreshLimit限制.
+func (t *TokenUsecase) RefreshAccessToken(ctx context.Context, appId string, forceRefresh bool) (*AccessToken, error) {
+	mode := refreshAlways
+	if forceRefresh {
+		mode = refreshForce
+	}
+	return t.refresh(ctx, appId, mode)
+}
+
+// ForceRefreshUsage 今日已使用的stable_token强制刷新次数及上限
+func (t *TokenUsecase) ForceRefreshUsage(ctx context.Context, appId string) (used int64, limit int64, err error) {
+	used, err = t.rdb.Get(ctx, t.forceRefreshKey(appId)).Int64()
+	if errors.Is(err, redis.Nil) {
+		err = nil
+	}
+	return used, t.forceLimit, err
+}
+
+// refresh 在分布式锁保护下刷新AccessToken
+//
+// 多个副本同时刷新时只有获得锁的副本调用微信接口, 其他副本等待锁释放后读取缓存中的新AccessToken.
+// mode为refreshIfMissing时, 获得锁后缓存中已有AccessToken则直接使用.
+func (t *TokenUsecase) refresh(ctx context.Context, appId string, mode refreshMode) (*AccessToken, error) {
+	prev, _ := t.loadToken(ctx, appId)
+
+	for {
+		lock, err := t.locker.Obtain(ctx, t.lockKey(appId), tokenLockTTL)
+		if err == nil {
+			at, err := t.refreshLocked(ctx, appId, lock, mode)
+			if err := lock.Release(context.WithoutCancel(ctx)); err != nil {
+				ctxLogger(ctx, t.log).Warn("release token lock error", zap.String("appId", appId), zap.Error(err))
+			}
+			if !errors.Is(err, ErrLockLost) {
+				return at, err
+			}
+			ctxLogger(ctx, t.log).Warn("token lock lost while refreshing", zap.String("appId", appId))
+		} else if !errors.Is(err, ErrLockNotObtained) {
+			return nil, err
+		}
+
+		// 等待持锁者写入新的AccessToken
+		if err := t.locker.WaitRelease(ctx, t.lockKey(appId), tokenLockPollInterval); err != nil {
+			return nil, err
+		}
+		at, err := t.loadToken(ctx, appId)
+		if err != nil {
+			return nil, err
+		}
+		if at != nil && (prev == nil || at.AccessToken != prev.AccessToken) {
+			return at, nil
+		}
+	}
+}
+
+// refreshLocked 持有锁时刷新AccessToken, 写入缓存时校验fencing值
+func (t *TokenUsecase) refreshLocked(ctx context.Context, appId string, lock *Lock, mode refreshMode) (*AccessToken, error) {
+	if mode == refreshIfMissing {
+		if at, _ := t.loadToken(ctx, appId); at != nil {
+			return at, nil
+		}
+	}
+
+	account, err := t.accounts.GetAccount(ctx, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	force := mode == refreshForce && account.UseStableToken
+	if force {
+		if err := t.useForceRefresh(ctx, appId); err != nil {
+			return nil, err
+		}
+	}
+
+	at, err := t.fetch(ctx, account, force)
+	t.metrics.TokenRefreshed(appId, force, err)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.storeToken(ctx, lock, at); err != nil {
+		ctxLogger(ctx, t.log).Error("store access token error", zap.String("appId", appId), zap.Error(err))
+		if errors.Is(err, ErrLockLost) {
+			return nil, err
+		}
+	}
+	ctxLogger(ctx, t.log).Info("access token refreshed", zap.String("appId", appId), zap.Bool("force", force),
+		zap.Int64("expiresAt", at.ExpiresAt), zap.String("fence", lock.Fence()))
+
+	return at, nil
+}
+
+// InvalidateAccessToken 作废缓存的AccessToken
+//
+// 仅当缓存的AccessToken与token一致时删除, 避免删除其他请求刚刷新的AccessToken.
+func (t *TokenUsecase) InvalidateAccessToken(ctx context.Context, appId string, token string) error {
+	key := t.tokenKey(appId)
+
+	err := t.rdb.Watch(ctx, func(tx *redis.Tx) error {
+		val, err := tx.Get(ctx, key).Result()
+		if errors.Is(err, redis.Nil) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		at := &AccessToken{}
+		if err := json.Unmarshal([]byte(val), at); err != nil {
+			return err
+		}
+		if token != "" && at.AccessToken != token {
+			return nil
+		}
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Del(ctx, key)
+			return nil
+		})
+		return err
+	}, key)
+	if errors.Is(err, redis.TxFailedErr) {
+		// 缓存已被其他请求更新
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	ctxLogger(ctx, t.log).Info("access token invalidated", zap.String("appId", appId))
+	return nil
+}
+
+// useForceRefresh 占用一次今日的强制刷新次数
+func (t *TokenUsecase) useForceRefresh(ctx context.Context, appId string) error {
+	n, err := forceRefreshScript.Run(ctx, t.rdb, []string{t.forceRefreshKey(appId)},
+		t.forceLimit, int64((48 * time.Hour).Seconds())).Int64()
+	if err != nil {
+		return err
+	}
+	if n < 0 {
+		ctxLogger(ctx, t.log).Warn("stable token force refresh limit reached", zap.String("appId", appId),
+			zap.Int64("limit", t.forceLimit))
+		return ErrForceRefreshExhausted
+	}
+
+	ctxLogger(ctx, t.log).Info("stable token force refresh", zap.String("appId", appId),
+		zap.Int64("used", n), zap.Int64("limit", t.forceLimit))
+	return nil
+}
+
+// fetchToken 调用微信接口获取AccessToken
+func (t *TokenUsecase) fetchToken(ctx context.Context, account *Account, forceRefresh bool) (*AccessToken, error) {
+	if account.UseStableToken {
+		return t.fetchStableToken(ctx, account, forceRefresh)
+	}
+
+	url := fmt.Sprintf("https://%s%s?grant_type=client_credential&appid=%s&secret=%s",
+		domain.GetWXAPIDomain(),
+		pathAccessToken,
+		account.AppId,
+		account.AppSecret,
+	)
+
+	resp, err := t.wx.Get(upstreamCtx(ctx, account.AppId), url)
+	type resultT struct {
+		wxError.WXError
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	rt, err := decodeResponse[resultT](resp, err)
+	if err != nil {
+		ctxLogger(ctx, t.log).Error("fetch access token error", zap.String("appId", account.AppId), zap.Error(err))
+		return nil, err
+	}
+
+	return &AccessToken{
+		AppId:       account.AppId,
+		AccessToken: rt.AccessToken,
+		ExpiresAt:   time.Now().Unix() + rt.ExpiresIn,
+	}, nil
+}
+
+// fetchStableToken 调用stable_token接口获取AccessToken
+func (t *TokenUsecase) fetchStableToken(ctx context.Context, account *Account, forceRefresh bool) (*AccessToken, error) {
+	url := fmt.Sprintf("https://%s%s", domain.GetWXAPIDomain(), pathStableToken)
+
+	type stableTokenReq struct {
+		GrantType    string `json:"grant_type"`
+		AppId        string `json:"appid"`
+		Secret       string `json:"secret"`
+		ForceRefresh bool   `json:"force_refresh"`
+	}
+	reader, err := helpers.BuildRequestBody(stableTokenReq{
+		GrantType:    "client_credential",
+		AppId:        account.AppId,
+		Secret:       account.AppSecret,
+		ForceRefresh: forceRefresh,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.wx.Post(upstreamCtx(ctx, account.AppId), url, "application/json", reader)
+	type resultT struct {
+		wxError.WXError
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	rt, err := decodeResponse[resultT](resp, err)
+	if err != nil {
+		ctxLogger(ctx, t.log).Error("fetch stable token error", zap.String("appId", account.AppId), zap.Error(err))
+		return nil, err
+	}
+
+	return &AccessToken{
+		AppId:       account.AppId,
+		AccessToken: rt.AccessToken,
+		ExpiresAt:   time.Now().Unix() + rt.ExpiresIn,
+	}, nil
+}
+
+// upstreamCtx 请求token相关接口的上下文: 带上AppId用于链路追踪、指标和调用次数统计,
+// 并去掉调用方RPC的重试策略, 避免请求被重试或对冲; 每次获取都会使之前的token在5分钟后失效
+func upstreamCtx(ctx context.Context, appId string) context.Context {
+	ctx = context.WithValue(ctx, consts.AppIdKey, appId)
+	return context.WithValue(ctx, retryKey{}, (*retryState)(nil))
+}
+
+// loadToken 读取缓存, 未命中时返回nil, nil
+func (t *TokenUsecase) loadToken(ctx context.Context, appId string) (*AccessToken, error) {
+	val, err := t.rdb.Get(ctx, t.tokenKey(appId)).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	at := &AccessToken{}
+	if err := json.Unmarshal([]byte(val), at); err != nil {
+		return nil, err
+	}
+	return at, nil
+}
+
+// storeToken 持有锁时写入缓存
+func (t *TokenUsecase) storeToken(ctx context.Context, lock *Lock, at *AccessToken) error {
+	ttl := time.Until(time.Unix(at.ExpiresAt, 0)) - t.ahead
+	if ttl <= 0 {
+		return nil
+	}
+
+	val, err := json.Marshal(at)
+	if err != nil {
+		return err
+	}
+	return lock.Set(ctx, t.tokenKey(at.AppId), val, ttl)
+}
+
+func (t *TokenUsecase) tokenKey(appId string) string {
+	return t.prefix + ":access_token:" + appId
+}
+
+func (t *TokenUsecase) lockKey(appId string) string {
+	return t.prefix + ":access_token_lock:" + appId
+}
+
+// forceRefreshKey 按日计数的强制刷新次数
+func (t *TokenUsecase) forceRefreshKey(appId string) string {
+	return t.prefix + ":force_refresh:" + appId + ":" + time.Now().In(chinaTZ).Format("20060102")
+}
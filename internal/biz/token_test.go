@@ -0,0 +1,272 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/seth16888/wxcommon/domain"
+	"github.com/seth16888/wxproxy/internal/config"
+	"github.com/seth16888/wxproxy/internal/metrics"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.uber.org/zap"
+)
+
+const testAppId = "wx0000000000000001"
+
+// newTestRedis 使用miniredis模拟的Redis
+func newTestRedis(t *testing.T) (*miniredis.Miniredis, redis.UniversalClient) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	return mr, rdb
+}
+
+// newTestTokenUsecase 模拟一个副本, fetch统计向微信获取AccessToken的次数
+func newTestTokenUsecase(rdb redis.UniversalClient, fetches *atomic.Int32) *TokenUsecase {
+	accounts := NewAccountUsecase([]*config.Account{{AppId: testAppId, AppSecret: "secret"}},
+		nil, rdb, zap.NewNop())
+	t := NewTokenUsecase(nil, rdb, accounts, nil, nil, nil, nil, nil, zap.NewNop())
+	t.fetch = func(ctx context.Context, account *Account, _ bool) (*AccessToken, error) {
+		n := fetches.Add(1)
+		// 模拟微信接口耗时, 让其他副本在此期间争抢锁
+		time.Sleep(50 * time.Millisecond)
+		return &AccessToken{
+			AppId:       account.AppId,
+			AccessToken: "token-" + strconv.Itoa(int(n)),
+			ExpiresAt:   time.Now().Add(2 * time.Hour).Unix(),
+		}, nil
+	}
+	return t
+}
+
+func TestRefreshConcurrentReplicas(t *testing.T) {
+	_, rdb := newTestRedis(t)
+
+	const replicas = 20
+	var (
+		fetches atomic.Int32
+		wg      sync.WaitGroup
+		tokens  = make([]string, replicas)
+		errs    = make([]error, replicas)
+	)
+	start := make(chan struct{})
+	for i := range replicas {
+		uc := newTestTokenUsecase(rdb, &fetches)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			at, err := uc.refresh(context.Background(), testAppId, refreshIfMissing)
+			errs[i] = err
+			if at != nil {
+				tokens[i] = at.AccessToken
+			}
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	if n := fetches.Load(); n != 1 {
+		t.Fatalf("upstream fetches = %d, want 1", n)
+	}
+	for i := range replicas {
+		if errs[i] != nil {
+			t.Fatalf("replica %d: %v", i, errs[i])
+		}
+		if tokens[i] != "token-1" {
+			t.Fatalf("replica %d got token %q, want token-1", i, tokens[i])
+		}
+	}
+}
+
+func TestLockSetStaleFence(t *testing.T) {
+	mr, rdb := newTestRedis(t)
+	ctx := context.Background()
+	locker := NewRedisLocker(rdb)
+
+	stale, err := locker.Obtain(ctx, "lock", time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := locker.Obtain(ctx, "lock", time.Second); !errors.Is(err, ErrLockNotObtained) {
+		t.Fatalf("second obtain: err = %v, want ErrLockNotObtained", err)
+	}
+
+	// 锁过期后被其他持有者获取
+	mr.FastForward(2 * time.Second)
+	current, err := locker.Obtain(ctx, "lock", time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if current.Fence() == stale.Fence() {
+		t.Fatalf("fence not incremented: %s", current.Fence())
+	}
+
+	if err := stale.Set(ctx, "value", "stale", time.Minute); !errors.Is(err, ErrLockLost) {
+		t.Fatalf("stale set: err = %v, want ErrLockLost", err)
+	}
+	if err := current.Set(ctx, "value", "current", time.Minute); err != nil {
+		t.Fatalf("current set: %v", err)
+	}
+	if v, _ := mr.Get("value"); v != "current" {
+		t.Fatalf("value = %q, want current", v)
+	}
+	if err := stale.Release(ctx); !errors.Is(err, ErrLockLost) {
+		t.Fatalf("stale release: err = %v, want ErrLockLost", err)
+	}
+}
+
+func TestRefreshWaitsForHolder(t *testing.T) {
+	_, rdb := newTestRedis(t)
+	ctx := context.Background()
+
+	var fetches atomic.Int32
+	reader := newTestTokenUsecase(rdb, &fetches)
+
+	// 其他副本持有刷新锁
+	holder, err := NewRedisLocker(rdb).Obtain(ctx, reader.lockKey(testAppId), tokenLockTTL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type result struct {
+		at  *AccessToken
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		at, err := reader.refresh(ctx, testAppId, refreshIfMissing)
+		done <- result{at, err}
+	}()
+
+	// 持锁者写入新的AccessToken后释放锁
+	time.Sleep(3 * tokenLockPollInterval)
+	select {
+	case r := <-done:
+		t.Fatalf("refresh returned before the lock was released: %+v", r)
+	default:
+	}
+	val, _ := json.Marshal(&AccessToken{
+		AppId:       testAppId,
+		AccessToken: "holder-token",
+		ExpiresAt:   time.Now().Add(2 * time.Hour).Unix(),
+	})
+	if err := holder.Set(ctx, reader.tokenKey(testAppId), val, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if err := holder.Release(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case r := <-done:
+		if r.err != nil {
+			t.Fatal(r.err)
+		}
+		if r.at.AccessToken != "holder-token" {
+			t.Fatalf("token = %q, want holder-token", r.at.AccessToken)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("refresh did not return after the lock was released")
+	}
+	if n := fetches.Load(); n != 0 {
+		t.Fatalf("upstream fetches = %d, want 0", n)
+	}
+}
+
+func TestMetricAppId(t *testing.T) {
+	mr, rdb := newTestRedis(t)
+	ctx := context.Background()
+
+	const redisAppId = "wx0000000000000002"
+	mr.HSet("wxproxy:accounts", redisAppId, `{"app_secret":"secret"}`)
+
+	uc := newTestTokenUsecase(rdb, new(atomic.Int32))
+	uc.metrics = metrics.NewMetrics(nil)
+
+	tests := map[string]string{
+		testAppId:           testAppId,
+		redisAppId:          redisAppId,
+		"wx-not-registered": metrics.UnknownAppId,
+		"":                  metrics.UnknownAppId,
+	}
+	for appId, want := range tests {
+		if got := uc.metricAppId(ctx, appId); got != want {
+			t.Errorf("metricAppId(%q) = %q, want %q", appId, got, want)
+		}
+	}
+}
+
+func TestTokenFetchUpstream(t *testing.T) {
+	sr := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr)))
+
+	_, rdb := newTestRedis(t)
+	accounts := NewAccountUsecase([]*config.Account{{AppId: testAppId, AppSecret: "secret"}},
+		nil, rdb, zap.NewNop())
+	breakers := NewBreakerGroup(&config.Breaker{FailureThreshold: 2, HalfOpenProbes: 1}, &fakeHealth{}, zap.NewNop())
+	m := metrics.NewMetrics(nil)
+	uc := NewTokenUsecase(NewHttpClient(), rdb, accounts, nil, breakers, nil, nil, m, zap.NewNop())
+	account, err := accounts.GetAccount(context.Background(), testAppId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 调用方RPC可以重试和对冲, 获取token的请求不继承其重试策略
+	ctx := upstreamCtx(readCtx(context.Background()), testAppId)
+	if retryFromContext(ctx) != nil || canHedge(ctx) {
+		t.Fatal("token request inherits caller retry policy")
+	}
+
+	// 已取消的请求不访问网络, 但经过链路追踪和指标
+	ctx, cancel := context.WithCancel(readCtx(context.Background()))
+	cancel()
+	if _, err := uc.fetchToken(ctx, account, false); err == nil {
+		t.Fatal("fetchToken with canceled context succeeded")
+	}
+
+	spans := sr.Ended()
+	if len(spans) != 1 || spans[0].Name() != "wechat "+pathAccessToken {
+		t.Fatalf("spans = %v, want one %q span", spans, "wechat "+pathAccessToken)
+	}
+	var gotAppId bool
+	for _, attr := range spans[0].Attributes() {
+		if strings.Contains(attr.Value.Emit(), "secret") {
+			t.Fatalf("span attribute %s leaks secret: %s", attr.Key, attr.Value.Emit())
+		}
+		gotAppId = gotAppId || attr.Key == "wx.app_id" && attr.Value.AsString() == testAppId
+	}
+	if !gotAppId {
+		t.Fatal("span has no wx.app_id attribute")
+	}
+
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	want := `wxproxy_upstream_requests_total{app_id="` + testAppId + `",errcode="canceled",path="` + pathAccessToken + `"} 1`
+	if !strings.Contains(rec.Body.String(), want) {
+		t.Fatalf("metrics missing %s", want)
+	}
+
+	// 熔断时不发出请求
+	trip(t, breakers.Get(domain.GetWXAPIDomain(), pathAccessToken))
+	if _, err := uc.fetchToken(context.Background(), account, false); !isBreakerOpen(err) {
+		t.Fatalf("err = %v, want breaker open", err)
+	}
+	if n := len(sr.Ended()); n != 1 {
+		t.Fatalf("spans = %d, want 1", n)
+	}
+}
@@ -1,10 +1,25 @@
 package bootstrap
 
 import (
+	"context"
+
 	"github.com/seth16888/wxproxy/internal/di"
 	"github.com/seth16888/wxproxy/internal/server"
 )
 
 func StartApp() error {
-  return server.Start(di.DI)
+  ctx, cancel := context.WithCancel(context.Background())
+
+  // 后台续期AccessToken, 服务停止后等待续期任务退出
+  renewDone := make(chan struct{})
+  go func() {
+    defer close(renewDone)
+    di.DI.Renewer.Run(ctx)
+  }()
+
+  err := server.Start(di.DI)
+  cancel()
+  <-renewDone
+
+  return err
 }
@@ -3,20 +3,61 @@ package config
 import (
 	"fmt"
 
+	"github.com/go-viper/mapstructure/v2"
 	"github.com/seth16888/wxcommon/logger"
 
 	"github.com/spf13/viper"
 )
 
 type Bootstrap struct {
-	Server   *Server                  `yaml:"server"`
-	Log      *logger.LogConfig        `yaml:"log"`
-	Redis    *Redis                   `yaml:"redis"`
+	Server    *Server           `yaml:"server"`
+	Log       *logger.LogConfig `yaml:"log"`
+	Redis     *Redis            `yaml:"redis"`
+	Token     *Token            `yaml:"token"`
+	Accounts  []*Account        `yaml:"accounts"`
+	RateLimit *RateLimit        `yaml:"rate_limit"`
+	Breaker   *Breaker          `yaml:"breaker"`
+	Upstream  *Upstream         `yaml:"upstream"`
+	Retry     *Retry            `yaml:"retry"`
+	Redact    *Redact           `yaml:"redact"`
+	Trace     *Trace            `yaml:"trace"`
+	Auth      *Auth             `yaml:"auth"`
 }
 
 type Server struct {
-	Addr    string `yaml:"addr"`
-	Timeout int    `yaml:"timeout"`
+	Addr string `yaml:"addr"`
+	// Timeout 一元RPC的处理超时(秒), 默认10
+	Timeout int `yaml:"timeout"`
+	// StreamTimeout 流式RPC的总超时(秒), 默认300
+	StreamTimeout int `yaml:"stream_timeout"`
+	// StreamIdleTimeout 流式RPC两次收发消息之间的最长间隔(秒), 默认60
+	StreamIdleTimeout int `yaml:"stream_idle_timeout"`
+	// Methods 按gRPC方法覆盖的超时
+	Methods []*MethodTimeout `yaml:"methods"`
+	// HTTPAddr HTTP/JSON网关的监听地址, 如0.0.0.0:9012, 为空时不开启
+	HTTPAddr string `yaml:"http_addr"`
+	// MetricsAddr Prometheus指标的HTTP监听地址(路径/metrics), 如0.0.0.0:9011, 为空时不开启
+	MetricsAddr string `yaml:"metrics_addr"`
+	// TLS gRPC服务的TLS配置, 为空时不使用TLS
+	TLS *TLS `yaml:"tls"`
+}
+
+// TLS 服务端证书, 配置ClientCAFile时校验客户端提供的证书(mTLS)
+type TLS struct {
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+	// ClientCAFile 签发客户端证书的CA(PEM)
+	ClientCAFile string `yaml:"client_ca_file"`
+}
+
+// MethodTimeout gRPC方法的超时, 客户端设置了更短的截止时间时以客户端为准
+type MethodTimeout struct {
+	// Method gRPC方法名, 如GetMaterialList
+	Method string `yaml:"method"`
+	// Timeout 处理超时(秒), 流式RPC为总超时
+	Timeout int `yaml:"timeout"`
+	// IdleTimeout 流式RPC两次收发消息之间的最长间隔(秒)
+	IdleTimeout int `yaml:"idle_timeout"`
 }
 
 type Redis struct {
@@ -28,6 +69,146 @@ type Redis struct {
 	WriteTimeout int    `yaml:"write_timeout"`
 }
 
+// Token AccessToken托管配置
+type Token struct {
+	// KeyPrefix Redis缓存key前缀
+	KeyPrefix string `yaml:"key_prefix"`
+	// RefreshAhead 提前过期时间(秒), 避免临近过期的token被下发
+	RefreshAhead int `yaml:"refresh_ahead"`
+	// ForceRefreshLimit stable_token每日强制刷新次数上限, 默认20
+	ForceRefreshLimit int `yaml:"force_refresh_limit"`
+	// RenewInterval 后台续期的扫描间隔(秒), 默认60, 小于0时不启动后台续期
+	RenewInterval int `yaml:"renew_interval"`
+	// RenewBefore AccessToken距过期不足该时间(秒)时由后台续期, 默认900
+	RenewBefore int `yaml:"renew_before"`
+}
+
+// Account 公众号账号, 也可以通过Redis注册
+type Account struct {
+	AppId     string `yaml:"app_id"`
+	AppSecret string `yaml:"app_secret"`
+	// UseStableToken 使用stable_token接口获取AccessToken
+	UseStableToken bool `yaml:"use_stable_token"`
+	// Clients 允许通过GetAccessToken获取AccessToken的调用方
+	Clients []string `yaml:"clients"`
+}
+
+// RateLimit 调用频率限制配置, 令牌桶状态保存在Redis中, 多副本共享
+type RateLimit struct {
+	Enabled bool             `yaml:"enabled"`
+	Rules   []*RateLimitRule `yaml:"rules"`
+}
+
+// RateLimitRule 频率限制规则
+//
+// AppId、Method、Client、Path为空时不区分该维度, 为"*"时每个取值单独限流, 其他值只匹配该取值.
+// 配置了Path的规则在每次请求微信接口时检查, 其他规则在每次gRPC请求时检查. 请求须通过所有匹配的规则.
+type RateLimitRule struct {
+	AppId string `yaml:"app_id"`
+	// Method gRPC方法名, 如SendCustomMessage或/api.wxproxy.v1.Mpproxy/SendCustomMessage
+	Method string `yaml:"method"`
+	// Client 调用方, 取自metadata x-client-id, 开启认证时为认证后的调用方
+	Client string `yaml:"client"`
+	// Path 微信接口路径, 如/cgi-bin/message/custom/send
+	Path string `yaml:"path"`
+	// Rate 每秒生成的令牌数
+	Rate float64 `yaml:"rate"`
+	// Burst 令牌桶容量, 默认为Rate
+	Burst int `yaml:"burst"`
+}
+
+// Breaker 微信接口熔断配置, 按上游域名和接口分组熔断
+type Breaker struct {
+	// Disabled 关闭熔断
+	Disabled bool `yaml:"disabled"`
+	// FailureThreshold 连续失败多少次后熔断, 默认5
+	FailureThreshold int `yaml:"failure_threshold"`
+	// OpenTimeout 熔断多久(秒)后进入半开状态, 默认30
+	OpenTimeout int `yaml:"open_timeout"`
+	// HalfOpenProbes 半开状态允许的并发探测请求数, 默认1
+	HalfOpenProbes int `yaml:"half_open_probes"`
+}
+
+// Upstream 微信接口域名配置
+type Upstream struct {
+	// Domains 依次尝试的域名, 如api.weixin.qq.com、api2.weixin.qq.com、sh.api.weixin.qq.com,
+	// 默认只使用api.weixin.qq.com
+	Domains []string `yaml:"domains"`
+	// FailureCooldown 域名请求失败后降低优先级的时长(秒), 默认30
+	FailureCooldown int `yaml:"failure_cooldown"`
+	// SlowThreshold 平均耗时超过该值(毫秒)的域名降低优先级, 默认2000
+	SlowThreshold int `yaml:"slow_threshold"`
+	// HedgeDelay 幂等方法(或携带幂等键)的请求超过该时间(毫秒)未返回时向下一个域名发送对冲请求, 为0时不开启
+	HedgeDelay int `yaml:"hedge_delay"`
+}
+
+// Retry 微信接口重试策略, 网络错误、HTTP 5xx和系统繁忙(-1)时按指数退避和随机抖动重试
+type Retry struct {
+	// MaxAttempts 最多尝试次数(含首次), 默认3, 为1时不重试
+	MaxAttempts int `yaml:"max_attempts"`
+	// InitialBackoff 首次重试前的最大等待时间(毫秒), 默认100
+	InitialBackoff int `yaml:"initial_backoff"`
+	// MaxBackoff 重试等待时间上限(毫秒), 默认2000
+	MaxBackoff int `yaml:"max_backoff"`
+	// Multiplier 重试等待时间的增长倍数, 默认2
+	Multiplier float64 `yaml:"multiplier"`
+	// Methods 按gRPC方法覆盖的策略
+	Methods []*RetryMethod `yaml:"methods"`
+}
+
+// RetryMethod gRPC方法的重试策略
+type RetryMethod struct {
+	// Method gRPC方法名, 如SendTplMsg
+	Method string `yaml:"method"`
+	// MaxAttempts 最多尝试次数(含首次)
+	MaxAttempts int `yaml:"max_attempts"`
+	// Idempotent 是否幂等, 未配置时只有查询类方法(如GetMemberInfo、BatchGetMemberInfo)视为幂等;
+	// 非幂等的方法只在请求携带metadata x-idempotency-key时重试和换域名
+	Idempotent *bool `yaml:"idempotent"`
+}
+
+// Redact 日志脱敏配置, AccessToken、AppSecret等密钥始终脱敏
+type Redact struct {
+	// KeepOpenId 不脱敏openid, 默认脱敏
+	KeepOpenId bool `yaml:"keep_openid"`
+	// KeepContent 不脱敏消息内容, 默认脱敏
+	KeepContent bool `yaml:"keep_content"`
+	// Fields 额外需要脱敏的字段名, 同时匹配proto字段、JSON字段和URL参数, 不区分大小写和下划线
+	Fields []string `yaml:"fields"`
+}
+
+// Trace OpenTelemetry链路追踪配置
+type Trace struct {
+	// Exporter 导出方式: otlp、stdout或none, 默认none
+	Exporter string `yaml:"exporter"`
+	// Endpoint OTLP gRPC地址, 如127.0.0.1:4317, 默认读取环境变量OTEL_EXPORTER_OTLP_ENDPOINT
+	Endpoint string `yaml:"endpoint"`
+	// Insecure OTLP不使用TLS
+	Insecure bool `yaml:"insecure"`
+	// SampleRatio 采样比例(0, 1], 默认1; 请求已带有采样决定时沿用上游的决定
+	SampleRatio float64 `yaml:"sample_ratio"`
+	// ServiceName 服务名, 默认wxproxy
+	ServiceName string `yaml:"service_name"`
+}
+
+// Auth 调用方认证配置
+type Auth struct {
+	// Enabled 开启认证, 未携带有效API Key或客户端证书的请求返回Unauthenticated
+	Enabled bool `yaml:"enabled"`
+	// APIKeys 静态API Key, 也可以注册到Redis: HSET {key_prefix}:api_keys <hash> <client>
+	APIKeys []*APIKey `yaml:"api_keys"`
+	// AdminClients 允许调用管理接口(RefreshAccessToken、ClearQuota、ClearQuotaV2)的调用方, 须经过认证
+	AdminClients []string `yaml:"admin_clients"`
+}
+
+// APIKey 调用方的API Key, 只保存哈希值
+type APIKey struct {
+	// Client 调用方标识, 认证后作为x-client-id用于日志、频率限制和账号的clients
+	Client string `yaml:"client"`
+	// Hash API Key的SHA-256, 十六进制
+	Hash string `yaml:"hash"`
+}
+
 func ReadConfigFromFile(file string) *Bootstrap {
 	if file == "" {
 		file = "conf.yaml"
@@ -47,7 +228,10 @@ func ReadConfigFromFile(file string) *Bootstrap {
 	}
 
 	confVar := &Bootstrap{}
-	if err := viper.Unmarshal(confVar); err != nil {
+	// 使用yaml tag映射配置项
+	if err := viper.Unmarshal(confVar, func(dc *mapstructure.DecoderConfig) {
+		dc.TagName = "yaml"
+	}); err != nil {
 		panic(err)
 	}
 
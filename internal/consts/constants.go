@@ -3,4 +3,14 @@ package consts
 const (
 	RequestIdKey = "X-Request-ID"
 	AppIdKey     = "appId"
+	ClientIdKey  = "x-client-id"
+
+	// IdempotencyKey 幂等键, 携带时非幂等的方法也可重试
+	IdempotencyKey = "x-idempotency-key"
+	// AttemptsKey 响应trailer中微信接口的尝试次数
+	AttemptsKey = "x-attempts"
+	// APIKeyKey 调用方的API Key
+	APIKeyKey = "x-api-key"
+	// AuthClientKey 上下文中经认证(API Key或mTLS)的调用方, 不来自metadata
+	AuthClientKey = "authClient"
 )
@@ -1,44 +1,114 @@
 package di
 
 import (
+	"github.com/redis/go-redis/extra/redisotel/v9"
 	"github.com/seth16888/wxcommon/hc"
 	"github.com/seth16888/wxcommon/logger"
 	"github.com/seth16888/wxcommon/redis"
 
 	"github.com/seth16888/wxproxy/internal/biz"
 	"github.com/seth16888/wxproxy/internal/config"
+	"github.com/seth16888/wxproxy/internal/metrics"
+	"github.com/seth16888/wxproxy/internal/redact"
+	"github.com/seth16888/wxproxy/internal/tracing"
 
 	"github.com/seth16888/wxproxy/internal/service"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/health"
 )
 
 var DI *Container
 
 type Container struct {
-	Conf *config.Bootstrap
-	Log  *zap.Logger
-	Svc  *service.MPProxyService
-  Redis *redis.RedisClient
+	Conf  *config.Bootstrap
+	Log   *zap.Logger
+	Svc   *service.MPProxyService
+	Redis *redis.RedisClient
+	Token *biz.TokenUsecase
+	Renewer *biz.TokenRenewer
+	Health *health.Server
+	Limiter *biz.RateLimiter
+	Retry *biz.RetryPolicies
+	Metrics *metrics.Metrics
+	// Auth 调用方认证, 未开启时为nil
+	Auth *biz.APIKeyUsecase
+	// TraceShutdown 关闭链路追踪, 导出剩余的span
+	TraceShutdown tracing.Shutdown
 }
 
 func NewContainer(configFile string) *Container {
   conf:= config.ReadConfigFromFile(configFile)
   log := logger.InitLogger(conf.Log)
+  // 日志脱敏
+  redactor := redact.New(conf.Redact)
+  log = log.WithOptions(zap.WrapCore(func(c zapcore.Core) zapcore.Core {
+    return redact.NewCore(c, redactor)
+  }))
 
   redis.ConnectRedis(conf.Redis.Addr, conf.Redis.Username,
     conf.Redis.Password, conf.Redis.DB, log)
 
+  // 链路追踪
+  traceShutdown, err := tracing.Init(conf.Trace)
+  if err != nil {
+    log.Fatal("init tracing error", zap.Error(err))
+  }
+  if conf.Trace != nil && conf.Trace.Exporter != "" && conf.Trace.Exporter != tracing.ExporterNone {
+    // 不记录Redis命令, 命令参数中有AccessToken和API Key哈希
+    if err := redisotel.InstrumentTracing(redis.Redis.Client, redisotel.WithDBStatement(false)); err != nil {
+      log.Fatal("instrument redis tracing error", zap.Error(err))
+    }
+  }
+
+  // Prometheus指标, 未配置监听地址时为nil
+  var m *metrics.Metrics
+  if conf.Server != nil && conf.Server.MetricsAddr != "" {
+    m = metrics.NewMetrics(redis.Redis.Client)
+  }
+
   hc := hc.NewClient(hc.DefaultTimeout, hc.DefaultIdleConnTimeout, hc.CommonCheckRedirect)
 
-  uc := biz.NewMPProxyUsecase(hc, log)
+  accounts := biz.NewAccountUsecase(conf.Accounts, conf.Token, redis.Redis.Client, log)
+
+  healthSvc := health.NewServer()
+  quota := biz.NewQuotaCounter(redis.Redis.Client, accounts, conf.Token, log)
+  breakers := biz.NewBreakerGroup(conf.Breaker, healthSvc, log)
+  domains := biz.NewDomainPool(conf.Upstream, healthSvc, log)
+  token := biz.NewTokenUsecase(hc, redis.Redis.Client, accounts, quota, breakers, domains, conf.Token, m, log)
+  renewer := biz.NewTokenRenewer(token, healthSvc, conf.Token, log)
 
-  svc := service.NewMPProxyService(uc, log)
+  limiter := biz.NewRateLimiter(redis.Redis.Client, conf.RateLimit, conf.Token, log)
+
+  retry := biz.NewRetryPolicies(conf.Retry)
+
+  // 调用方认证
+  var auth *biz.APIKeyUsecase
+  if conf.Auth != nil && conf.Auth.Enabled {
+    auth = biz.NewAPIKeyUsecase(conf.Auth, conf.Token, redis.Redis.Client, log)
+  }
+
+  uc := biz.NewMPProxyUsecase(hc, token, quota, limiter, breakers, domains, m, log)
+
+  var admins []string
+  if conf.Auth != nil {
+    admins = conf.Auth.AdminClients
+  }
+  svc := service.NewMPProxyService(uc, token, admins, log)
 
 	DI = &Container{
     Conf: conf,
     Log: log,
     Svc: svc,
     Redis: redis.Redis,
+    Token: token,
+    Renewer: renewer,
+    Health: healthSvc,
+    Limiter: limiter,
+    Retry: retry,
+    Metrics: m,
+    Auth: auth,
+    TraceShutdown: traceShutdown,
   }
 	return DI
 }
@@ -0,0 +1,134 @@
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/redis/go-redis/v9"
+)
+
+const namespace = "wxproxy"
+
+// UnknownAppId 未注册的AppId在app_id标签中的取值
+const UnknownAppId = "unknown"
+
+// Metrics Prometheus指标
+//
+// 所有方法对nil安全, 未开启指标时可传入nil.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	rpcRequests *prometheus.CounterVec
+	rpcDuration *prometheus.HistogramVec
+
+	upstreamRequests *prometheus.CounterVec
+	upstreamDuration *prometheus.HistogramVec
+
+	tokenRefreshes *prometheus.CounterVec
+	tokenCache     *prometheus.CounterVec
+}
+
+// NewMetrics 创建指标并注册Go运行时、进程和Redis连接池指标, rdb为nil时不注册Redis连接池指标
+func NewMetrics(rdb redis.UniversalClient) *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		rpcRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "rpc_requests_total",
+			Help:      "gRPC请求数",
+		}, []string{"method", "code"}),
+		rpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "rpc_duration_seconds",
+			Help:      "gRPC请求耗时",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "code"}),
+		upstreamRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "upstream_requests_total",
+			Help:      "微信接口请求数, errcode为微信返回的错误码, 网络错误为error, HTTP错误为http_{status}",
+		}, []string{"path", "app_id", "errcode"}),
+		upstreamDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "upstream_duration_seconds",
+			Help:      "微信接口请求耗时",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"path", "app_id"}),
+		tokenRefreshes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "token_refreshes_total",
+			Help:      "向微信获取AccessToken的次数",
+		}, []string{"app_id", "force", "result"}),
+		tokenCache: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "token_cache_requests_total",
+			Help:      "读取AccessToken缓存的次数, result为hit或miss",
+		}, []string{"app_id", "result"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.rpcRequests, m.rpcDuration,
+		m.upstreamRequests, m.upstreamDuration,
+		m.tokenRefreshes, m.tokenCache,
+	)
+	if rdb != nil {
+		m.registry.MustRegister(newRedisPoolCollector(rdb))
+	}
+	return m
+}
+
+// Handler 指标的HTTP处理器
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// ObserveRPC 记录gRPC请求, code为gRPC状态码
+func (m *Metrics) ObserveRPC(method, code string, d time.Duration) {
+	if m == nil {
+		return
+	}
+	m.rpcRequests.WithLabelValues(method, code).Inc()
+	m.rpcDuration.WithLabelValues(method, code).Observe(d.Seconds())
+}
+
+// ObserveUpstream 记录一次微信接口请求
+func (m *Metrics) ObserveUpstream(path, appId, errcode string, d time.Duration) {
+	if m == nil {
+		return
+	}
+	m.upstreamRequests.WithLabelValues(path, appId, errcode).Inc()
+	m.upstreamDuration.WithLabelValues(path, appId).Observe(d.Seconds())
+}
+
+// TokenRefreshed 记录一次向微信获取AccessToken
+func (m *Metrics) TokenRefreshed(appId string, force bool, err error) {
+	if m == nil {
+		return
+	}
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+	forceLabel := "false"
+	if force {
+		forceLabel = "true"
+	}
+	m.tokenRefreshes.WithLabelValues(appId, forceLabel, result).Inc()
+}
+
+// TokenCache 记录一次读取AccessToken缓存
+func (m *Metrics) TokenCache(appId string, hit bool) {
+	if m == nil {
+		return
+	}
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	m.tokenCache.WithLabelValues(appId, result).Inc()
+}
@@ -0,0 +1,121 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/seth16888/wxproxy/internal/consts"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const accessTokenField = "AccessToken"
+
+// TokenSource 根据AppId获取AccessToken
+type TokenSource interface {
+	AccessToken(ctx context.Context, appId string) (string, error)
+}
+
+// AppTokenInterceptor AccessToken托管拦截器
+//
+// AppId取自请求字段AppId或metadata(appid), 并写入上下文(consts.AppIdKey).
+// 请求未携带AccessToken时, 使用AppId从TokenSource获取并填充到请求的AccessToken字段;
+// 已携带AccessToken的请求保持原样.
+func AppTokenInterceptor(ts TokenSource) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		ctx, err := injectAccessToken(ctx, req, ts)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// AppTokenStreamInterceptor AppTokenInterceptor的流式版本, 在接收请求消息时填充AccessToken
+func AppTokenStreamInterceptor(ts TokenSource) grpc.StreamServerInterceptor {
+	return func(srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return handler(srv, &appTokenStream{ServerStream: ss, ctx: ss.Context(), ts: ts})
+	}
+}
+
+type appTokenStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	ts  TokenSource
+}
+
+func (s *appTokenStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *appTokenStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	ctx, err := injectAccessToken(s.ctx, m, s.ts)
+	if err != nil {
+		return err
+	}
+	s.ctx = ctx
+
+	return nil
+}
+
+// injectAccessToken 解析AppId并按需填充AccessToken
+func injectAccessToken(ctx context.Context, req any, ts TokenSource) (context.Context, error) {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return ctx, nil
+	}
+
+	appId := appIdFromRequest(ctx, req)
+	if appId == "" {
+		return ctx, nil
+	}
+	ctx = context.WithValue(ctx, consts.AppIdKey, appId)
+
+	m := msg.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName(accessTokenField)
+	if fd == nil || fd.Kind() != protoreflect.StringKind || m.Get(fd).String() != "" {
+		return ctx, nil
+	}
+
+	token, err := ts.AccessToken(ctx, appId)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return ctx, err
+		}
+		return ctx, status.Errorf(codes.Unavailable, "get access token for %s: %v", appId, err)
+	}
+	m.Set(fd, protoreflect.ValueOfString(token))
+
+	return ctx, nil
+}
+
+// appIdFromRequest 请求字段AppId优先, 其次是metadata
+func appIdFromRequest(ctx context.Context, req any) string {
+	if r, ok := req.(interface{ GetAppId() string }); ok && r.GetAppId() != "" {
+		return r.GetAppId()
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get(consts.AppIdKey); len(vals) > 0 {
+			return vals[0]
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,141 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+
+	v1 "github.com/seth16888/wxproxy/api/v1"
+	"github.com/seth16888/wxproxy/internal/consts"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// healthMethodPrefix 健康检查不需要认证
+const healthMethodPrefix = "/grpc.health.v1.Health/"
+
+// Authenticator 校验API Key, 返回调用方标识
+type Authenticator interface {
+	Authenticate(ctx context.Context, apiKey string) (string, error)
+}
+
+// Auth 调用方认证拦截器
+//
+// 依次使用metadata中的API Key(x-api-key)和mTLS客户端证书认证, 认证通过后将调用方标识
+// 写入上下文(consts.ClientIdKey和consts.AuthClientKey), 覆盖metadata中自报的x-client-id.
+// 须位于ClientID之后. 未认证时返回UNAUTHENTICATED, 健康检查除外.
+func Auth(a Authenticator, log *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		ctx, err := authenticate(ctx, a, log, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// AuthStream Auth的流式版本, 在建立流时认证
+func AuthStream(a Authenticator, log *zap.Logger) grpc.StreamServerInterceptor {
+	return func(srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx, err := authenticate(ss.Context(), a, log, info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func authenticate(ctx context.Context, a Authenticator, log *zap.Logger, method string) (context.Context, error) {
+	if strings.HasPrefix(method, healthMethodPrefix) {
+		return ctx, nil
+	}
+
+	if apiKey := apiKeyFromContext(ctx); apiKey != "" {
+		clientId, err := a.Authenticate(ctx, apiKey)
+		if err != nil {
+			if _, ok := status.FromError(err); ok {
+				authFailed(ctx, log, method, "invalid api key")
+				return ctx, err
+			}
+			return ctx, status.Errorf(codes.Unavailable, "authenticate api key: %v", err)
+		}
+		return withAuthClient(ctx, clientId), nil
+	}
+
+	if clientId := peerCertIdentity(ctx); clientId != "" {
+		return withAuthClient(ctx, clientId), nil
+	}
+
+	authFailed(ctx, log, method, "missing credentials")
+	return ctx, v1.ErrorUnauthenticated("api key or client certificate required")
+}
+
+// withAuthClient 写入认证后的调用方
+func withAuthClient(ctx context.Context, clientId string) context.Context {
+	ctx = context.WithValue(ctx, consts.ClientIdKey, clientId)
+	return context.WithValue(ctx, consts.AuthClientKey, clientId)
+}
+
+// apiKeyFromContext metadata中的API Key
+func apiKeyFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if vals := md.Get(consts.APIKeyKey); len(vals) > 0 {
+		return vals[0]
+	}
+	return ""
+}
+
+// peerCertIdentity 经过校验的客户端证书的标识, 依次取Subject CN、DNS SAN和URI SAN
+func peerCertIdentity(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.PeerCertificates) == 0 {
+		return ""
+	}
+
+	cert := info.State.PeerCertificates[0]
+	switch {
+	case cert.Subject.CommonName != "":
+		return cert.Subject.CommonName
+	case len(cert.DNSNames) > 0:
+		return cert.DNSNames[0]
+	case len(cert.URIs) > 0:
+		return cert.URIs[0].String()
+	}
+	return ""
+}
+
+// authFailed 记录认证失败的请求
+func authFailed(ctx context.Context, log *zap.Logger, method, reason string) {
+	fields := []zap.Field{
+		zap.String("method", method),
+		zap.String("reason", reason),
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		fields = append(fields, zap.String("peer", p.Addr.String()))
+	}
+	if requestID, ok := ctx.Value(consts.RequestIdKey).(string); ok {
+		fields = append(fields, zap.String("requestId", requestID))
+	}
+	log.Warn("unauthenticated", fields...)
+}
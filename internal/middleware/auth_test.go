@@ -0,0 +1,217 @@
+package middleware
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"net"
+	"net/url"
+	"testing"
+
+	v1 "github.com/seth16888/wxproxy/api/v1"
+	"github.com/seth16888/wxproxy/internal/consts"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const testMethod = "/api.v1.MPProxy/PullMenu"
+
+// fakeAuthenticator 按API Key查找调用方, unavailable为true时模拟Redis不可用
+type fakeAuthenticator struct {
+	keys        map[string]string
+	unavailable bool
+}
+
+func (a *fakeAuthenticator) Authenticate(_ context.Context, apiKey string) (string, error) {
+	if a.unavailable {
+		return "", errors.New("redis: connection refused")
+	}
+	if client, ok := a.keys[apiKey]; ok {
+		return client, nil
+	}
+	return "", v1.ErrorUnauthenticated("invalid api key")
+}
+
+// withAPIKey metadata中携带API Key
+func withAPIKey(ctx context.Context, apiKey string) context.Context {
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(consts.APIKeyKey, apiKey))
+}
+
+// withPeerCert 连接的客户端证书, verified为false时模拟未经校验的证书
+func withPeerCert(ctx context.Context, cert *x509.Certificate, verified bool) context.Context {
+	state := tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}
+	if verified {
+		state.VerifiedChains = [][]*x509.Certificate{{cert}}
+	}
+	return peer.NewContext(ctx, &peer.Peer{
+		Addr:     &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 50000},
+		AuthInfo: credentials.TLSInfo{State: state},
+	})
+}
+
+func TestAuth(t *testing.T) {
+	a := &fakeAuthenticator{keys: map[string]string{"key-jssdk": "jssdk"}}
+	spiffe, _ := url.Parse("spiffe://wxproxy/ops")
+
+	tests := []struct {
+		name   string
+		a      *fakeAuthenticator
+		method string
+		ctx    context.Context
+		code   codes.Code
+		client string
+	}{
+		{
+			name:   "health check without credentials",
+			method: "/grpc.health.v1.Health/Check",
+			ctx:    context.Background(),
+		},
+		{
+			name:   "api key",
+			ctx:    withAPIKey(context.Background(), "key-jssdk"),
+			client: "jssdk",
+		},
+		{
+			name: "invalid api key does not fall back to certificate",
+			ctx: withPeerCert(withAPIKey(context.Background(), "key-unknown"),
+				&x509.Certificate{Subject: pkix.Name{CommonName: "ops"}}, true),
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "authenticator unavailable",
+			a:    &fakeAuthenticator{unavailable: true},
+			ctx:  withAPIKey(context.Background(), "key-jssdk"),
+			code: codes.Unavailable,
+		},
+		{
+			name:   "certificate common name",
+			ctx:    withPeerCert(context.Background(), &x509.Certificate{Subject: pkix.Name{CommonName: "ops"}}, true),
+			client: "ops",
+		},
+		{
+			name:   "certificate dns san",
+			ctx:    withPeerCert(context.Background(), &x509.Certificate{DNSNames: []string{"ops.internal"}}, true),
+			client: "ops.internal",
+		},
+		{
+			name:   "certificate uri san",
+			ctx:    withPeerCert(context.Background(), &x509.Certificate{URIs: []*url.URL{spiffe}}, true),
+			client: "spiffe://wxproxy/ops",
+		},
+		{
+			name: "unverified certificate",
+			ctx:  withPeerCert(context.Background(), &x509.Certificate{Subject: pkix.Name{CommonName: "ops"}}, false),
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "missing credentials",
+			ctx:  context.Background(),
+			code: codes.Unauthenticated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.a == nil {
+				tt.a = a
+			}
+			if tt.method == "" {
+				tt.method = testMethod
+			}
+			// 自报的x-client-id, 认证通过后被覆盖
+			ctx := context.WithValue(tt.ctx, consts.ClientIdKey, "self-reported")
+
+			var got context.Context
+			_, err := Auth(tt.a, zap.NewNop())(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method},
+				func(ctx context.Context, _ any) (any, error) {
+					got = ctx
+					return nil, nil
+				})
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("code = %s, want %s (err %v)", code, tt.code, err)
+			}
+			if tt.code != codes.OK {
+				if got != nil {
+					t.Fatal("handler called for unauthenticated request")
+				}
+				return
+			}
+
+			authClient, _ := got.Value(consts.AuthClientKey).(string)
+			if authClient != tt.client {
+				t.Fatalf("auth client = %q, want %q", authClient, tt.client)
+			}
+			if tt.client != "" && got.Value(consts.ClientIdKey) != tt.client {
+				t.Fatalf("client id = %v, want %q", got.Value(consts.ClientIdKey), tt.client)
+			}
+		})
+	}
+}
+
+func TestAuthLogsFailure(t *testing.T) {
+	core, logs := observer.New(zap.WarnLevel)
+	ctx := context.WithValue(context.Background(), consts.RequestIdKey, "req-1")
+	ctx = withAPIKey(ctx, "key-unknown")
+
+	a := &fakeAuthenticator{}
+	_, err := Auth(a, zap.New(core))(ctx, nil, &grpc.UnaryServerInfo{FullMethod: testMethod},
+		func(ctx context.Context, _ any) (any, error) { return nil, nil })
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("err = %v, want Unauthenticated", err)
+	}
+
+	entries := logs.FilterMessage("unauthenticated").All()
+	if len(entries) != 1 {
+		t.Fatalf("log entries = %d, want 1", len(entries))
+	}
+	fields := entries[0].ContextMap()
+	if fields["requestId"] != "req-1" || fields["reason"] != "invalid api key" || fields["method"] != testMethod {
+		t.Fatalf("log fields = %v", fields)
+	}
+	for _, v := range fields {
+		if v == "key-unknown" {
+			t.Fatal("api key logged")
+		}
+	}
+}
+
+// testStream 只提供上下文的ServerStream
+type testStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestAuthStream(t *testing.T) {
+	a := &fakeAuthenticator{keys: map[string]string{"key-jssdk": "jssdk"}}
+	info := &grpc.StreamServerInfo{FullMethod: testMethod}
+
+	var got string
+	handler := func(_ any, ss grpc.ServerStream) error {
+		got, _ = ss.Context().Value(consts.AuthClientKey).(string)
+		return nil
+	}
+
+	ss := &testStream{ctx: withAPIKey(context.Background(), "key-jssdk")}
+	if err := AuthStream(a, zap.NewNop())(nil, ss, info, handler); err != nil {
+		t.Fatal(err)
+	}
+	if got != "jssdk" {
+		t.Fatalf("auth client = %q, want jssdk", got)
+	}
+
+	ss = &testStream{ctx: context.Background()}
+	if err := AuthStream(a, zap.NewNop())(nil, ss, info, handler); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("err = %v, want Unauthenticated", err)
+	}
+}
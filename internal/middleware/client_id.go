@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/seth16888/wxproxy/internal/consts"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// ClientID 从metadata(x-client-id)读取调用方标识, 写入上下文(consts.ClientIdKey)
+func ClientID() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context,
+		req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(withClientId(ctx), req)
+	}
+}
+
+// ClientIDStream ClientID的流式版本
+func ClientIDStream() grpc.StreamServerInterceptor {
+	return func(srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return handler(srv, &contextStream{ServerStream: ss, ctx: withClientId(ss.Context())})
+	}
+}
+
+func withClientId(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	if vals := md.Get(consts.ClientIdKey); len(vals) > 0 && vals[0] != "" {
+		ctx = context.WithValue(ctx, consts.ClientIdKey, vals[0])
+	}
+	return ctx
+}
+
+// contextStream 替换了Context的ServerStream
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
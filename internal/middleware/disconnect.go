@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"google.golang.org/grpc"
@@ -16,20 +17,36 @@ func ClientDisconnectInterceptor() grpc.UnaryServerInterceptor {
 		handler grpc.UnaryHandler,
 	) (resp any, err error) {
 
-		ch := make(chan error)
+		ch := make(chan result, 1)
 
 		go func() {
-			resp, err = handler(ctx, req)
-			ch <- err
+			resp, err := handler(ctx, req)
+			ch <- result{resp, err}
 		}()
 
 		select {
 		case <-ctx.Done(): // 客户端断开连接
 			err = status.Error(codes.Canceled, fmt.Sprintf("%s: Request canceled", info.FullMethod))
 			return
-		case <-ch:
+		case r := <-ch:
+			return r.resp, r.err
 		}
+	}
+}
 
-		return
+// ClientDisconnectStream ClientDisconnectInterceptor的流式版本
+//
+// 流的处理函数使用流的上下文, 客户端断开后微信接口请求随之取消; 处理函数返回后将错误转换为Canceled.
+func ClientDisconnectStream() grpc.StreamServerInterceptor {
+	return func(srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		err := handler(srv, ss)
+		if err != nil && errors.Is(ss.Context().Err(), context.Canceled) {
+			return status.Error(codes.Canceled, fmt.Sprintf("%s: Request canceled", info.FullMethod))
+		}
+		return err
 	}
 }
@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	"sync/atomic"
 	"time"
 
 	"github.com/seth16888/wxproxy/internal/consts"
@@ -31,9 +32,71 @@ func LoggingInterceptor(log *zap.Logger) grpc.UnaryServerInterceptor {
 		if ok {
 			fields = append(fields, zap.String("requestId", requestID))
 		}
+		// 调用方
+		if clientId, ok := ctx.Value(consts.ClientIdKey).(string); ok {
+			fields = append(fields, zap.String("clientId", clientId))
+		}
 
 		log.Info("request", fields...)
 
 		return resp, err
 	}
 }
+
+// LoggingStream LoggingInterceptor的流式版本, 记录流的持续时间和收发消息数
+func LoggingStream(log *zap.Logger) grpc.StreamServerInterceptor {
+	return func(srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		start := time.Now().UnixMilli()
+
+		s := &countingStream{ServerStream: ss}
+		err := handler(srv, s)
+
+		fields := []zap.Field{
+			zap.String("method", info.FullMethod),
+			zap.Int64("latency", time.Now().UnixMilli()-start),
+			zap.Int64("received", s.received.Load()),
+			zap.Int64("sent", s.sent.Load()),
+			zap.Error(err),
+		}
+		// RequestID
+		requestID, ok := ss.Context().Value(consts.RequestIdKey).(string)
+		if ok {
+			fields = append(fields, zap.String("requestId", requestID))
+		}
+		// 调用方
+		if clientId, ok := ss.Context().Value(consts.ClientIdKey).(string); ok {
+			fields = append(fields, zap.String("clientId", clientId))
+		}
+
+		log.Info("stream", fields...)
+
+		return err
+	}
+}
+
+// countingStream 统计收发消息数的ServerStream
+type countingStream struct {
+	grpc.ServerStream
+	received atomic.Int64
+	sent     atomic.Int64
+}
+
+func (s *countingStream) SendMsg(m any) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.sent.Add(1)
+	}
+	return err
+}
+
+func (s *countingStream) RecvMsg(m any) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.received.Add(1)
+	}
+	return err
+}
@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// RPCObserver 记录gRPC请求指标
+type RPCObserver interface {
+	ObserveRPC(method, code string, d time.Duration)
+}
+
+// Metrics 指标拦截器, 按方法和gRPC状态码记录请求数和耗时
+//
+// 须位于拦截器链的最前面, 以便记录完整耗时和最终的状态码.
+func Metrics(o RPCObserver) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+		o.ObserveRPC(info.FullMethod, status.Code(err).String(), time.Since(start))
+		return resp, err
+	}
+}
+
+// MetricsStream Metrics的流式版本, 耗时为流的持续时间
+func MetricsStream(o RPCObserver) grpc.StreamServerInterceptor {
+	return func(srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		start := time.Now()
+
+		err := handler(srv, ss)
+		o.ObserveRPC(info.FullMethod, status.Code(err).String(), time.Since(start))
+		return err
+	}
+}
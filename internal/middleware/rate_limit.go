@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/seth16888/wxproxy/internal/consts"
+	"google.golang.org/grpc"
+)
+
+// Limiter 调用频率限制
+type Limiter interface {
+	Allow(ctx context.Context, appId, method, clientId string) error
+}
+
+// RateLimit 调用频率限制拦截器
+//
+// 须位于ClientID和AppTokenInterceptor之后, 以便从上下文中读取调用方和AppId.
+// 超过限制时返回ResourceExhausted, 详情中携带RetryInfo.
+func RateLimit(l Limiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if err := allow(ctx, l, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// RateLimitStream RateLimit的流式版本, 在接收请求消息时检查
+func RateLimitStream(l Limiter) grpc.StreamServerInterceptor {
+	return func(srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return handler(srv, &rateLimitStream{ServerStream: ss, l: l, method: info.FullMethod})
+	}
+}
+
+type rateLimitStream struct {
+	grpc.ServerStream
+	l      Limiter
+	method string
+}
+
+func (s *rateLimitStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	return allow(s.Context(), s.l, s.method)
+}
+
+func allow(ctx context.Context, l Limiter, method string) error {
+	appId, _ := ctx.Value(consts.AppIdKey).(string)
+	clientId, _ := ctx.Value(consts.ClientIdKey).(string)
+
+	return l.Allow(ctx, appId, method, clientId)
+}
@@ -26,3 +26,21 @@ func RecoverInterceptor(log *zap.Logger) grpc.UnaryServerInterceptor {
 		return
 	}
 }
+
+// RecoverStream RecoverInterceptor的流式版本
+func RecoverStream(log *zap.Logger) grpc.StreamServerInterceptor {
+	return func(srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				log.Error("panic", zap.String("method", info.FullMethod), zap.Any("error", r))
+				err = status.Errorf(codes.Internal, "Unexpected error occurred")
+			}
+		}()
+
+		return handler(srv, ss)
+	}
+}
@@ -6,18 +6,70 @@ import (
 	"github.com/seth16888/wxcommon/helpers"
 	"github.com/seth16888/wxproxy/internal/consts"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
+// maxRequestIDLen 请求携带的Request ID的最大长度, 超过时重新生成
+const maxRequestIDLen = 128
+
+// RequestID Request ID拦截器
+//
+// 优先使用metadata(x-request-id)中的Request ID, 未携带或不合法时生成新的;
+// Request ID写入上下文(consts.RequestIdKey), 并在响应header和trailer(x-request-id)中返回.
 func RequestID() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context,
 		req interface{}, info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler) (interface{}, error) {
-		// 生成Request ID
-		requestID := helpers.UUID()
+		requestID := requestIDFromMetadata(ctx)
 
 		ctx = context.WithValue(ctx, consts.RequestIdKey, requestID)
+		md := metadata.Pairs(consts.RequestIdKey, requestID)
+		_ = grpc.SetHeader(ctx, md)
+		_ = grpc.SetTrailer(ctx, md)
 
 		// 继续处理请求
 		return handler(ctx, req)
 	}
 }
+
+// RequestIDStream RequestID的流式版本
+func RequestIDStream() grpc.StreamServerInterceptor {
+	return func(srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		requestID := requestIDFromMetadata(ss.Context())
+
+		ctx := context.WithValue(ss.Context(), consts.RequestIdKey, requestID)
+		md := metadata.Pairs(consts.RequestIdKey, requestID)
+		_ = ss.SetHeader(md)
+		ss.SetTrailer(md)
+
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// requestIDFromMetadata 读取metadata中的Request ID, 未携带或不合法时生成新的
+func requestIDFromMetadata(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		if vals := md.Get(consts.RequestIdKey); len(vals) > 0 && validRequestID(vals[0]) {
+			return vals[0]
+		}
+	}
+	return helpers.UUID()
+}
+
+// validRequestID Request ID只允许可打印的ASCII字符
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLen {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/seth16888/wxproxy/internal/consts"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RetryPolicies 按gRPC方法的重试策略
+type RetryPolicies interface {
+	WithRetry(ctx context.Context, fullMethod string, idempotencyKey string) context.Context
+	Attempts(ctx context.Context) int
+}
+
+// Retry 重试策略拦截器
+//
+// 将方法的重试策略写入上下文, 幂等键取自metadata(x-idempotency-key);
+// 处理完成后在trailer(x-attempts)中返回微信接口的尝试次数.
+func Retry(p RetryPolicies) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		ctx = p.WithRetry(ctx, info.FullMethod, idempotencyKey(ctx))
+
+		resp, err := handler(ctx, req)
+		if n := p.Attempts(ctx); n > 0 {
+			_ = grpc.SetTrailer(ctx, metadata.Pairs(consts.AttemptsKey, strconv.Itoa(n)))
+		}
+		return resp, err
+	}
+}
+
+// RetryStream Retry的流式版本
+func RetryStream(p RetryPolicies) grpc.StreamServerInterceptor {
+	return func(srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx := p.WithRetry(ss.Context(), info.FullMethod, idempotencyKey(ss.Context()))
+
+		err := handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+		if n := p.Attempts(ctx); n > 0 {
+			ss.SetTrailer(metadata.Pairs(consts.AttemptsKey, strconv.Itoa(n)))
+		}
+		return err
+	}
+}
+
+func idempotencyKey(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if vals := md.Get(consts.IdempotencyKey); len(vals) > 0 {
+		return vals[0]
+	}
+	return ""
+}
@@ -2,43 +2,211 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"path"
 	"time"
 
+	"github.com/seth16888/wxproxy/internal/config"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+const (
+	// defaultTimeout 默认一元RPC的处理超时
+	defaultTimeout = 10 * time.Second
+	// defaultStreamTimeout 默认流式RPC的总超时
+	defaultStreamTimeout = 5 * time.Minute
+	// defaultStreamIdleTimeout 默认流式RPC两次收发消息之间的最长间隔
+	defaultStreamIdleTimeout = time.Minute
+)
+
+var (
+	errStreamTimeout     = errors.New("stream timeout")
+	errStreamIdleTimeout = errors.New("stream idle timeout")
+)
+
+// Timeouts 按gRPC方法的超时配置
+type Timeouts struct {
+	unary      time.Duration
+	stream     time.Duration
+	streamIdle time.Duration
+	methods    map[string]*config.MethodTimeout
+}
+
+// NewTimeouts 读取config.Server中的超时配置, 未配置的使用默认值
+func NewTimeouts(conf *config.Server) *Timeouts {
+	t := &Timeouts{
+		unary:      defaultTimeout,
+		stream:     defaultStreamTimeout,
+		streamIdle: defaultStreamIdleTimeout,
+		methods:    make(map[string]*config.MethodTimeout),
+	}
+	if conf == nil {
+		return t
+	}
+
+	if conf.Timeout > 0 {
+		t.unary = time.Duration(conf.Timeout) * time.Second
+	}
+	if conf.StreamTimeout > 0 {
+		t.stream = time.Duration(conf.StreamTimeout) * time.Second
+	}
+	if conf.StreamIdleTimeout > 0 {
+		t.streamIdle = time.Duration(conf.StreamIdleTimeout) * time.Second
+	}
+	for _, m := range conf.Methods {
+		t.methods[path.Base(m.Method)] = m
+	}
+	return t
+}
+
+// Unary 一元RPC的处理超时
+func (t *Timeouts) Unary(fullMethod string) time.Duration {
+	if m, ok := t.methods[path.Base(fullMethod)]; ok && m.Timeout > 0 {
+		return time.Duration(m.Timeout) * time.Second
+	}
+	return t.unary
+}
+
+// Stream 流式RPC的总超时和空闲超时
+func (t *Timeouts) Stream(fullMethod string) (total time.Duration, idle time.Duration) {
+	total, idle = t.stream, t.streamIdle
+	if m, ok := t.methods[path.Base(fullMethod)]; ok {
+		if m.Timeout > 0 {
+			total = time.Duration(m.Timeout) * time.Second
+		}
+		if m.IdleTimeout > 0 {
+			idle = time.Duration(m.IdleTimeout) * time.Second
+		}
+	}
+	return total, idle
+}
+
 // TimeoutInterceptor 超时拦截器
-// 当请求处理时间超过10秒时，返回超时错误
+// 当请求处理时间超过方法的超时时间时，返回超时错误;
+// 客户端设置了更短的截止时间时以客户端为准
 // 超时错误的状态码为codes.DeadlineExceeded
 // 超时错误的消息为"Deadline exceeded"
-func TimeoutInterceptor() grpc.UnaryServerInterceptor {
+func TimeoutInterceptor(t *Timeouts) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context,
 		req any,
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (resp any, err error) {
-		ctxWithTimeout, cancel := context.WithTimeout(ctx, 10*time.Second)
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, t.Unary(info.FullMethod))
 		defer cancel()
 
-		ch := make(chan struct{})
+		// 缓冲为1, 超时返回后handler仍可写入结果并退出, 不会泄漏goroutine
+		ch := make(chan result, 1)
 		go func() {
-			resp, err = handler(ctxWithTimeout, req)
-			close(ch)
+			resp, err := handler(ctxWithTimeout, req)
+			ch <- result{resp, err}
 		}()
 
 		select {
 		case <-ctxWithTimeout.Done():
-			cancel()
-			err = status.Error(
-        codes.DeadlineExceeded,
+			if errors.Is(ctxWithTimeout.Err(), context.Canceled) {
+				return nil, status.Error(codes.Canceled,
+					fmt.Sprintf("%s: Request canceled", info.FullMethod))
+			}
+			return nil, status.Error(codes.DeadlineExceeded,
 				fmt.Sprintf("%s: Deadline exceeded", info.FullMethod))
-			return
-		case <-ch:
+		case r := <-ch:
+			return r.resp, r.err
 		}
+	}
+}
+
+// TimeoutStream 流式RPC超时拦截器
+//
+// 超过总超时, 或两次收发消息的间隔超过空闲超时时, 取消流的上下文, 之后的收发返回DeadlineExceeded;
+// 客户端设置了更短的截止时间时以客户端为准.
+func TimeoutStream(t *Timeouts) grpc.StreamServerInterceptor {
+	return func(srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		total, idle := t.Stream(info.FullMethod)
+		ctx, cancelTotal := context.WithTimeoutCause(ss.Context(), total, errStreamTimeout)
+		defer cancelTotal()
+		ctx, cancel := context.WithCancelCause(ctx)
+		defer cancel(nil)
 
-		return
+		s := &timeoutStream{ServerStream: ss, ctx: ctx, idle: idle}
+		if idle > 0 {
+			s.timer = time.AfterFunc(idle, func() { cancel(errStreamIdleTimeout) })
+			defer s.timer.Stop()
+		}
+
+		err := handler(srv, s)
+		// 客户端断开或客户端截止时间到达时保留原错误
+		if ctx.Err() != nil && ss.Context().Err() == nil {
+			return s.timeoutErr(info.FullMethod)
+		}
+		return err
+	}
+}
+
+// timeoutStream 收发消息时重置空闲超时
+type timeoutStream struct {
+	grpc.ServerStream
+	ctx   context.Context
+	idle  time.Duration
+	timer *time.Timer
+}
+
+func (s *timeoutStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *timeoutStream) SendMsg(m any) error {
+	if err := s.active(); err != nil {
+		return err
+	}
+	defer s.touch()
+
+	return s.ServerStream.SendMsg(m)
+}
+
+func (s *timeoutStream) RecvMsg(m any) error {
+	if err := s.active(); err != nil {
+		return err
 	}
+	defer s.touch()
+
+	return s.ServerStream.RecvMsg(m)
+}
+
+// active 流的上下文已取消时返回错误
+func (s *timeoutStream) active() error {
+	if s.ctx.Err() == nil {
+		return nil
+	}
+	if s.ServerStream.Context().Err() != nil {
+		return status.FromContextError(s.ServerStream.Context().Err()).Err()
+	}
+	return s.timeoutErr("")
+}
+
+func (s *timeoutStream) touch() {
+	if s.timer != nil && s.ctx.Err() == nil {
+		s.timer.Reset(s.idle)
+	}
+}
+
+func (s *timeoutStream) timeoutErr(method string) error {
+	msg := context.Cause(s.ctx).Error()
+	if method != "" {
+		msg = method + ": " + msg
+	}
+	return status.Error(codes.DeadlineExceeded, msg)
+}
+
+// result handler的返回值
+type result struct {
+	resp any
+	err  error
 }
@@ -0,0 +1,123 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	v1 "github.com/seth16888/wxproxy/api/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+)
+
+// validator protoc-gen-validate生成的校验方法
+type validator interface {
+	ValidateAll() error
+}
+
+// fieldError 单个字段的校验错误
+type fieldError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// multiError 多个字段的校验错误
+type multiError interface {
+	AllErrors() []error
+}
+
+// Validate 请求参数校验拦截器
+//
+// 按proto中的validate规则校验请求, 不通过时返回INVALID_ARGUMENT, 详情中携带BadRequest的字段错误.
+// 须位于AppTokenInterceptor之后, 以便托管的AccessToken已填充到请求中.
+func Validate() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if err := validate(req); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// ValidateStream Validate的流式版本, 在接收请求消息时校验
+func ValidateStream() grpc.StreamServerInterceptor {
+	return func(srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return handler(srv, &validateStream{ServerStream: ss})
+	}
+}
+
+type validateStream struct {
+	grpc.ServerStream
+}
+
+func (s *validateStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	return validate(m)
+}
+
+// validate 校验请求, 没有校验规则的消息直接通过
+func validate(req any) error {
+	v, ok := req.(validator)
+	if !ok {
+		return nil
+	}
+	err := v.ValidateAll()
+	if err == nil {
+		return nil
+	}
+
+	violations := fieldViolations("", err)
+	msgs := make([]string, 0, len(violations))
+	for _, fv := range violations {
+		msgs = append(msgs, fv.Field+": "+fv.Description)
+	}
+	st := v1.ErrorInvalidArgument("invalid request: %s", strings.Join(msgs, "; ")).GRPCStatus()
+
+	if withDetails, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations}); err == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+// fieldViolations 将校验错误展开为字段错误, 嵌套消息的字段以"."连接, 如Common.ToUser
+func fieldViolations(prefix string, err error) []*errdetails.BadRequest_FieldViolation {
+	var multi multiError
+	if errors.As(err, &multi) {
+		var violations []*errdetails.BadRequest_FieldViolation
+		for _, e := range multi.AllErrors() {
+			violations = append(violations, fieldViolations(prefix, e)...)
+		}
+		return violations
+	}
+
+	var fe fieldError
+	if !errors.As(err, &fe) {
+		return []*errdetails.BadRequest_FieldViolation{{Field: strings.TrimSuffix(prefix, "."), Description: err.Error()}}
+	}
+
+	field := prefix + fe.Field()
+	if cause := fe.Cause(); cause != nil && isValidationError(cause) {
+		return fieldViolations(field+".", cause)
+	}
+	return []*errdetails.BadRequest_FieldViolation{{Field: field, Description: fe.Reason()}}
+}
+
+// isValidationError 嵌套消息的校验错误
+func isValidationError(err error) bool {
+	var multi multiError
+	var fe fieldError
+	return errors.As(err, &multi) || errors.As(err, &fe)
+}
@@ -0,0 +1,110 @@
+package redact
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// core 写入前脱敏日志消息和字段的zapcore.Core
+type core struct {
+	zapcore.Core
+	r *Redactor
+}
+
+// NewCore 包装zapcore.Core, 日志消息和字段在写入前脱敏
+//
+// 用法: log.WithOptions(zap.WrapCore(func(c zapcore.Core) zapcore.Core { return redact.NewCore(c, r) }))
+func NewCore(c zapcore.Core, r *Redactor) zapcore.Core {
+	return &core{Core: c, r: r}
+}
+
+func (c *core) With(fields []zapcore.Field) zapcore.Core {
+	return &core{Core: c.Core.With(c.r.Fields(fields)), r: c.r}
+}
+
+func (c *core) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+	return ce
+}
+
+func (c *core) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	ent.Message = c.r.String(ent.Message)
+	return c.Core.Write(ent, c.r.Fields(fields))
+}
+
+// Fields 脱敏日志字段
+func (r *Redactor) Fields(fields []zapcore.Field) []zapcore.Field {
+	out := make([]zapcore.Field, len(fields))
+	for i, f := range fields {
+		out[i] = r.field(f)
+	}
+	return out
+}
+
+func (r *Redactor) field(f zapcore.Field) zapcore.Field {
+	switch f.Type {
+	case zapcore.StringType:
+		if r.Sensitive(f.Key) {
+			return zap.String(f.Key, Mask)
+		}
+		return zap.String(f.Key, r.String(f.String))
+	case zapcore.ByteStringType:
+		if r.Sensitive(f.Key) {
+			return zap.String(f.Key, Mask)
+		}
+		return zap.String(f.Key, r.String(string(f.Interface.([]byte))))
+	case zapcore.ErrorType:
+		if err, ok := f.Interface.(error); ok && err != nil {
+			return zap.String(f.Key, r.String(err.Error()))
+		}
+	case zapcore.StringerType:
+		if r.Sensitive(f.Key) {
+			return zap.String(f.Key, Mask)
+		}
+		if s, ok := f.Interface.(fmt.Stringer); ok && s != nil {
+			return zap.String(f.Key, r.String(s.String()))
+		}
+	case zapcore.ReflectType:
+		if r.Sensitive(f.Key) {
+			return zap.String(f.Key, Mask)
+		}
+		return r.any(f.Key, f.Interface)
+	case zapcore.ArrayMarshalerType, zapcore.ObjectMarshalerType, zapcore.InlineMarshalerType:
+		if r.Sensitive(f.Key) {
+			return zap.String(f.Key, Mask)
+		}
+		// 编码后按普通值脱敏
+		enc := zapcore.NewMapObjectEncoder()
+		f.AddTo(enc)
+		if f.Type == zapcore.InlineMarshalerType {
+			return zap.Inline(zapcore.ObjectMarshalerFunc(func(oe zapcore.ObjectEncoder) error {
+				for k, v := range enc.Fields {
+					if r.Sensitive(k) {
+						oe.AddString(k, Mask)
+						continue
+					}
+					if err := oe.AddReflected(k, r.Value(v)); err != nil {
+						return err
+					}
+				}
+				return nil
+			}))
+		}
+		return r.any(f.Key, enc.Fields[f.Key])
+	}
+	return f
+}
+
+// any 脱敏任意值, JSON脱敏的结果按原结构输出
+func (r *Redactor) any(key string, v any) zapcore.Field {
+	v = r.Value(v)
+	if raw, ok := v.(json.RawMessage); ok {
+		return zap.Reflect(key, raw)
+	}
+	return zap.Any(key, v)
+}
@@ -0,0 +1,199 @@
+package redact
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/seth16888/wxproxy/internal/config"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Mask 脱敏后的值
+const Mask = "***"
+
+var (
+	// secretFields 始终脱敏的字段
+	secretFields = []string{
+		"access_token", "app_secret", "secret", "password", "api_key",
+		"refresh_token", "component_access_token", "authorizer_access_token", "authorizer_refresh_token",
+	}
+	// openIdFields 用户标识字段, openids包括BlockMemberReq.OpenIds、GetBlacklistReply.OpenIDs
+	openIdFields = []string{"openid", "openids", "next_openid", "openid_list", "touser", "unionid"}
+	// contentFields 消息内容字段, value为模板消息和订阅消息Data中的取值
+	contentFields = []string{"content", "head_content", "tail_content", "text", "value", "title", "description"}
+)
+
+var (
+	// queryPattern URL参数, 如?access_token=xxx&openid=xxx, 或不带?的access_token=xxx&openid=xxx
+	queryPattern = regexp.MustCompile(`(^|[?&;])([A-Za-z_\-]+)=([^&;\s"'#]*)`)
+	// keyValuePattern JSON或proto文本格式的键值, 如"access_token":"xxx"、AccessToken:"xxx"
+	keyValuePattern = regexp.MustCompile(`("?)([A-Za-z_]+)("?\s*:\s*)("(?:[^"\\]|\\.)*"|\[[^\]]*\])`)
+)
+
+// Redactor 日志脱敏
+//
+// 按字段名脱敏URL参数、JSON/proto文本中的键值和proto消息中的字段,
+// 字段名比较时不区分大小写和下划线, 如access_token、AccessToken、accessToken视为同一字段.
+type Redactor struct {
+	fields map[string]struct{}
+}
+
+// New 根据配置创建Redactor, conf为nil时脱敏所有内置字段
+func New(conf *config.Redact) *Redactor {
+	r := &Redactor{fields: make(map[string]struct{})}
+	r.add(secretFields...)
+	if conf == nil || !conf.KeepOpenId {
+		r.add(openIdFields...)
+	}
+	if conf == nil || !conf.KeepContent {
+		r.add(contentFields...)
+	}
+	if conf != nil {
+		r.add(conf.Fields...)
+	}
+	return r
+}
+
+// NewSecrets 只脱敏AccessToken、AppSecret等密钥的Redactor, 用于返回给调用方的微信接口数据
+func NewSecrets() *Redactor {
+	return New(&config.Redact{KeepOpenId: true, KeepContent: true})
+}
+
+func (r *Redactor) add(names ...string) {
+	for _, name := range names {
+		r.fields[normalize(name)] = struct{}{}
+	}
+}
+
+// Sensitive 字段是否需要脱敏
+func (r *Redactor) Sensitive(name string) bool {
+	_, ok := r.fields[normalize(name)]
+	return ok
+}
+
+// String 脱敏字符串中的URL参数和键值
+func (r *Redactor) String(s string) string {
+	if !strings.ContainsAny(s, "=:") {
+		return s
+	}
+
+	s = queryPattern.ReplaceAllStringFunc(s, func(m string) string {
+		sub := queryPattern.FindStringSubmatch(m)
+		if !r.Sensitive(sub[2]) {
+			return m
+		}
+		return sub[1] + sub[2] + "=" + Mask
+	})
+	return keyValuePattern.ReplaceAllStringFunc(s, func(m string) string {
+		sub := keyValuePattern.FindStringSubmatch(m)
+		if !r.Sensitive(sub[2]) {
+			return m
+		}
+		return sub[1] + sub[2] + sub[3] + `"` + Mask + `"`
+	})
+}
+
+// Message 返回脱敏后的proto消息副本
+func (r *Redactor) Message(m proto.Message) proto.Message {
+	if m == nil {
+		return m
+	}
+	clone := proto.Clone(m)
+	r.message(clone.ProtoReflect())
+	return clone
+}
+
+func (r *Redactor) message(m protoreflect.Message) {
+	// Range期间不能修改消息, 先收集再替换
+	type change struct {
+		fd protoreflect.FieldDescriptor
+		v  protoreflect.Value
+	}
+	var changes []change
+
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		sensitive := r.Sensitive(string(fd.Name()))
+		switch {
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				if nv, ok := r.value(fd, list.Get(i), sensitive); ok {
+					list.Set(i, nv)
+				}
+			}
+		case fd.IsMap():
+			mp := v.Map()
+			var keys []protoreflect.MapKey
+			var vals []protoreflect.Value
+			mp.Range(func(k protoreflect.MapKey, mv protoreflect.Value) bool {
+				if nv, ok := r.value(fd.MapValue(), mv, sensitive); ok {
+					keys, vals = append(keys, k), append(vals, nv)
+				}
+				return true
+			})
+			for i, k := range keys {
+				mp.Set(k, vals[i])
+			}
+		default:
+			if nv, ok := r.value(fd, v, sensitive); ok {
+				changes = append(changes, change{fd, nv})
+			}
+		}
+		return true
+	})
+
+	for _, c := range changes {
+		m.Set(c.fd, c.v)
+	}
+}
+
+// value 脱敏单个字段值, 返回是否需要替换
+func (r *Redactor) value(fd protoreflect.FieldDescriptor, v protoreflect.Value, sensitive bool) (protoreflect.Value, bool) {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		r.message(v.Message())
+	case protoreflect.StringKind:
+		if sensitive {
+			return protoreflect.ValueOfString(Mask), true
+		}
+		if s := r.String(v.String()); s != v.String() {
+			return protoreflect.ValueOfString(s), true
+		}
+	case protoreflect.BytesKind:
+		if sensitive {
+			return protoreflect.ValueOfBytes([]byte(Mask)), true
+		}
+	}
+	return v, false
+}
+
+// Value 脱敏任意值: proto消息返回脱敏后的副本, 其他值按JSON脱敏
+func (r *Redactor) Value(v any) any {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case proto.Message:
+		return r.Message(v)
+	case string:
+		return r.String(v)
+	case error:
+		return r.String(v.Error())
+	case fmt.Stringer:
+		return r.String(v.String())
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return r.String(fmt.Sprintf("%+v", v))
+	}
+	return json.RawMessage(r.String(string(data)))
+}
+
+// normalize 字段名转为小写并去掉下划线和连字符
+func normalize(name string) string {
+	name = strings.ToLower(name)
+	return strings.NewReplacer("_", "", "-", "").Replace(name)
+}
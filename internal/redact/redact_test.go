@@ -0,0 +1,183 @@
+package redact
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+
+	v1 "github.com/seth16888/wxproxy/api/v1"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	testToken  = "tok-3f9a1c7e"
+	testSecret = "secret-8b2d4e6f"
+	testOpenId = "oOPENID-5c1a9d"
+)
+
+// newTestLogger 经NewCore脱敏后写入observer
+func newTestLogger() (*zap.Logger, *observer.ObservedLogs) {
+	obs, logs := observer.New(zapcore.DebugLevel)
+	return zap.New(NewCore(obs, New(nil))), logs
+}
+
+// render 将observer记录的日志编码为JSON文本
+func render(t *testing.T, logs *observer.ObservedLogs) string {
+	t.Helper()
+	enc := zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig())
+	var b strings.Builder
+	for _, e := range logs.All() {
+		buf, err := enc.EncodeEntry(e.Entry, e.Context)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b.WriteString(buf.String())
+		buf.Free()
+	}
+	return b.String()
+}
+
+func TestCoreRedacts(t *testing.T) {
+	rawURL := "https://api.weixin.qq.com/cgi-bin/user/info?access_token=" + testToken +
+		"&openid=" + testOpenId + "&lang=zh_CN"
+	tokenURL := "https://api.weixin.qq.com/cgi-bin/token?grant_type=client_credential&appid=wx1&secret=" + testSecret
+
+	req := &v1.SendKFTextMsgRequest{
+		AccessToken: testToken,
+		Type:        "text",
+		Common:      &v1.KFMessageCommon{ToUser: testOpenId, MsgType: "text"},
+		Text:        &v1.SendKFTextMsgRequest_KFTextMsg{Content: "hello"},
+	}
+
+	tests := []struct {
+		name string
+		log  func(log *zap.Logger)
+		keep []string
+	}{
+		{
+			name: "url query",
+			log:  func(log *zap.Logger) { log.Info("request", zap.String("url", rawURL)) },
+			keep: []string{"/cgi-bin/user/info", "lang=zh_CN"},
+		},
+		{
+			name: "url in message",
+			log:  func(log *zap.Logger) { log.Info("request " + tokenURL) },
+			keep: []string{"grant_type=client_credential"},
+		},
+		{
+			name: "proto stringer",
+			log:  func(log *zap.Logger) { log.Info("request", zap.Any("request", req)) },
+			keep: []string{"MsgType", "Common"},
+		},
+		{
+			name: "error with url",
+			log: func(log *zap.Logger) {
+				err := &url.Error{Op: "Get", URL: tokenURL, Err: errors.New("i/o timeout")}
+				log.Error("request error", zap.Error(err))
+			},
+			keep: []string{"i/o timeout", "/cgi-bin/token"},
+		},
+		{
+			name: "sugared debugf",
+			log:  func(log *zap.Logger) { log.Sugar().Debugf("url: %s", rawURL) },
+			keep: []string{"url: https://api.weixin.qq.com/cgi-bin/user/info"},
+		},
+		{
+			name: "logger with fields",
+			log:  func(log *zap.Logger) { log.With(zap.String("url", tokenURL)).Info("request") },
+			keep: []string{"appid=wx1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log, logs := newTestLogger()
+			tt.log(log)
+
+			out := render(t, logs)
+			if logs.Len() != 1 {
+				t.Fatalf("got %d entries, want 1", logs.Len())
+			}
+			for _, secret := range []string{testToken, testSecret, testOpenId} {
+				if strings.Contains(out, secret) {
+					t.Errorf("output contains %q: %s", secret, out)
+				}
+			}
+			for _, s := range tt.keep {
+				if !strings.Contains(out, s) {
+					t.Errorf("output does not contain %q: %s", s, out)
+				}
+			}
+		})
+	}
+}
+
+func TestCoreRedactsOpenIdsAndContent(t *testing.T) {
+	const content = "CONTENT-4e8a1f"
+	tests := []struct {
+		name string
+		msg  proto.Message
+	}{
+		{"BlockMemberReq.OpenIds", &v1.BlockMemberReq{AccessToken: testToken, OpenIds: []string{testOpenId, testOpenId}}},
+		{"GetBlacklistReply.OpenIDs", &v1.GetBlacklistReply{Total: 1, OpenIDs: []string{testOpenId}}},
+		{"SendTplMsgRequest.Data.Value", &v1.SendTplMsgRequest{
+			Touser: testOpenId,
+			Data:   map[string]*v1.SendTplMsgRequest_DataItem{"first": {Value: content, Color: "#173177"}},
+		}},
+		{"SendSubscribeMsgRequest.Title", &v1.SendSubscribeMsgRequest{
+			Touser: testOpenId,
+			Title:  content,
+			Data:   map[string]*v1.SendSubscribeMsgRequest_DataItem{"content": {Value: content}},
+		}},
+		{"SendSubscribeMessageRequest.Data.Value", &v1.SendSubscribeMessageRequest{
+			Data: map[string]*v1.SendSubscribeMessageRequest_DataItem{"thing1": {Value: content}},
+		}},
+		{"SendKFNewsCardMsgRequest.Description", &v1.SendKFNewsCardMsgRequest{
+			News: &v1.SendKFNewsCardMsgRequest_KFNewsCardMsg{Title: content, Description: content, Url: "https://example.com"},
+		}},
+		{"SendKFMusicMsgRequest.Description", &v1.SendKFMusicMsgRequest{
+			Music: &v1.SendKFMusicMsgRequest_KFMusicMsg{Title: content, Description: content},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log, logs := newTestLogger()
+			// Stringer(prototext)、Reflect(proto消息)和JSON三种写法
+			log.Info("request", zap.Any("request", tt.msg), zap.Reflect("message", tt.msg),
+				zap.String("body", protojson.Format(tt.msg)))
+
+			out := render(t, logs)
+			for _, secret := range []string{testToken, testOpenId, content} {
+				if strings.Contains(out, secret) {
+					t.Errorf("output contains %q: %s", secret, out)
+				}
+			}
+		})
+	}
+}
+
+func TestCoreRedactsNestedOpenId(t *testing.T) {
+	log, logs := newTestLogger()
+	req := &v1.SendKFTextMsgRequest{
+		AccessToken: testToken,
+		Common:      &v1.KFMessageCommon{ToUser: testOpenId},
+	}
+	field := zap.Any("request", req)
+	if field.Type != zapcore.StringerType {
+		t.Fatalf("zap.Any(proto) type = %v, want StringerType", field.Type)
+	}
+	log.Info("request", field, zap.Reflect("body", req))
+
+	out := render(t, logs)
+	if strings.Contains(out, testOpenId) || strings.Contains(out, testToken) {
+		t.Fatalf("output contains secrets: %s", out)
+	}
+	// 原消息不被修改
+	if req.Common.ToUser != testOpenId || req.AccessToken != testToken {
+		t.Fatalf("request modified: %v", req)
+	}
+}
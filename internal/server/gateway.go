@@ -0,0 +1,154 @@
+package server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	v1 "github.com/seth16888/wxproxy/api/v1"
+	"github.com/seth16888/wxproxy/internal/consts"
+	"github.com/seth16888/wxproxy/internal/di"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	// inProcessNetwork 网关进程内连接的网络类型
+	inProcessNetwork = "bufconn"
+	// inProcessBufferSize 进程内连接的缓冲区大小
+	inProcessBufferSize = 1 << 20
+)
+
+// gatewayHeaders 转发到gRPC metadata的HTTP请求头
+var gatewayHeaders = map[string]bool{
+	strings.ToLower(consts.RequestIdKey):   true,
+	strings.ToLower(consts.ClientIdKey):    true,
+	strings.ToLower(consts.AppIdKey):       true,
+	strings.ToLower(consts.IdempotencyKey): true,
+	consts.APIKeyKey:                       true,
+	"traceparent":                          true,
+	"tracestate":                           true,
+	"baggage":                              true,
+}
+
+// gateway HTTP/JSON网关
+type gateway struct {
+	srv  *http.Server
+	conn *grpc.ClientConn
+}
+
+// startGateway 启动HTTP/JSON网关, 未配置监听地址时返回nil
+//
+// 网关按proto中的google.api.http路由将HTTP请求转为gRPC请求, 经进程内连接发送到gRPC服务,
+// 因此与gRPC请求经过相同的拦截器(Request ID、认证、频率限制、AccessToken托管等).
+// 进程内连接不使用TLS, gRPC服务开启mTLS时经网关的请求须使用API Key认证.
+// gRPC状态码按grpc-gateway的规则转为HTTP状态码, 如ResourceExhausted为429.
+// 同时在/openapi.json提供OpenAPI v3文档, 在/swagger/提供Swagger页面.
+func startGateway(deps *di.Container, s *grpc.Server, errCh chan<- error) *gateway {
+	if deps.Conf.Server.HTTPAddr == "" {
+		return nil
+	}
+
+	lis := bufconn.Listen(inProcessBufferSize)
+	go func() {
+		if err := s.Serve(lis); err != nil && err != grpc.ErrServerStopped {
+			deps.Log.Error("failed to serve gateway connection", zap.Error(err))
+			errCh <- err
+		}
+	}()
+
+	conn, err := grpc.NewClient("passthrough:///"+inProcessNetwork,
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		deps.Log.Error("failed to dial grpc server for gateway", zap.Error(err))
+		errCh <- err
+		return nil
+	}
+
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+		runtime.WithOutgoingTrailerMatcher(outgoingTrailerMatcher),
+	)
+	if err := v1.RegisterMpproxyHandler(context.Background(), mux, conn); err != nil {
+		deps.Log.Error("failed to register gateway handler", zap.Error(err))
+		_ = conn.Close()
+		errCh <- err
+		return nil
+	}
+
+	// OpenAPI文档和Swagger页面
+	root := http.NewServeMux()
+	if err := registerOpenAPI(root); err != nil {
+		deps.Log.Error("failed to load openapi document", zap.Error(err))
+		_ = conn.Close()
+		errCh <- err
+		return nil
+	}
+	root.Handle("/", mux)
+
+	srv := &http.Server{
+		Addr:              deps.Conf.Server.HTTPAddr,
+		Handler:           root,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	deps.Log.Info("starting http gateway", zap.String("addr", srv.Addr))
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			deps.Log.Error("failed to serve http gateway", zap.Error(err))
+			errCh <- err
+		}
+	}()
+
+	return &gateway{srv: srv, conn: conn}
+}
+
+// stop 关闭HTTP服务, 等待处理中的请求完成后关闭gRPC连接
+func (g *gateway) stop(deps *di.Container) {
+	if g == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := g.srv.Shutdown(ctx); err != nil {
+		deps.Log.Warn("shutdown http gateway error", zap.Error(err))
+	}
+	_ = g.conn.Close()
+}
+
+// incomingHeaderMatcher 转发gatewayHeaders中的请求头, 其他请求头按grpc-gateway的默认规则
+func incomingHeaderMatcher(key string) (string, bool) {
+	if k := strings.ToLower(key); gatewayHeaders[k] {
+		return k, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// outgoingHeaderMatcher 响应header中的x-request-id原样返回, 其他加Grpc-Metadata-前缀
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if key == strings.ToLower(consts.RequestIdKey) {
+		return textproto.CanonicalMIMEHeaderKey(key), true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+// outgoingTrailerMatcher 响应trailer中的x-request-id、x-attempts原样返回, 其他加Grpc-Trailer-前缀
+//
+// 请求须携带TE: trailers才会返回trailer.
+func outgoingTrailerMatcher(key string) (string, bool) {
+	switch key {
+	case strings.ToLower(consts.RequestIdKey), consts.AttemptsKey:
+		return textproto.CanonicalMIMEHeaderKey(key), true
+	}
+	return runtime.MetadataTrailerPrefix + key, true
+}
@@ -0,0 +1,64 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	v1 "github.com/seth16888/wxproxy/api/v1"
+	swaggerFiles "github.com/swaggo/files/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// swaggerPage Swagger UI页面, 静态资源为内嵌的swagger-ui-dist, 不从CDN加载
+const swaggerPage = `<!DOCTYPE html>
+<html lang="zh-CN">
+<head>
+  <meta charset="utf-8">
+  <title>WXProxy API</title>
+  <link rel="stylesheet" href="swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="swagger-ui-bundle.js"></script>
+  <script>
+    window.ui = SwaggerUIBundle({ url: "/openapi.json", dom_id: "#swagger-ui" });
+  </script>
+</body>
+</html>
+`
+
+// swaggerAssets 页面使用的静态资源
+var swaggerAssets = []string{"swagger-ui.css", "swagger-ui-bundle.js"}
+
+// openAPIJSON 将生成的OpenAPI文档从YAML转为JSON
+func openAPIJSON() ([]byte, error) {
+	var doc map[string]any
+	if err := yaml.Unmarshal(v1.OpenAPI, &doc); err != nil {
+		return nil, err
+	}
+	return json.Marshal(doc)
+}
+
+// registerOpenAPI 注册/openapi.json和/swagger/
+func registerOpenAPI(mux *http.ServeMux) error {
+	doc, err := openAPIJSON()
+	if err != nil {
+		return err
+	}
+
+	mux.HandleFunc("GET /openapi.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(doc)
+	})
+	mux.HandleFunc("GET /swagger/{$}", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write([]byte(swaggerPage))
+	})
+	for _, name := range swaggerAssets {
+		mux.HandleFunc("GET /swagger/"+name, func(w http.ResponseWriter, r *http.Request) {
+			http.ServeFileFS(w, r, swaggerFiles.FS, name)
+		})
+	}
+	mux.Handle("GET /swagger", http.RedirectHandler("/swagger/", http.StatusMovedPermanently))
+	return nil
+}
@@ -0,0 +1,50 @@
+package server
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSwaggerServesEmbeddedAssets(t *testing.T) {
+	mux := http.NewServeMux()
+	if err := registerOpenAPI(mux); err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	get := func(path string) (*http.Response, string) {
+		t.Helper()
+		resp, err := http.Get(srv.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("GET %s: status %d", path, resp.StatusCode)
+		}
+		return resp, string(body)
+	}
+
+	_, page := get("/swagger/")
+	if strings.Contains(page, "https://") {
+		t.Fatalf("swagger page loads external resources: %s", page)
+	}
+	for _, name := range swaggerAssets {
+		if !strings.Contains(page, `"`+name+`"`) {
+			t.Fatalf("swagger page does not reference %s", name)
+		}
+		resp, body := get("/swagger/" + name)
+		if len(body) == 0 {
+			t.Fatalf("%s is empty", name)
+		}
+		if ct := resp.Header.Get("Content-Type"); !strings.HasPrefix(ct, "text/") {
+			t.Fatalf("%s content type = %q", name, ct)
+		}
+	}
+	get("/openapi.json")
+}
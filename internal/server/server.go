@@ -1,14 +1,18 @@
 package server
 
 import (
+	"context"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	v1 "github.com/seth16888/wxproxy/api/v1"
 	"github.com/seth16888/wxproxy/internal/di"
 	"github.com/seth16888/wxproxy/internal/middleware"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 
@@ -29,30 +33,84 @@ func Start(deps *di.Container) error {
 		return err
 	}
 
-	s := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(
-			middleware.TimeoutInterceptor(),
-			middleware.RequestID(),
-			middleware.LoggingInterceptor(deps.Log),
-			middleware.ClientDisconnectInterceptor(),
-			middleware.RecoverInterceptor(deps.Log),
-		),
+	timeouts := middleware.NewTimeouts(deps.Conf.Server)
+	unary := []grpc.UnaryServerInterceptor{
+		middleware.TimeoutInterceptor(timeouts),
+		middleware.RequestID(),
+		middleware.ClientID(),
+	}
+	stream := []grpc.StreamServerInterceptor{
+		middleware.TimeoutStream(timeouts),
+		middleware.RequestIDStream(),
+		middleware.ClientIDStream(),
+	}
+	// 调用方认证, 位于日志之前以便记录认证后的调用方
+	if deps.Auth != nil {
+		unary = append(unary, middleware.Auth(deps.Auth, deps.Log))
+		stream = append(stream, middleware.AuthStream(deps.Auth, deps.Log))
+	}
+	unary = append(unary,
+		middleware.LoggingInterceptor(deps.Log),
+		middleware.ClientDisconnectInterceptor(),
+		middleware.RecoverInterceptor(deps.Log),
+		middleware.Retry(deps.Retry),
+		middleware.AppTokenInterceptor(deps.Token),
+		middleware.Validate(),
 	)
+	stream = append(stream,
+		middleware.LoggingStream(deps.Log),
+		middleware.ClientDisconnectStream(),
+		middleware.RecoverStream(deps.Log),
+		middleware.RetryStream(deps.Retry),
+		middleware.AppTokenStreamInterceptor(deps.Token),
+		middleware.ValidateStream(),
+	)
+	// 指标
+	if deps.Metrics != nil {
+		unary = append([]grpc.UnaryServerInterceptor{middleware.Metrics(deps.Metrics)}, unary...)
+		stream = append([]grpc.StreamServerInterceptor{middleware.MetricsStream(deps.Metrics)}, stream...)
+	}
+	// 频率限制
+	if deps.Limiter != nil {
+		unary = append(unary, middleware.RateLimit(deps.Limiter))
+		stream = append(stream, middleware.RateLimitStream(deps.Limiter))
+	}
+
+	opts := []grpc.ServerOption{
+		// 链路追踪, 从metadata中提取W3C trace context
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	}
+	// TLS, 配置了client_ca_file时为mTLS
+	creds, err := serverCredentials(deps.Conf.Server.TLS)
+	if err != nil {
+		deps.Log.Error("failed to load tls credentials", zap.Error(err))
+		_ = listener.Close()
+		return err
+	}
+	if creds != nil {
+		opts = append(opts, grpc.Creds(creds))
+	}
+
+	s := grpc.NewServer(opts...)
 	v1.RegisterMpproxyServer(s, deps.Svc)
 	// 健康检查
-	healthSvc := healthsvc.NewServer()
+	healthSvc := deps.Health
 	healthpb.RegisterHealthServer(s, healthSvc)
 	updateHealthStatus(healthSvc, v1.Mpproxy_ServiceDesc.ServiceName,
 		healthpb.HealthCheckResponse_SERVING)
 
-	deps.Log.Info("starting grpc server", zap.String("addr", listenAddr))
-	errCh := make(chan error, 1)
+	deps.Log.Info("starting grpc server", zap.String("addr", listenAddr), zap.Bool("tls", creds != nil))
+	errCh := make(chan error, 4)
 	go func() {
 		if err := s.Serve(listener); err != grpc.ErrServerStopped {
 			deps.Log.Error("failed to serve", zap.Error(err))
 			errCh <- err
 		}
 	}()
+	metricsSrv := startMetrics(deps, errCh)
+	gw := startGateway(deps, s, errCh)
 
 	sigCh := make(chan os.Signal, 1)
 	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
@@ -62,11 +120,18 @@ func Start(deps *di.Container) error {
 		updateHealthStatus(healthSvc, v1.Mpproxy_ServiceDesc.ServiceName,
 			healthpb.HealthCheckResponse_NOT_SERVING)
 		deps.Log.Info("shutting down grpc server gracefully...")
+		gw.stop(deps)
 		s.GracefulStop()
+		stopMetrics(deps, metricsSrv)
+		stopTracing(deps)
 		deps.Log.Sync() // 确保日志同步
 	case err := <-errCh:
 		updateHealthStatus(healthSvc, v1.Mpproxy_ServiceDesc.ServiceName,
 			healthpb.HealthCheckResponse_NOT_SERVING)
+		gw.stop(deps)
+		s.Stop()
+		stopMetrics(deps, metricsSrv)
+		stopTracing(deps)
 		deps.Log.Sync() // 确保日志同步
 		return err
 	}
@@ -83,3 +148,53 @@ func updateHealthStatus(
 ) {
 	h.SetServingStatus(service, status)
 }
+
+// startMetrics 启动Prometheus指标的HTTP服务, 未开启指标时返回nil
+func startMetrics(deps *di.Container, errCh chan<- error) *http.Server {
+	if deps.Metrics == nil {
+		return nil
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", deps.Metrics.Handler())
+	srv := &http.Server{
+		Addr:              deps.Conf.Server.MetricsAddr,
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	deps.Log.Info("starting metrics server", zap.String("addr", srv.Addr))
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			deps.Log.Error("failed to serve metrics", zap.Error(err))
+			errCh <- err
+		}
+	}()
+	return srv
+}
+
+// stopMetrics 关闭Prometheus指标的HTTP服务
+func stopMetrics(deps *di.Container, srv *http.Server) {
+	if srv == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil {
+		deps.Log.Warn("shutdown metrics server error", zap.Error(err))
+	}
+}
+
+// stopTracing 导出剩余的span并关闭链路追踪
+func stopTracing(deps *di.Container) {
+	if deps.TraceShutdown == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := deps.TraceShutdown(ctx); err != nil {
+		deps.Log.Warn("shutdown tracing error", zap.Error(err))
+	}
+}
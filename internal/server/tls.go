@@ -0,0 +1,66 @@
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"os"
+
+	"github.com/seth16888/wxproxy/internal/config"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// serverCredentials gRPC服务的TLS凭证, 未配置证书时返回nil
+//
+// 配置了ClientCAFile时校验客户端提供的证书(mTLS). 不提供证书的客户端仍可建立连接并使用API Key认证,
+// 既没有证书也没有API Key的请求由Auth拦截器拒绝.
+// 网关的进程内连接不经过TLS, 见inProcessCredentials.
+func serverCredentials(conf *config.TLS) (credentials.TransportCredentials, error) {
+	if conf == nil || conf.CertFile == "" {
+		return nil, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(conf.CertFile, conf.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load server certificate: %w", err)
+	}
+	tlsConf := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if conf.ClientCAFile != "" {
+		pem, err := os.ReadFile(conf.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("read client ca: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate found in client ca %s", conf.ClientCAFile)
+		}
+		tlsConf.ClientCAs = pool
+		tlsConf.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+
+	return inProcessCredentials{TransportCredentials: credentials.NewTLS(tlsConf)}, nil
+}
+
+// inProcessCredentials 网关的进程内连接不使用TLS, 其他连接使用TLS
+//
+// 进程内连接没有客户端证书, 经网关的请求须使用API Key认证.
+type inProcessCredentials struct {
+	credentials.TransportCredentials
+}
+
+func (c inProcessCredentials) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	if conn.LocalAddr().Network() == inProcessNetwork {
+		return insecure.NewCredentials().ServerHandshake(conn)
+	}
+	return c.TransportCredentials.ServerHandshake(conn)
+}
+
+func (c inProcessCredentials) Clone() credentials.TransportCredentials {
+	return inProcessCredentials{TransportCredentials: c.TransportCredentials.Clone()}
+}
@@ -0,0 +1,162 @@
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/seth16888/wxproxy/internal/config"
+	"google.golang.org/grpc/credentials"
+)
+
+// testCert 测试证书
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	der  []byte
+}
+
+// newTestCert 由parent签发的证书, parent为nil时自签名
+func newTestCert(t *testing.T, cn string, parent *testCert, tmpl *x509.Certificate) *testCert {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl.SerialNumber = big.NewInt(time.Now().UnixNano())
+	tmpl.Subject = pkix.Name{CommonName: cn}
+	tmpl.NotBefore = time.Now().Add(-time.Hour)
+	tmpl.NotAfter = time.Now().Add(time.Hour)
+
+	signer, signerKey := tmpl, key
+	if parent != nil {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCert{cert: cert, key: key, der: der}
+}
+
+// newTestCA 自签名的CA证书
+func newTestCA(t *testing.T, cn string) *testCert {
+	return newTestCert(t, cn, nil, &x509.Certificate{
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	})
+}
+
+// writePEM 写入证书和私钥, 返回文件路径
+func (c *testCert) writePEM(t *testing.T, dir, name string) (string, string) {
+	t.Helper()
+	keyDER, err := x509.MarshalECPrivateKey(c.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certFile := filepath.Join(dir, name+".crt")
+	keyFile := filepath.Join(dir, name+".key")
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return certFile, keyFile
+}
+
+func (c *testCert) tlsCert() *tls.Certificate {
+	return &tls.Certificate{Certificate: [][]byte{c.der}, PrivateKey: c.key, Leaf: c.cert}
+}
+
+func TestServerCredentialsClientCertOptional(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t, "wxproxy-ca")
+	serverCert := newTestCert(t, "localhost", ca, &x509.Certificate{
+		DNSNames:    []string{"localhost"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	certFile, keyFile := serverCert.writePEM(t, dir, "server")
+	caFile, _ := ca.writePEM(t, dir, "ca")
+
+	creds, err := serverCredentials(&config.TLS{CertFile: certFile, KeyFile: keyFile, ClientCAFile: caFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	clientAuth := []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+
+	// handshake 返回服务端看到的连接信息
+	handshake := func(clientCert *tls.Certificate) (credentials.TLSInfo, error) {
+		serverConn, clientConn := net.Pipe()
+		defer serverConn.Close()
+		defer clientConn.Close()
+
+		go func() {
+			client := tls.Client(clientConn, &tls.Config{
+				RootCAs:    roots,
+				ServerName: "localhost",
+				NextProtos: []string{"h2"},
+				// 不按服务端接受的CA筛选, 总是发送证书
+				GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+					if clientCert == nil {
+						return &tls.Certificate{}, nil
+					}
+					return clientCert, nil
+				},
+			})
+			// 服务端拒绝证书时客户端握手失败, 由服务端的结果判断
+			_ = client.Handshake()
+			// TLS 1.3的客户端证书在握手后校验, 读取服务端的响应
+			_, _ = client.Read(make([]byte, 1))
+		}()
+
+		_, info, err := creds.ServerHandshake(serverConn)
+		if err != nil {
+			return credentials.TLSInfo{}, err
+		}
+		return info.(credentials.TLSInfo), nil
+	}
+
+	// 不提供证书的客户端可以建立连接, 由Auth拦截器要求API Key
+	info, err := handshake(nil)
+	if err != nil {
+		t.Fatalf("handshake without client certificate: %v", err)
+	}
+	if len(info.State.VerifiedChains) != 0 {
+		t.Fatal("verified chains without client certificate")
+	}
+
+	// CA签发的客户端证书通过校验
+	client := newTestCert(t, "ops", ca, &x509.Certificate{ExtKeyUsage: clientAuth})
+	info, err = handshake(client.tlsCert())
+	if err != nil {
+		t.Fatalf("handshake with client certificate: %v", err)
+	}
+	if len(info.State.VerifiedChains) == 0 || info.State.PeerCertificates[0].Subject.CommonName != "ops" {
+		t.Fatal("client certificate not verified")
+	}
+
+	// 其他CA签发的证书被拒绝
+	other := newTestCert(t, "ops", newTestCA(t, "other-ca"), &x509.Certificate{ExtKeyUsage: clientAuth})
+	if _, err := handshake(other.tlsCert()); err == nil {
+		t.Fatal("handshake with untrusted client certificate succeeded")
+	}
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"slices"
+
+	v1 "github.com/seth16888/wxproxy/api/v1"
+	"github.com/seth16888/wxproxy/internal/consts"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// ErrAuthRequired 接口须由经认证的调用方调用
+	ErrAuthRequired = v1.ErrorUnauthenticated("authenticated client required")
+	// ErrAdminRequired 调用方不在auth.admin_clients中
+	ErrAdminRequired = status.Error(codes.PermissionDenied, "admin client required")
+)
+
+// authClient 经认证(API Key或mTLS)的调用方, 未开启认证或未认证时返回空
+//
+// 不使用metadata中自报的x-client-id.
+func authClient(ctx context.Context) string {
+	clientId, _ := ctx.Value(consts.AuthClientKey).(string)
+	return clientId
+}
+
+// requireAdmin 管理接口只允许auth.admin_clients中经认证的调用方调用
+func (m *MPProxyService) requireAdmin(ctx context.Context, method string) error {
+	clientId := authClient(ctx)
+	if clientId == "" {
+		m.logger(ctx).Warn("admin method called without authentication", zap.String("method", method))
+		return ErrAuthRequired
+	}
+	if !slices.Contains(m.admins, clientId) {
+		m.logger(ctx).Warn("client not allowed to call admin method", zap.String("method", method),
+			zap.String("clientId", clientId))
+		return ErrAdminRequired
+	}
+	return nil
+}
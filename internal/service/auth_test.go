@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/seth16888/wxproxy/api/v1"
+	"github.com/seth16888/wxproxy/internal/consts"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRequireAdmin(t *testing.T) {
+	obs, logs := observer.New(zapcore.DebugLevel)
+	m := NewMPProxyService(nil, nil, []string{"ops"}, zap.New(obs))
+
+	ctx := context.WithValue(context.Background(), consts.RequestIdKey, "req-1")
+	// metadata中自报的x-client-id不视为认证
+	selfReported := context.WithValue(ctx, consts.ClientIdKey, "ops")
+	if err := m.requireAdmin(selfReported, "ClearQuota"); !v1.IsUnauthenticated(err) {
+		t.Fatalf("self-reported client: err = %v, want UNAUTHENTICATED", err)
+	}
+
+	other := context.WithValue(ctx, consts.AuthClientKey, "billing")
+	if err := m.requireAdmin(other, "ClearQuota"); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("non-admin client: err = %v, want PermissionDenied", err)
+	}
+
+	admin := context.WithValue(ctx, consts.AuthClientKey, "ops")
+	if err := m.requireAdmin(admin, "ClearQuota"); err != nil {
+		t.Fatalf("admin client: %v", err)
+	}
+
+	// 拒绝的请求日志带有requestId
+	entries := logs.All()
+	if len(entries) != 2 {
+		t.Fatalf("got %d log entries, want 2", len(entries))
+	}
+	for _, e := range entries {
+		if e.ContextMap()["requestId"] != "req-1" {
+			t.Fatalf("log entry %q without requestId: %v", e.Message, e.ContextMap())
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package service
+
+import (
+	"context"
+
+	"github.com/seth16888/wxproxy/internal/consts"
+	"go.uber.org/zap"
+)
+
+// logger 本次请求的日志, 附加上下文中的RequestID, 与biz层的日志一致
+func (m *MPProxyService) logger(ctx context.Context) *zap.Logger {
+	if requestId, ok := ctx.Value(consts.RequestIdKey).(string); ok && requestId != "" {
+		return m.log.With(zap.String("requestId", requestId))
+	}
+	return m.log
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
 	v1 "github.com/seth16888/wxproxy/api/v1"
 	"github.com/seth16888/wxproxy/internal/biz"
@@ -14,24 +15,175 @@ import (
 
 type MPProxyService struct {
 	v1.UnimplementedMpproxyServer
-	log *zap.Logger
-	uc  *biz.MPProxyUsecase
+	log   *zap.Logger
+	uc    *biz.MPProxyUsecase
+	token *biz.TokenUsecase
+	// admins 允许调用管理接口的调用方
+	admins []string
 }
 
-func NewMPProxyService(uc *biz.MPProxyUsecase, logger *zap.Logger) *MPProxyService {
-	return &MPProxyService{uc: uc, log: logger}
+func NewMPProxyService(uc *biz.MPProxyUsecase, token *biz.TokenUsecase, admins []string,
+	logger *zap.Logger,
+) *MPProxyService {
+	return &MPProxyService{uc: uc, token: token, admins: admins, log: logger}
+}
+
+// GetAccessToken 调用方获取托管的AccessToken
+//
+// 调用方须经过认证(API Key或mTLS), 不信任metadata中自报的x-client-id.
+func (m *MPProxyService) GetAccessToken(ctx context.Context, req *v1.GetAccessTokenRequest) (*v1.GetAccessTokenReply, error) {
+	clientId := authClient(ctx)
+	if clientId == "" {
+		m.logger(ctx).Warn("GetAccessToken called without authentication", zap.String("appId", req.GetAppId()))
+		return nil, ErrAuthRequired
+	}
+	at, err := m.token.GetClientAccessToken(ctx, req.GetAppId(), clientId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetAccessTokenReply{
+		AccessToken: at.AccessToken,
+		ExpiresAt:   at.ExpiresAt,
+		ExpiresIn:   max(at.ExpiresAt-time.Now().Unix(), 0),
+	}, nil
+}
+
+func (m *MPProxyService) RefreshAccessToken(ctx context.Context, req *v1.RefreshAccessTokenRequest) (*v1.RefreshAccessTokenReply, error) {
+	if err := m.requireAdmin(ctx, "RefreshAccessToken"); err != nil {
+		return nil, err
+	}
+
+	at, err := m.token.RefreshAccessToken(ctx, req.GetAppId(), req.GetForceRefresh())
+	if err != nil {
+		return nil, err
+	}
+
+	used, limit, err := m.token.ForceRefreshUsage(ctx, req.GetAppId())
+	if err != nil {
+		m.logger(ctx).Warn("get force refresh usage error", zap.String("appId", req.GetAppId()), zap.Error(err))
+	}
+
+	return &v1.RefreshAccessTokenReply{
+		ExpiresAt:         at.ExpiresAt,
+		ForceRefreshCount: used,
+		ForceRefreshLimit: limit,
+	}, nil
+}
+
+// DescribeErrorCode 查询微信错误码的说明
+func (m *MPProxyService) DescribeErrorCode(ctx context.Context, req *v1.DescribeErrorCodeRequest) (*v1.DescribeErrorCodeReply, error) {
+	info, reason, err := biz.DescribeErrCode(req.GetErrcode())
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.DescribeErrorCodeReply{
+		Errcode:         info.Code,
+		Reason:          reason,
+		Category:        info.Category,
+		Retryable:       info.Retryable,
+		DescriptionZh:   info.Zh,
+		DescriptionEn:   info.En,
+		SuggestedAction: info.Action,
+	}, nil
+}
+
+// GetRidInfo 查询rid对应的请求详情
+func (m *MPProxyService) GetRidInfo(ctx context.Context, req *v1.GetRidInfoRequest) (*v1.GetRidInfoReply, error) {
+	res, err := m.uc.GetRidInfo(ctx, req.GetAccessToken(), req.GetRid())
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetRidInfoReply{
+		InvokeTime:   res.InvokeTime,
+		CostInMs:     res.CostInMs,
+		RequestUrl:   res.RequestUrl,
+		RequestBody:  res.RequestBody,
+		ResponseBody: res.ResponseBody,
+		ClientIp:     res.ClientIp,
+	}, nil
+}
+
+// GetApiQuota 查询接口的每日调用额度
+func (m *MPProxyService) GetApiQuota(ctx context.Context, req *v1.GetApiQuotaRequest) (*v1.GetApiQuotaReply, error) {
+	res, err := m.uc.GetApiQuota(ctx, req.GetAccessToken(), req.GetCgiPath())
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetApiQuotaReply{
+		DailyLimit:             res.Quota.DailyLimit,
+		Used:                   res.Quota.Used,
+		Remain:                 res.Quota.Remain,
+		RateLimitCallCount:     res.RateLimit.CallCount,
+		RateLimitRefreshSecond: res.RateLimit.RefreshSecond,
+	}, nil
+}
+
+// ClearQuota 重置接口调用次数(管理接口), 每月次数有限
+func (m *MPProxyService) ClearQuota(ctx context.Context, req *v1.ClearQuotaRequest) (*v1.WXErrorReply, error) {
+	if err := m.requireAdmin(ctx, "ClearQuota"); err != nil {
+		return nil, err
+	}
+
+	if err := m.uc.ClearQuota(ctx, req.GetAccessToken(), req.GetAppId()); err != nil {
+		return nil, err
+	}
+
+	return &v1.WXErrorReply{Errcode: 0, Errmsg: "ok"}, nil
+}
+
+// ClearQuotaV2 使用托管的AppSecret重置接口调用次数(管理接口)
+func (m *MPProxyService) ClearQuotaV2(ctx context.Context, req *v1.ClearQuotaV2Request) (*v1.WXErrorReply, error) {
+	if err := m.requireAdmin(ctx, "ClearQuotaV2"); err != nil {
+		return nil, err
+	}
+
+	if err := m.token.ClearQuotaV2(ctx, req.GetAppId()); err != nil {
+		return nil, err
+	}
+
+	return &v1.WXErrorReply{Errcode: 0, Errmsg: "ok"}, nil
+}
+
+// GetQuotaUsage 查询WXProxy记录的账号每日接口调用次数
+func (m *MPProxyService) GetQuotaUsage(ctx context.Context, req *v1.GetQuotaUsageRequest) (*v1.GetQuotaUsageReply, error) {
+	date, usages, err := m.uc.GetQuotaUsage(ctx, req.GetAccessToken(), req.GetAppId(),
+		req.GetDate(), req.GetWithLimit())
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*v1.QuotaUsage, 0, len(usages))
+	for _, usage := range usages {
+		items = append(items, &v1.QuotaUsage{
+			CgiPath:    usage.CgiPath,
+			Count:      usage.Count,
+			DailyLimit: usage.DailyLimit,
+			Used:       usage.Used,
+			Remain:     usage.Remain,
+		})
+	}
+
+	return &v1.GetQuotaUsageReply{Date: date, Items: items}, nil
 }
 
 func (m *MPProxyService) BlockMember(ctx context.Context, req *v1.BlockMemberReq) (*v1.WXErrorReply, error) {
-	wxErr := m.uc.BlockMember(ctx, req.AccessToken, req.OpenIds)
+	if err := m.uc.BlockMember(ctx, req.AccessToken, req.OpenIds); err != nil {
+		return nil, err
+	}
 
-	return wxErr, nil
+	return &v1.WXErrorReply{Errcode: 0, Errmsg: "ok"}, nil
 }
 
 func (m *MPProxyService) UnBlockMember(ctx context.Context, req *v1.BlockMemberReq) (*v1.WXErrorReply, error) {
-	wxErr := m.uc.UnBlockMember(ctx, req.AccessToken, req.OpenIds)
+	if err := m.uc.UnBlockMember(ctx, req.AccessToken, req.OpenIds); err != nil {
+		return nil, err
+	}
 
-	return wxErr, nil
+	return &v1.WXErrorReply{Errcode: 0, Errmsg: "ok"}, nil
 }
 
 func (m *MPProxyService) GetMaterialCount(ctx context.Context, req *v1.AccessTokenParam) (*v1.GetMaterialCountReply, error) {
@@ -52,14 +204,14 @@ func (m *MPProxyService) GetMaterialNewsList(req *v1.GetMaterialListRequest, str
 		return status.Error(codes.InvalidArgument, "type must be news")
 	}
 
+	ctx := stream.Context()
 	currentOffset := req.GetOffset()
 	for {
-		ctx := context.Background()
 		res, err := m.uc.GetMaterialNewsList(ctx, req.GetAccessToken(), req.Type, currentOffset, req.GetCount())
 		if err != nil {
-			return status.Error(codes.Unknown, err.Error())
+			return err
 		}
-		m.log.Debug("GetMaterialNewsList", zap.Int64("total", res.TotalCount), zap.Int64("item_count", res.ItemCount))
+		m.logger(ctx).Debug("GetMaterialNewsList", zap.Int64("total", res.TotalCount), zap.Int64("item_count", res.ItemCount))
 		var items []*v1.MaterialNewsItem
 		var newsItem *v1.MaterialNewsItem
 		if res.Item == nil {
@@ -117,14 +269,14 @@ func (m *MPProxyService) GetMaterialList(req *v1.GetMaterialListRequest, stream
 		return status.Error(codes.InvalidArgument, "type must be image, video or voice")
 	}
 
+	ctx := stream.Context()
 	currentOffset := req.GetOffset()
 	for {
-		ctx := context.Background()
 		res, err := m.uc.GetMaterialList(ctx, req.GetAccessToken(), req.GetType(), currentOffset, req.GetCount())
 		if err != nil {
-			return status.Error(codes.Unknown, err.Error())
+			return err
 		}
-		m.log.Debug("GetMaterialList", zap.Int64("total", res.TotalCount), zap.Int64("item_count", res.ItemCount))
+		m.logger(ctx).Debug("GetMaterialList", zap.Int64("total", res.TotalCount), zap.Int64("item_count", res.ItemCount))
 		var items []*v1.MaterialItem
 		if res.Item == nil {
 			break
@@ -163,11 +315,10 @@ func (m *MPProxyService) GetMaterialList(req *v1.GetMaterialListRequest, stream
 }
 
 func (m *MPProxyService) DeleteMaterial(ctx context.Context, req *v1.DeleteMaterialReq) (*v1.WXErrorReply, error) {
-	wxErr := m.uc.DeleteMaterial(ctx, req.GetAccessToken(), req.GetMediaId())
-	if wxErr != nil {
-		return wxErr, nil
+	if err := m.uc.DeleteMaterial(ctx, req.GetAccessToken(), req.GetMediaId()); err != nil {
+		return nil, err
 	}
-	return nil, nil
+	return &v1.WXErrorReply{Errcode: 0, Errmsg: "ok"}, nil
 }
 
 func (m *MPProxyService) GetMemberList(ctx context.Context, req *v1.GetMemberListRequest) (*v1.GetMemberListReply, error) {
@@ -520,7 +671,7 @@ func (m *MPProxyService) PullMenu(ctx context.Context, req *v1.AccessTokenParam)
 func (m *MPProxyService) CreateMenu(ctx context.Context,req *v1.CreateMenuRequest) (*v1.WXErrorReply, error) {
 	err := m.uc.CreateMenu(ctx, req.AccessToken, req.Button)
 	if err != nil {
-		m.log.Error("CreateMenu", zap.Error(err))
+		m.logger(ctx).Error("CreateMenu", zap.Error(err))
 		return nil, err
 	}
 
@@ -528,15 +679,19 @@ func (m *MPProxyService) CreateMenu(ctx context.Context,req *v1.CreateMenuReques
 }
 
 func (m *MPProxyService) CreateConditionalMenu(ctx context.Context, req *v1.CreateMenuRequest) (*v1.WXErrorReply, error) {
-  wxErr := m.uc.CreateConditionalMenu(ctx, req.AccessToken, req.Button, req.Matchrule)
+	if err := m.uc.CreateConditionalMenu(ctx, req.AccessToken, req.Button, req.Matchrule); err != nil {
+		return nil, err
+	}
 
-	return wxErr, nil
+	return &v1.WXErrorReply{Errcode: 0, Errmsg: "ok"}, nil
 }
 
 func (m *MPProxyService) DeleteConditionalMenu(ctx context.Context, req *v1.DeleteConditionalMenuRequest) (*v1.WXErrorReply, error) {
-  wxErr := m.uc.DeleteConditionalMenu(ctx, req.AccessToken, req.Menuid)
+	if err := m.uc.DeleteConditionalMenu(ctx, req.AccessToken, req.Menuid); err != nil {
+		return nil, err
+	}
 
-	return wxErr, nil
+	return &v1.WXErrorReply{Errcode: 0, Errmsg: "ok"}, nil
 }
 
 func (m *MPProxyService) DeleteMenu(ctx context.Context, req *v1.AccessTokenParam) (*v1.WXErrorReply, error) {
@@ -875,11 +1030,11 @@ func (m *MPProxyService) InviteKFWorker(ctx context.Context, req *v1.InviteKFWor
 		return nil, err
 	}
 
-	return nil, nil
+	return &v1.WXErrorReply{Errcode: 0, Errmsg: "ok"}, nil
 }
 
 func (m *MPProxyService) UpdateKFAvatar(context.Context, *v1.UpdateKFAvatarRequest) (*v1.WXErrorReply, error) {
-	return nil, nil
+	return nil, status.Error(codes.Unimplemented, "method UpdateKFAvatar not implemented")
 }
 
 // 下发客服输入状态
@@ -892,7 +1047,7 @@ func (m *MPProxyService) UpdateKFTyping(ctx context.Context, req *v1.UpdateKFTyp
 		return nil, err
 	}
 
-	return nil, nil
+	return &v1.WXErrorReply{Errcode: 0, Errmsg: "ok"}, nil
 }
 
 func (m *MPProxyService) GetKFSessionList(ctx context.Context, req *v1.GetKFSessionListRequest) (*v1.GetKFSessionListReply, error) {
@@ -1,2 +1,2 @@
-protoc --proto_path=./api --proto_path=./third_party --go_out=paths=source_relative:./api --go-grpc_out=paths=source_relative:./api ./api/v1/*.proto
+protoc --proto_path=./api --proto_path=./third_party --go_out=paths=source_relative:./api --go-grpc_out=paths=source_relative:./api --go-errors_out=paths=source_relative:./api --grpc-gateway_out=paths=source_relative:./api --validate_out="lang=go,paths=source_relative:./api" --openapi_out=enum_type=string:./api/v1 ./api/v1/*.proto
 